@@ -11,7 +11,9 @@
 package cli
 
 import (
+	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -24,6 +26,10 @@ func MakeBackupExportCommand() *cobra.Command {
 	var collections []string
 	var pretty bool
 	var format string
+	var compression string
+	var filter string
+	var changedSince string
+	var stream bool
 	var cmd = &cobra.Command{
 		Use:   "export  [-c --collections | -p --pretty | -f --format] <output_path>",
 		Short: "Export the database to a file",
@@ -34,8 +40,21 @@ Otherwise, all collections in the database will be exported.
 
 If the --pretty flag is provided, the JSON will be pretty printed.
 
+The --format flag selects the json, ndjson or cbor format, and the --compression flag
+compresses the export with gzip or zstd.
+
+The --filter flag only exports the documents matching the given GraphQL filter, and the
+--changed-since flag only exports the documents changed since the given RFC 3339 time.
+
+By default the file is written by the node. If the --stream flag is provided, the export is
+streamed from the node and written to the file on this machine instead.
+
 Example: export data for the 'Users' collection:
-  defradb client export --collection Users user_data.json`,
+  defradb client export --collection Users user_data.json
+
+Example: stream a compressed export of the documents changed since midnight to this machine:
+  defradb client export --stream --format ndjson --compression zstd \
+    --changed-since 2023-11-20T00:00:00Z changes.ndjson.zst`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			store := mustGetStoreContext(cmd)
@@ -52,24 +71,65 @@ Example: export data for the 'Users' collection:
 			data := client.BackupConfig{
 				Filepath:    outputPath,
 				Format:      format,
+				Compression: compression,
 				Pretty:      pretty,
 				Collections: collections,
+				Filter:      filter,
+			}
+			if changedSince != "" {
+				since, err := time.Parse(time.RFC3339, changedSince)
+				if err != nil {
+					return NewErrInvalidChangedSince(changedSince, err)
+				}
+				data.ChangedSince = since
 			}
 
-			return store.BasicExport(cmd.Context(), &data)
+			if !stream {
+				return store.BasicExport(cmd.Context(), &data)
+			}
+			return streamExport(cmd, store, &data)
 		},
 	}
 	cmd.Flags().BoolVarP(&pretty, "pretty", "p", false, "Set the output JSON to be pretty printed")
 	cmd.Flags().StringVarP(&format, "format", "f", jsonFileType,
-		"Define the output format. Supported formats: [json]")
+		"Define the output format. Supported formats: [json, ndjson, cbor]")
+	cmd.Flags().StringVar(&compression, "compression", "",
+		"Compress the output. Supported compressions: [gzip, zstd]")
 	cmd.Flags().StringSliceVarP(&collections, "collections", "c", []string{}, "List of collections")
+	cmd.Flags().StringVar(&filter, "filter", "", "Only export the documents matching this GraphQL filter")
+	cmd.Flags().StringVar(&changedSince, "changed-since", "",
+		"Only export the documents changed since this RFC 3339 time")
+	cmd.Flags().BoolVar(&stream, "stream", false, "Write the export to a file on this machine instead of the node")
 
 	return cmd
 }
 
+// streamExport writes the export streamed from the node to a local file, which
+// is only created once the export has completed successfully.
+func streamExport(cmd *cobra.Command, store client.Store, config *client.BackupConfig) (err error) {
+	tempFile := config.Filepath + ".temp"
+	f, err := os.Create(tempFile)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		closeErr := f.Close()
+		if err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			os.Remove(tempFile) //nolint:errcheck
+		} else {
+			err = os.Rename(tempFile, config.Filepath)
+		}
+	}()
+
+	return store.BasicExportTo(cmd.Context(), config, f)
+}
+
 func isValidExportFormat(format string) bool {
 	switch strings.ToLower(format) {
-	case jsonFileType:
+	case client.BackupFormatJSON, client.BackupFormatNDJSON, client.BackupFormatCBOR:
 		return true
 	default:
 		return false
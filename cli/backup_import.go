@@ -11,22 +11,62 @@
 package cli
 
 import (
+	"os"
+
 	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
 )
 
 func MakeBackupImportCommand() *cobra.Command {
+	var format string
+	var compression string
+	var stream bool
 	var cmd = &cobra.Command{
 		Use:   "import <input_path>",
 		Short: "Import a JSON data file to the database",
 		Long: `Import a JSON data file to the database.
 
+By default the file is read by the node, and its format is detected from its extension:
+.ndjson and .jsonl files are read as ndjson, .cbor files as cbor, and any other file as json.
+Gzip and zstd compressed files are detected from their content.
+
+If the --stream flag is provided, the file is read on this machine and streamed to the node
+instead. Its format is then set with the --format flag.
+
 Example: import data to the database:
-  defradb client import user_data.json`,
+  defradb client import user_data.json
+
+Example: stream a compressed ndjson file to the database:
+  defradb client import --stream --format ndjson user_data.ndjson.gz`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			store := mustGetStoreContext(cmd)
-			return store.BasicImport(cmd.Context(), args[0])
+
+			if !stream {
+				return store.BasicImport(cmd.Context(), args[0])
+			}
+			if !isValidExportFormat(format) {
+				return ErrInvalidExportFormat
+			}
+
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close() //nolint:errcheck
+
+			config := client.BackupConfig{
+				Format:      format,
+				Compression: compression,
+			}
+			return store.BasicImportFrom(cmd.Context(), &config, f)
 		},
 	}
+	cmd.Flags().StringVarP(&format, "format", "f", jsonFileType,
+		"Define the format of a streamed input. Supported formats: [json, ndjson, cbor]")
+	cmd.Flags().StringVar(&compression, "compression", "",
+		"Define the compression of a streamed input. Detected from the content if not set")
+	cmd.Flags().BoolVar(&stream, "stream", false, "Read the file on this machine instead of the node")
 	return cmd
 }
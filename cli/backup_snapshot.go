@@ -0,0 +1,44 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func MakeBackupSnapshotCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "snapshot <output_path>",
+		Short: "Write a snapshot of the entire node state to a file",
+		Long: `Write a consistent snapshot of the entire node state to a file on the node.
+
+Unlike export, the snapshot holds every datastore entry of the node, including schema
+versions, migrations, indexes, DAG history, replicators and P2P collections.
+The snapshot is taken while the node is running, and its checksum is verified once written.
+It can be restored to an empty datastore with the server-restore command.
+
+The snapshot is not encrypted, even if the datastore is.
+
+Example: write a snapshot of the node
+  defradb client backup snapshot /var/backups/defradb.snapshot`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db := mustGetDBContext(cmd)
+
+			info, err := db.CreateSnapshot(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd, info)
+		},
+	}
+	return cmd
+}
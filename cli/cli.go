@@ -45,6 +45,26 @@ func NewDefraCommand(cfg *config.Config) *cobra.Command {
 		MakeP2PInfoCommand(),
 	)
 
+	webhook := MakeWebhookCommand()
+	webhook.AddCommand(
+		MakeWebhookGetAllCommand(),
+		MakeWebhookAddCommand(),
+		MakeWebhookDeleteCommand(),
+		MakeWebhookDeadLettersCommand(),
+	)
+
+	policy := MakePolicyCommand()
+	policy.AddCommand(
+		MakePolicyGetCommand(),
+		MakePolicySetCommand(),
+	)
+
+	encryption_key := MakeEncryptionKeyCommand()
+	encryption_key.AddCommand(
+		MakeEncryptionKeyGetCommand(),
+		MakeEncryptionKeySetCommand(),
+	)
+
 	schema_migrate := MakeSchemaMigrationCommand()
 	schema_migrate.AddCommand(
 		MakeSchemaMigrationSetCommand(),
@@ -74,6 +94,7 @@ func NewDefraCommand(cfg *config.Config) *cobra.Command {
 	backup.AddCommand(
 		MakeBackupExportCommand(),
 		MakeBackupImportCommand(),
+		MakeBackupSnapshotCommand(),
 	)
 
 	tx := MakeTxCommand()
@@ -91,6 +112,11 @@ func NewDefraCommand(cfg *config.Config) *cobra.Command {
 		MakeCollectionUpdateCommand(),
 		MakeCollectionCreateCommand(),
 		MakeCollectionDescribeCommand(),
+		MakeCollectionChangesCommand(),
+		MakeCollectionDiffCommand(),
+		MakeCollectionRevertCommand(),
+		MakeCollectionUpsertCommand(),
+		MakeCollectionImportCommand(),
 	)
 
 	client := MakeClientCommand(cfg)
@@ -100,6 +126,9 @@ func NewDefraCommand(cfg *config.Config) *cobra.Command {
 		schema,
 		index,
 		p2p,
+		webhook,
+		policy,
+		encryption_key,
 		backup,
 		tx,
 		collection,
@@ -110,6 +139,8 @@ func NewDefraCommand(cfg *config.Config) *cobra.Command {
 		client,
 		MakeStartCommand(cfg),
 		MakeServerDumpCmd(cfg),
+		MakeServerRestoreCmd(cfg),
+		MakeServerRotateKeyCmd(cfg),
 		MakeVersionCommand(),
 		MakeInitCommand(cfg),
 	)
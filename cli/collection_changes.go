@@ -0,0 +1,56 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func MakeCollectionChangesCommand() *cobra.Command {
+	var cursor uint64
+	var limit uint64
+	var cmd = &cobra.Command{
+		Use:   "changes [--cursor <seq>] [--limit <count>]",
+		Short: "List the change log of a collection.",
+		Long: `List the changes recorded in the change log of a collection.
+
+Changes are returned in sequence order along with a cursor, the sequence number
+of the last change read. The cursor may be provided to the next call to resume
+from where the previous call left off. Changes to documents the caller may not
+read are skipped.
+
+Example: list all retained changes
+  defradb client collection changes --name User
+
+Example: list changes after a cursor
+  defradb client collection changes --name User --cursor 10
+
+Example: list at most 100 changes after a cursor
+  defradb client collection changes --name User --cursor 10 --limit 100
+		`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store := mustGetStoreContext(cmd)
+
+			col, ok := tryGetCollectionContext(cmd)
+			if !ok {
+				return cmd.Usage()
+			}
+			result, err := store.ChangesSince(cmd.Context(), col.Name(), cursor, limit)
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd, result)
+		},
+	}
+	cmd.Flags().Uint64Var(&cursor, "cursor", 0, "Sequence number after which to list changes")
+	cmd.Flags().Uint64Var(&limit, "limit", 0, "Maximum number of changes to list, or zero for no limit")
+	return cmd
+}
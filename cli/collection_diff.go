@@ -0,0 +1,58 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func MakeCollectionDiffCommand() *cobra.Command {
+	var from string
+	var to string
+	var cmd = &cobra.Command{
+		Use:   "diff <docKey> [--from <cid>] [--to <cid>]",
+		Short: "View the fields changed between two versions of a document.",
+		Long: `View the fields changed between two versions of a document.
+
+Versions are identified by the CID of their composite commit. If --from is not
+provided the state before the document was created is used. If --to is not
+provided the current state of the document is used.
+
+Example: view the changes made since a version
+  defradb client collection diff --name User bae-123 --from bafybeib...
+
+Example: view the changes made between two versions
+  defradb client collection diff --name User bae-123 --from bafybeib... --to bafybeic...
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			col, ok := tryGetCollectionContext(cmd)
+			if !ok {
+				return cmd.Usage()
+			}
+
+			docKey, err := client.NewDocKeyFromString(args[0])
+			if err != nil {
+				return err
+			}
+			diff, err := col.Diff(cmd.Context(), docKey, from, to)
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd, diff)
+		},
+	}
+	cmd.Flags().StringVar(&from, "from", "", "CID of the composite commit of the earlier version")
+	cmd.Flags().StringVar(&to, "to", "", "CID of the composite commit of the later version")
+	return cmd
+}
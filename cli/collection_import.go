@@ -0,0 +1,161 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func MakeCollectionImportCommand() *cobra.Command {
+	var format string
+	var mappings []string
+	var relations []string
+	var batchSize int
+	var rejectsFile string
+	var cmd = &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import documents from a CSV or NDJSON file.",
+		Long: `Import documents from a CSV or NDJSON file.
+
+Every row of the file is created as a new document. The first record of a CSV file
+holds the column names, and each line of an NDJSON file holds a JSON object. The format
+is detected from the file extension unless the --format flag is provided.
+
+Columns are imported into the field of the same name, unless mapped to another field
+with the --map flag, and the values are converted to the kind of their field. Relation
+fields are imported by the value of a natural key of the related collection, set with
+the --relation flag.
+
+Rows that cannot be imported are rejected without stopping the import, and are written
+as NDJSON to the file given with the --rejects flag.
+
+Example: import a CSV file
+  defradb client collection import --name User users.csv
+
+Example: import a CSV file, mapping columns to fields and ignoring a column
+  defradb client collection import --name User --map full_name:name --map notes: users.csv
+
+Example: import books whose author column holds the name of the author
+  defradb client collection import --name Book --relation author:name --rejects rejects.ndjson books.csv
+
+Example: import from stdin
+  cat users.ndjson | defradb client collection import --name User --format ndjson -
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			col, ok := tryGetCollectionContext(cmd)
+			if !ok {
+				return cmd.Usage()
+			}
+
+			config := client.ImportConfig{
+				Format:    format,
+				Mapping:   make(map[string]string),
+				Relations: make(map[string]string),
+				BatchSize: batchSize,
+			}
+			for _, value := range mappings {
+				column, field, ok := strings.Cut(value, ":")
+				if !ok {
+					return NewErrInvalidImportPair("map", value)
+				}
+				config.Mapping[column] = field
+			}
+			for _, value := range relations {
+				field, naturalKey, ok := strings.Cut(value, ":")
+				if !ok {
+					return NewErrInvalidImportPair("relation", value)
+				}
+				config.Relations[field] = naturalKey
+			}
+
+			var r io.Reader
+			if args[0] == "-" {
+				if config.Format == "" {
+					return ErrMissingImportFormat
+				}
+				r = cmd.InOrStdin()
+			} else {
+				if config.Format == "" {
+					config.Format = importFormatFromPath(args[0])
+				}
+				f, err := os.Open(args[0])
+				if err != nil {
+					return err
+				}
+				defer f.Close() //nolint:errcheck
+				r = f
+			}
+
+			result, err := col.Import(cmd.Context(), config, r)
+			if err != nil {
+				return err
+			}
+			if rejectsFile != "" {
+				if err := writeImportRejects(rejectsFile, result.Rejected); err != nil {
+					return err
+				}
+			}
+			return writeJSON(cmd, result)
+		},
+	}
+	cmd.Flags().StringVarP(&format, "format", "f", "",
+		"Format of the imported file, one of csv or ndjson. Detected from the file extension if not set")
+	cmd.Flags().StringArrayVar(&mappings, "map", nil,
+		"Column imported into a field, as column:field. An empty field ignores the column")
+	cmd.Flags().StringArrayVar(&relations, "relation", nil,
+		"Relation field imported by the natural key of the related collection, as field:key")
+	cmd.Flags().IntVar(&batchSize, "batch-size", client.DefaultImportBatchSize,
+		"Number of documents created per transaction")
+	cmd.Flags().StringVar(&rejectsFile, "rejects", "", "File the rejected rows are written to as NDJSON")
+	return cmd
+}
+
+// importFormatFromPath returns the import format matching the extension of the given path.
+func importFormatFromPath(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return client.ImportFormatCSV
+	case ".ndjson", ".jsonl":
+		return client.ImportFormatNDJSON
+	default:
+		return ""
+	}
+}
+
+func writeImportRejects(path string, rejects []client.ImportReject) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, reject := range rejects {
+		if err := enc.Encode(reject); err != nil {
+			f.Close() //nolint:errcheck
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close() //nolint:errcheck
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,47 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func MakeCollectionRevertCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "revert <docKey> <cid>",
+		Short: "Revert a document to a previous version.",
+		Long: `Revert a document to a previous version.
+
+The version is identified by the CID of its composite commit. The revert is written
+as a new update, so it is replicated like any other update. Documents that have been
+deleted since the given version are restored.
+
+Example: revert a document to a previous version
+  defradb client collection revert --name User bae-123 bafybeib...
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			col, ok := tryGetCollectionContext(cmd)
+			if !ok {
+				return cmd.Usage()
+			}
+
+			docKey, err := client.NewDocKeyFromString(args[0])
+			if err != nil {
+				return err
+			}
+			return col.Revert(cmd.Context(), docKey, args[1])
+		},
+	}
+	return cmd
+}
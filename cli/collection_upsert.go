@@ -0,0 +1,60 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func MakeCollectionUpsertCommand() *cobra.Command {
+	var filter string
+	var updater string
+	var cmd = &cobra.Command{
+		Use:   "upsert --filter <filter> --updater <updater> <document>",
+		Short: "Update the document matching a filter, or create it.",
+		Long: `Update the document matching a filter, or create it.
+
+The single document matching the filter is updated with the updater. If no document
+matches the filter the given document is created. An error is returned if more than
+one document matches the filter.
+
+Example: upsert a user by email
+  defradb client collection upsert --name User \
+  --filter '{ "email": { "_eq": "bob@example.com" } }' --updater '{ "name": "Bob" }' \
+  '{ "email": "bob@example.com", "name": "Bob" }'
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			col, ok := tryGetCollectionContext(cmd)
+			if !ok {
+				return cmd.Usage()
+			}
+			if filter == "" || updater == "" {
+				return ErrNoDocKeyOrFilter
+			}
+
+			doc, err := client.NewDocFromJSON([]byte(args[0]))
+			if err != nil {
+				return err
+			}
+			res, err := col.Upsert(cmd.Context(), filter, doc, updater)
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd, res)
+		},
+	}
+	cmd.Flags().StringVar(&filter, "filter", "", "Document filter")
+	cmd.Flags().StringVar(&updater, "updater", "", "Document updater")
+	return cmd
+}
@@ -0,0 +1,26 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func MakeEncryptionKeyCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "encryption-key",
+		Short: "Manage the keys of collections with encrypted fields",
+		Long: `Manage the keys of collections with encrypted fields. The values of fields declared
+with the @encrypted directive are encrypted with the key of their collection before being
+written to the DAG, only nodes holding that key can read them.`,
+	}
+	return cmd
+}
@@ -0,0 +1,40 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"encoding/hex"
+
+	"github.com/spf13/cobra"
+)
+
+func MakeEncryptionKeyGetCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "get <collection>",
+		Short: "Get the encryption key of a collection",
+		Long: `Get the hex encoded key used to encrypt the encrypted fields of a collection.
+
+Example:
+  defradb client encryption-key get User
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db := mustGetDBContext(cmd)
+
+			key, err := db.GetEncryptionKey(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd, hex.EncodeToString(key))
+		},
+	}
+	return cmd
+}
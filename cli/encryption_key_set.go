@@ -0,0 +1,44 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"encoding/hex"
+
+	"github.com/spf13/cobra"
+)
+
+func MakeEncryptionKeySetCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "set <collection> <key>",
+		Short: "Set the encryption key of a collection",
+		Long: `Set the key used to encrypt the encrypted fields of a collection.
+
+The key must be hex encoded and 16, 24 or 32 bytes long. The same key must be set
+on every node that needs to read the values of the encrypted fields. The key of a
+collection cannot be replaced once it has been set.
+
+Example:
+  defradb client encryption-key set User 000102030405060708090a0b0c0d0e0f
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db := mustGetDBContext(cmd)
+
+			key, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+			return db.SetEncryptionKey(cmd.Context(), args[0], key)
+		},
+	}
+	return cmd
+}
@@ -17,6 +17,8 @@ import (
 const (
 	errInvalidLensConfig        string = "invalid lens configuration"
 	errSchemaVersionNotOfSchema string = "the given schema version is from a different schema"
+	errInvalidChangedSince      string = "invalid changed since time, expected an RFC 3339 time"
+	errInvalidImportPair        string = "invalid import flag, expected a value of the form name:value"
 )
 
 var (
@@ -27,12 +29,21 @@ var (
 	ErrNoLensConfig             = errors.New("lens config cannot be empty")
 	ErrInvalidLensConfig        = errors.New("invalid lens configuration")
 	ErrSchemaVersionNotOfSchema = errors.New(errSchemaVersionNotOfSchema)
+	ErrMissingImportFormat      = errors.New("import format must be defined when reading from stdin")
 )
 
 func NewErrInvalidLensConfig(inner error) error {
 	return errors.Wrap(errInvalidLensConfig, inner)
 }
 
+func NewErrInvalidChangedSince(value string, inner error) error {
+	return errors.Wrap(errInvalidChangedSince, inner, errors.NewKV("Value", value))
+}
+
+func NewErrInvalidImportPair(flag string, value string) error {
+	return errors.New(errInvalidImportPair, errors.NewKV("Flag", flag), errors.NewKV("Value", value))
+}
+
 func NewErrSchemaVersionNotOfSchema(schemaRoot string, schemaVersionID string) error {
 	return errors.New(
 		errSchemaVersionNotOfSchema,
@@ -0,0 +1,25 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func MakePolicyCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "policy",
+		Short: "Manage the authorization policy",
+		Long: `Manage the authorization policy. The policy defines roles, sets of permissions
+optionally scoped to collections, and grants them to authenticated identities.`,
+	}
+	return cmd
+}
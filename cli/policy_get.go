@@ -0,0 +1,37 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func MakePolicyGetCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "get",
+		Short: "Get the authorization policy",
+		Long: `Get the persisted authorization policy.
+
+Example:
+  defradb client policy get
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db := mustGetDBContext(cmd)
+
+			policy, err := db.GetPolicy(cmd.Context())
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd, policy)
+		},
+	}
+	return cmd
+}
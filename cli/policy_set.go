@@ -0,0 +1,71 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func MakePolicySetCommand() *cobra.Command {
+	var policyFile string
+	var cmd = &cobra.Command{
+		Use:   "set [policy]",
+		Short: "Replace the authorization policy",
+		Long: `Replace the persisted authorization policy.
+
+The policy must grant the admin permission to at least one identity.
+Setting an empty policy disables authorization.
+
+Example: set from an argument string:
+  defradb client policy set '{"roles": [{"name": "admin", "permissions": ["admin"]}], "members": {"alice": ["admin"]}}'
+
+Example: set from file:
+  defradb client policy set -f policy.json
+
+Example: set from stdin:
+  cat policy.json | defradb client policy set -`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db := mustGetDBContext(cmd)
+
+			var data []byte
+			var err error
+			switch {
+			case policyFile != "":
+				data, err = os.ReadFile(policyFile)
+			case len(args) > 0 && args[0] == "-":
+				data, err = io.ReadAll(cmd.InOrStdin())
+			case len(args) > 0:
+				data = []byte(args[0])
+			default:
+				return fmt.Errorf("policy cannot be empty")
+			}
+			if err != nil {
+				return err
+			}
+
+			var policy client.Policy
+			if err := json.Unmarshal(data, &policy); err != nil {
+				return err
+			}
+			return db.SetPolicy(cmd.Context(), policy)
+		},
+	}
+	cmd.Flags().StringVarP(&policyFile, "file", "f", "", "File to load a policy from")
+	return cmd
+}
@@ -104,5 +104,23 @@ Start a DefraDB node, interact with a local or remote node, and much more.
 		log.FeedbackFatalE(context.Background(), "Could not bind api.address", err)
 	}
 
+	cmd.PersistentFlags().String(
+		"api-key", cfg.API.ClientAPIKey,
+		"API key used to authenticate with the HTTP endpoint",
+	)
+	err = cfg.BindFlag("api.clientapikey", cmd.PersistentFlags().Lookup("api-key"))
+	if err != nil {
+		log.FeedbackFatalE(context.Background(), "Could not bind api.clientapikey", err)
+	}
+
+	cmd.PersistentFlags().String(
+		"token", cfg.API.ClientToken,
+		"JWT bearer token used to authenticate with the HTTP endpoint",
+	)
+	err = cfg.BindFlag("api.clienttoken", cmd.PersistentFlags().Lookup("token"))
+	if err != nil {
+		log.FeedbackFatalE(context.Background(), "Could not bind api.clienttoken", err)
+	}
+
 	return cmd
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/sourcenetwork/defradb/config"
 	ds "github.com/sourcenetwork/defradb/datastore"
 	badgerds "github.com/sourcenetwork/defradb/datastore/badger/v4"
+	pebbleds "github.com/sourcenetwork/defradb/datastore/pebble"
 	"github.com/sourcenetwork/defradb/db"
 	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/logging"
@@ -49,13 +50,33 @@ func MakeServerDumpCmd(cfg *config.Config) *cobra.Command {
 						cfg.Datastore.Badger.Path,
 					))
 				}
+				encryptionKey, err := cfg.Datastore.LoadEncryptionKey()
+				if err != nil {
+					return err
+				}
 				log.FeedbackInfo(cmd.Context(), "Opening badger store", logging.NewKV("Path", cfg.Datastore.Badger.Path))
-				rootstore, err = badgerds.NewDatastore(cfg.Datastore.Badger.Path, cfg.Datastore.Badger.Options)
+				opts := *cfg.Datastore.Badger.Options
+				opts.Options = opts.Options.WithEncryptionKey(encryptionKey)
+				rootstore, err = badgerds.NewDatastore(cfg.Datastore.Badger.Path, &opts)
 				if err != nil {
 					return errors.Wrap("could not open badger datastore", err)
 				}
+			} else if datastore == pebbleDatastoreName {
+				info, err := os.Stat(cfg.Datastore.Pebble.Path)
+				exists := (err == nil && info.IsDir())
+				if !exists {
+					return errors.New(fmt.Sprintf(
+						"pebble store does not exist at %s. Try with an existing directory",
+						cfg.Datastore.Pebble.Path,
+					))
+				}
+				log.FeedbackInfo(cmd.Context(), "Opening pebble store", logging.NewKV("Path", cfg.Datastore.Pebble.Path))
+				rootstore, err = pebbleds.NewDatastore(cfg.Datastore.Pebble.Path, nil)
+				if err != nil {
+					return errors.Wrap("could not open pebble datastore", err)
+				}
 			} else {
-				return errors.New("server-side dump is only supported for the Badger datastore")
+				return errors.New("server-side dump is only supported for the Badger and Pebble datastores")
 			}
 
 			db, err := db.NewDB(cmd.Context(), rootstore)
@@ -69,7 +90,7 @@ func MakeServerDumpCmd(cfg *config.Config) *cobra.Command {
 	}
 	cmd.Flags().StringVar(
 		&datastore, "store", cfg.Datastore.Store,
-		"Datastore to use. Options are badger, memory",
+		"Datastore to use. Options are badger, pebble, memory",
 	)
 	return cmd
 }
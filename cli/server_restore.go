@@ -0,0 +1,90 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/config"
+	ds "github.com/sourcenetwork/defradb/datastore"
+	badgerds "github.com/sourcenetwork/defradb/datastore/badger/v4"
+	pebbleds "github.com/sourcenetwork/defradb/datastore/pebble"
+	"github.com/sourcenetwork/defradb/db"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/logging"
+)
+
+func MakeServerRestoreCmd(cfg *config.Config) *cobra.Command {
+	var verifyOnly bool
+
+	cmd := &cobra.Command{
+		Use:   "server-restore <snapshot_path>",
+		Short: "Restores the state of the entire database from a snapshot",
+		Long: `Restores the state of the entire database from a snapshot file written by
+the client backup snapshot command.
+
+The snapshot checksum is verified before anything is written to the datastore.
+The datastore must be empty and must not be in use by a running node.
+A Badger datastore is encrypted with the configured encryption key, if any.
+
+Example: verify a snapshot without restoring it
+  defradb server-restore --verify-only /var/backups/defradb.snapshot
+
+Example: restore a snapshot to a new node
+  defradb server-restore --rootdir ~/.defradb-restored /var/backups/defradb.snapshot`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if verifyOnly {
+				info, err := db.VerifySnapshot(args[0])
+				if err != nil {
+					return err
+				}
+				return writeJSON(cmd, info)
+			}
+
+			var rootstore ds.RootStore
+			switch cfg.Datastore.Store {
+			case badgerDatastoreName:
+				encryptionKey, err := cfg.Datastore.LoadEncryptionKey()
+				if err != nil {
+					return err
+				}
+				log.FeedbackInfo(cmd.Context(), "Opening badger store", logging.NewKV("Path", cfg.Datastore.Badger.Path))
+				opts := *cfg.Datastore.Badger.Options
+				opts.Options = opts.Options.WithEncryptionKey(encryptionKey)
+				rootstore, err = badgerds.NewDatastore(cfg.Datastore.Badger.Path, &opts)
+				if err != nil {
+					return errors.Wrap("could not open badger datastore", err)
+				}
+			case pebbleDatastoreName:
+				var err error
+				log.FeedbackInfo(cmd.Context(), "Opening pebble store", logging.NewKV("Path", cfg.Datastore.Pebble.Path))
+				rootstore, err = pebbleds.NewDatastore(cfg.Datastore.Pebble.Path, nil)
+				if err != nil {
+					return errors.Wrap("could not open pebble datastore", err)
+				}
+			default:
+				return errors.New("restore is only supported for the Badger and Pebble datastores")
+			}
+			defer rootstore.Close() //nolint:errcheck
+
+			log.FeedbackInfo(cmd.Context(), "Restoring snapshot", logging.NewKV("Path", args[0]))
+			info, err := db.RestoreSnapshot(cmd.Context(), rootstore, args[0])
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd, info)
+		},
+	}
+	cmd.Flags().BoolVar(&verifyOnly, "verify-only", false,
+		"Only verify the snapshot checksum, without restoring it")
+	return cmd
+}
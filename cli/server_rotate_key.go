@@ -0,0 +1,104 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/config"
+	badgerds "github.com/sourcenetwork/defradb/datastore/badger/v4"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/logging"
+)
+
+func MakeServerRotateKeyCmd(cfg *config.Config) *cobra.Command {
+	var oldKeyPath string
+	var newKeyPath string
+
+	cmd := &cobra.Command{
+		Use:   "server-rotate-key",
+		Short: "Rotates the encryption key of an encrypted datastore",
+		Long: `Rotates the encryption key of an encrypted Badger datastore.
+
+Badger encrypts the data with data keys which are themselves encrypted with the datastore
+key, only the data keys are re-encrypted with the new key. The data itself is not rewritten.
+
+The current key is read from the file given with --old-key-path, or from the datastore
+configuration if not set. The new key is read from the file given with --new-key-path.
+Both are hex encoded 16, 24 or 32 bytes keys.
+An unencrypted datastore can not be encrypted this way, the command fails if no current key
+is set. If no new key is given, the data keys are stored unencrypted and the datastore can be
+opened without a key.
+
+The datastore must not be in use by a running node. The configuration must be updated
+to use the new key once the command completes.
+
+Example: rotate the key of the datastore
+  defradb server-rotate-key --old-key-path old.key --new-key-path new.key`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if cfg.Datastore.Store != badgerDatastoreName {
+				return errors.New("key rotation is only supported for the Badger datastore")
+			}
+			info, err := os.Stat(cfg.Datastore.Badger.Path)
+			if err != nil || !info.IsDir() {
+				return errors.New(fmt.Sprintf(
+					"badger store does not exist at %s. Try with an existing directory",
+					cfg.Datastore.Badger.Path,
+				))
+			}
+
+			var oldKey []byte
+			if oldKeyPath != "" {
+				oldKey, err = readEncryptionKeyFile(oldKeyPath)
+			} else {
+				oldKey, err = cfg.Datastore.LoadEncryptionKey()
+			}
+			if err != nil {
+				return err
+			}
+			var newKey []byte
+			if newKeyPath != "" {
+				newKey, err = readEncryptionKeyFile(newKeyPath)
+				if err != nil {
+					return err
+				}
+			}
+
+			log.FeedbackInfo(cmd.Context(), "Rotating badger store key", logging.NewKV("Path", cfg.Datastore.Badger.Path))
+			err = badgerds.RotateEncryptionKey(cfg.Datastore.Badger.Path, cfg.Datastore.Badger.Options, oldKey, newKey)
+			if err != nil {
+				return err
+			}
+			log.FeedbackInfo(cmd.Context(), "Successfully rotated the datastore encryption key")
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(
+		&newKeyPath, "new-key-path", "",
+		"Path to the file holding the hex encoded key to rotate the datastore key to",
+	)
+	cmd.Flags().StringVar(
+		&oldKeyPath, "old-key-path", "",
+		"Path to the file holding the hex encoded key the datastore is currently encrypted with",
+	)
+	return cmd
+}
+
+func readEncryptionKeyFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(fmt.Sprintf("failed to read encryption key file %v", path), err)
+	}
+	return config.ParseEncryptionKey(string(data))
+}
@@ -20,6 +20,7 @@ import (
 	"strings"
 	"syscall"
 
+	"github.com/libp2p/go-libp2p/core/crypto"
 	badger "github.com/sourcenetwork/badger/v4"
 	"github.com/spf13/cobra"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/sourcenetwork/defradb/config"
 	ds "github.com/sourcenetwork/defradb/datastore"
 	badgerds "github.com/sourcenetwork/defradb/datastore/badger/v4"
+	pebbleds "github.com/sourcenetwork/defradb/datastore/pebble"
 	"github.com/sourcenetwork/defradb/db"
 	"github.com/sourcenetwork/defradb/errors"
 	httpapi "github.com/sourcenetwork/defradb/http"
@@ -35,7 +37,10 @@ import (
 	netutils "github.com/sourcenetwork/defradb/net/utils"
 )
 
-const badgerDatastoreName = "badger"
+const (
+	badgerDatastoreName = "badger"
+	pebbleDatastoreName = "pebble"
+)
 
 func MakeStartCommand(cfg *config.Config) *cobra.Command {
 	var cmd = &cobra.Command{
@@ -71,6 +76,25 @@ func MakeStartCommand(cfg *config.Config) *cobra.Command {
 		log.FeedbackFatalE(context.Background(), "Could not bind net.peers", err)
 	}
 
+	cmd.Flags().String(
+		"trusted-signers", cfg.Net.TrustedSigners,
+		"List of peer IDs whose signed blocks are accepted, and that may assert the identity of the "+
+			"caller that made a change (defaults to the peer sending the blocks, without identities)",
+	)
+	err = cfg.BindFlag("net.trustedsigners", cmd.Flags().Lookup("trusted-signers"))
+	if err != nil {
+		log.FeedbackFatalE(context.Background(), "Could not bind net.trustedsigners", err)
+	}
+
+	cmd.Flags().Bool(
+		"allow-unsigned-blocks", cfg.Net.AllowUnsignedBlocks,
+		"Accept blocks that are not signed, such as those created before block signing",
+	)
+	err = cfg.BindFlag("net.allowunsignedblocks", cmd.Flags().Lookup("allow-unsigned-blocks"))
+	if err != nil {
+		log.FeedbackFatalE(context.Background(), "Could not bind net.allowunsignedblocks", err)
+	}
+
 	cmd.Flags().Int(
 		"max-txn-retries", cfg.Datastore.MaxTxnRetries,
 		"Specify the maximum number of retries per transaction",
@@ -80,9 +104,18 @@ func MakeStartCommand(cfg *config.Config) *cobra.Command {
 		log.FeedbackFatalE(context.Background(), "Could not bind datastore.maxtxnretries", err)
 	}
 
+	cmd.Flags().Uint64(
+		"changelog-retention", cfg.Datastore.ChangeLogRetention,
+		"Specify the maximum number of change log entries retained per collection (0 retains all entries)",
+	)
+	err = cfg.BindFlag("datastore.changelogretention", cmd.Flags().Lookup("changelog-retention"))
+	if err != nil {
+		log.FeedbackFatalE(context.Background(), "Could not bind datastore.changelogretention", err)
+	}
+
 	cmd.Flags().String(
 		"store", cfg.Datastore.Store,
-		"Specify the datastore to use (supported: badger, memory)",
+		"Specify the datastore to use (supported: badger, pebble, memory)",
 	)
 	err = cfg.BindFlag("datastore.store", cmd.Flags().Lookup("store"))
 	if err != nil {
@@ -98,6 +131,15 @@ func MakeStartCommand(cfg *config.Config) *cobra.Command {
 		log.FeedbackFatalE(context.Background(), "Could not bind datastore.badger.valuelogfilesize", err)
 	}
 
+	cmd.Flags().String(
+		"encryption-key-path", cfg.Datastore.EncryptionKeyPath,
+		"Path to the file holding the hex encoded key used to encrypt the datastore at rest",
+	)
+	err = cfg.BindFlag("datastore.encryptionkeypath", cmd.Flags().Lookup("encryption-key-path"))
+	if err != nil {
+		log.FeedbackFatalE(context.Background(), "Could not bind datastore.encryptionkeypath", err)
+	}
+
 	cmd.Flags().String(
 		"p2paddr", cfg.Net.P2PAddress,
 		"Listener address for the p2p network (formatted as a libp2p MultiAddr)",
@@ -160,6 +202,42 @@ func MakeStartCommand(cfg *config.Config) *cobra.Command {
 	if err != nil {
 		log.FeedbackFatalE(context.Background(), "Could not bind api.email", err)
 	}
+
+	cmd.Flags().StringArray(
+		"api-keys", cfg.API.APIKeys,
+		"List of API keys accepted by the server. Usage: --api-keys <identity>=<key>",
+	)
+	err = cfg.BindFlag("api.apikeys", cmd.Flags().Lookup("api-keys"))
+	if err != nil {
+		log.FeedbackFatalE(context.Background(), "Could not bind api.apikeys", err)
+	}
+
+	cmd.Flags().String(
+		"jwks-path", cfg.API.JWKSPath,
+		"Path to the JWKS file used to verify JWT bearer tokens",
+	)
+	err = cfg.BindFlag("api.jwkspath", cmd.Flags().Lookup("jwks-path"))
+	if err != nil {
+		log.FeedbackFatalE(context.Background(), "Could not bind api.jwkspath", err)
+	}
+
+	cmd.Flags().String(
+		"jwt-issuer", cfg.API.JWTIssuer,
+		"Issuer that JWT bearer tokens are required to have",
+	)
+	err = cfg.BindFlag("api.jwtissuer", cmd.Flags().Lookup("jwt-issuer"))
+	if err != nil {
+		log.FeedbackFatalE(context.Background(), "Could not bind api.jwtissuer", err)
+	}
+
+	cmd.Flags().String(
+		"jwt-audience", cfg.API.JWTAudience,
+		"Audience that JWT bearer tokens are required to have",
+	)
+	err = cfg.BindFlag("api.jwtaudience", cmd.Flags().Lookup("jwt-audience"))
+	if err != nil {
+		log.FeedbackFatalE(context.Background(), "Could not bind api.jwtaudience", err)
+	}
 	return cmd
 }
 
@@ -189,16 +267,27 @@ func start(ctx context.Context, cfg *config.Config) (*defraInstance, error) {
 
 	var rootstore ds.RootStore
 
-	var err error
+	encryptionKey, err := cfg.Datastore.LoadEncryptionKey()
+	if err != nil {
+		return nil, err
+	}
+
 	if cfg.Datastore.Store == badgerDatastoreName {
 		log.FeedbackInfo(ctx, "Opening badger store", logging.NewKV("Path", cfg.Datastore.Badger.Path))
+		opts := *cfg.Datastore.Badger.Options
+		opts.Options = opts.Options.WithEncryptionKey(encryptionKey)
 		rootstore, err = badgerds.NewDatastore(
 			cfg.Datastore.Badger.Path,
-			cfg.Datastore.Badger.Options,
+			&opts,
 		)
+	} else if cfg.Datastore.Store == pebbleDatastoreName {
+		log.FeedbackInfo(ctx, "Opening pebble store", logging.NewKV("Path", cfg.Datastore.Pebble.Path))
+		rootstore, err = pebbleds.NewDatastore(cfg.Datastore.Pebble.Path, nil)
 	} else if cfg.Datastore.Store == "memory" {
 		log.FeedbackInfo(ctx, "Building new memory store")
-		opts := badgerds.Options{Options: badger.DefaultOptions("").WithInMemory(true)}
+		opts := badgerds.Options{
+			Options: badger.DefaultOptions("").WithInMemory(true).WithEncryptionKey(encryptionKey),
+		}
 		rootstore, err = badgerds.NewDatastore("", &opts)
 	}
 
@@ -206,9 +295,27 @@ func start(ctx context.Context, cfg *config.Config) (*defraInstance, error) {
 		return nil, errors.Wrap("failed to open datastore", err)
 	}
 
+	// It would be ideal to not have the key path tied to the datastore.
+	// Running with memory store mode will always generate a random key.
+	// Adding support for an ephemeral mode and moving the key to the
+	// config would solve both of these issues.
+	var key crypto.PrivKey
+	if cfg.Datastore.Store == badgerDatastoreName {
+		key, err = loadOrGeneratePrivateKey(filepath.Join(cfg.Rootdir, "data", "key"))
+	} else if cfg.Datastore.Store == pebbleDatastoreName {
+		key, err = loadOrGeneratePrivateKey(filepath.Join(cfg.Datastore.Pebble.Path, "key"))
+	} else {
+		key, _, err = crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	options := []db.Option{
 		db.WithUpdateEvents(),
+		db.WithSigningKey(key),
 		db.WithMaxRetries(cfg.Datastore.MaxTxnRetries),
+		db.WithChangeLogRetention(cfg.Datastore.ChangeLogRetention),
 	}
 
 	db, err := db.NewDB(ctx, rootstore, options...)
@@ -221,17 +328,7 @@ func start(ctx context.Context, cfg *config.Config) (*defraInstance, error) {
 	if !cfg.Net.P2PDisabled {
 		nodeOpts := []net.NodeOpt{
 			net.WithConfig(cfg),
-		}
-		if cfg.Datastore.Store == badgerDatastoreName {
-			// It would be ideal to not have the key path tied to the datastore.
-			// Running with memory store mode will always generate a random key.
-			// Adding support for an ephemeral mode and moving the key to the
-			// config would solve both of these issues.
-			key, err := loadOrGeneratePrivateKey(filepath.Join(cfg.Rootdir, "data", "key"))
-			if err != nil {
-				return nil, err
-			}
-			nodeOpts = append(nodeOpts, net.WithPrivateKey(key))
+			net.WithPrivateKey(key),
 		}
 		log.FeedbackInfo(ctx, "Starting P2P node", logging.NewKV("P2P address", cfg.Net.P2PAddress))
 		node, err = net.NewNode(ctx, db, nodeOpts...)
@@ -272,6 +369,12 @@ func start(ctx context.Context, cfg *config.Config) (*defraInstance, error) {
 		)
 	}
 
+	authOpts, err := getAuthenticatorOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+	sOpt = append(sOpt, authOpts...)
+
 	var server *httpapi.Server
 	if node != nil {
 		server, err = httpapi.NewServer(node, sOpt...)
@@ -325,3 +428,34 @@ func wait(ctx context.Context, di *defraInstance) error {
 		return ctx.Err()
 	}
 }
+
+// getAuthenticatorOptions returns the server options enabling the authenticators configured
+// in the given config.
+func getAuthenticatorOptions(cfg *config.Config) ([]func(*httpapi.Server), error) {
+	var opts []func(*httpapi.Server)
+
+	keys, err := cfg.API.ParseAPIKeys()
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) > 0 {
+		opts = append(opts, httpapi.WithAuthenticator(httpapi.NewAPIKeyAuthenticator(keys)))
+	}
+
+	if cfg.API.JWKSPath != "" {
+		jwks, err := os.ReadFile(cfg.API.JWKSPath)
+		if err != nil {
+			return nil, errors.Wrap(fmt.Sprintf("failed to read JWKS file %v", cfg.API.JWKSPath), err)
+		}
+		auth, err := httpapi.NewJWTAuthenticator(jwks, httpapi.JWTOptions{
+			Issuer:   cfg.API.JWTIssuer,
+			Audience: cfg.API.JWTAudience,
+		})
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, httpapi.WithAuthenticator(auth))
+	}
+
+	return opts, nil
+}
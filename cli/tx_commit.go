@@ -30,7 +30,7 @@ func MakeTxCommitCommand(cfg *config.Config) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			tx, err := http.NewTransaction(cfg.API.Address, id)
+			tx, err := http.NewTransaction(cfg.API.Address, id, getClientOptions(cfg)...)
 			if err != nil {
 				return err
 			}
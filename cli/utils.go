@@ -52,6 +52,13 @@ func mustGetStoreContext(cmd *cobra.Command) client.Store {
 	return cmd.Context().Value(storeContextKey).(client.Store)
 }
 
+// mustGetDBContext returns the db for the current command context.
+//
+// If a db is not set in the current context this function panics.
+func mustGetDBContext(cmd *cobra.Command) client.DB {
+	return cmd.Context().Value(dbContextKey).(client.DB)
+}
+
 // mustGetP2PContext returns the p2p implementation for the current command context.
 //
 // If a p2p implementation is not set in the current context this function panics.
@@ -66,12 +73,25 @@ func tryGetCollectionContext(cmd *cobra.Command) (client.Collection, bool) {
 	return col, ok
 }
 
+// getClientOptions returns the options for authenticating HTTP clients with the
+// credentials set in the given config.
+func getClientOptions(cfg *config.Config) []http.ClientOption {
+	var opts []http.ClientOption
+	if cfg.API.ClientAPIKey != "" {
+		opts = append(opts, http.WithAPIKey(cfg.API.ClientAPIKey))
+	}
+	if cfg.API.ClientToken != "" {
+		opts = append(opts, http.WithBearerToken(cfg.API.ClientToken))
+	}
+	return opts
+}
+
 // setTransactionContext sets the transaction for the current command context.
 func setTransactionContext(cmd *cobra.Command, cfg *config.Config, txId uint64) error {
 	if txId == 0 {
 		return nil
 	}
-	tx, err := http.NewTransaction(cfg.API.Address, txId)
+	tx, err := http.NewTransaction(cfg.API.Address, txId, getClientOptions(cfg)...)
 	if err != nil {
 		return err
 	}
@@ -82,7 +102,7 @@ func setTransactionContext(cmd *cobra.Command, cfg *config.Config, txId uint64)
 
 // setStoreContext sets the store for the current command context.
 func setStoreContext(cmd *cobra.Command, cfg *config.Config) error {
-	db, err := http.NewClient(cfg.API.Address)
+	db, err := http.NewClient(cfg.API.Address, getClientOptions(cfg)...)
 	if err != nil {
 		return err
 	}
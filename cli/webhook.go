@@ -0,0 +1,26 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func MakeWebhookCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "webhook",
+		Short: "Configure the webhook system",
+		Long: `Configure the webhook system. Add, delete, or get the list of persisted webhooks,
+or inspect the payloads that could not be delivered.
+A webhook posts the changes made to the documents of a collection to an HTTP endpoint.`,
+	}
+	return cmd
+}
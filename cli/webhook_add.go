@@ -0,0 +1,58 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func MakeWebhookAddCommand() *cobra.Command {
+	var collection string
+	var filter string
+	var secret string
+	var cmd = &cobra.Command{
+		Use:   "add -c <collection> [--filter <filter>] [--secret <secret>] <url>",
+		Short: "Add a webhook",
+		Long: `Add a webhook that the changes made to the documents of a collection are posted to.
+
+If a filter is given, only changes resulting in a document version matching the filter
+are posted. If a secret is given, the hex encoded HMAC-SHA256 of each request body is sent
+in the X-Defra-Signature header.
+
+Example:
+  defradb client webhook add -c Users https://example.com/hook
+
+Example: with filter and secret
+  defradb client webhook add -c Users --filter '{age: {_gt: 20}}' --secret s3cret https://example.com/hook
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db := mustGetDBContext(cmd)
+
+			hook, err := db.AddWebhook(cmd.Context(), client.Webhook{
+				Collection: collection,
+				Filter:     filter,
+				URL:        args[0],
+				Secret:     secret,
+			})
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd, hook)
+		},
+	}
+	cmd.Flags().StringVarP(&collection, "collection", "c", "", "Collection whose changes are posted")
+	cmd.Flags().StringVar(&filter, "filter", "", "Filter that changed documents must match")
+	cmd.Flags().StringVar(&secret, "secret", "", "Secret used to sign request bodies")
+	return cmd
+}
@@ -0,0 +1,38 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func MakeWebhookDeadLettersCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "deadletters",
+		Short: "Get all undelivered webhook payloads",
+		Long: `Get all the payloads that could not be delivered to their webhook
+after all retry attempts were exhausted, oldest first.
+
+Example:
+  defradb client webhook deadletters
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db := mustGetDBContext(cmd)
+
+			letters, err := db.GetAllWebhookDeadLetters(cmd.Context())
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd, letters)
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,33 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func MakeWebhookDeleteCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete a webhook",
+		Long: `Delete a webhook and stop posting changes to it.
+
+Example:
+  defradb client webhook delete 6b4d3a1e-8b2f-4c3d-9e1a-2f3b4c5d6e7f
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db := mustGetDBContext(cmd)
+			return db.DeleteWebhook(cmd.Context(), args[0])
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,37 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func MakeWebhookGetAllCommand() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "getall",
+		Short: "Get all webhooks",
+		Long: `Get all the persisted webhooks. Webhook secrets are not returned.
+
+Example:
+  defradb client webhook getall
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db := mustGetDBContext(cmd)
+
+			hooks, err := db.GetAllWebhooks(cmd.Context())
+			if err != nil {
+				return err
+			}
+			return writeJSON(cmd, hooks)
+		},
+	}
+	return cmd
+}
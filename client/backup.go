@@ -12,6 +12,25 @@ package client
 
 import (
 	"context"
+	"io"
+	"time"
+)
+
+// The supported backup formats.
+const (
+	// BackupFormatJSON is a single JSON object holding an array of documents per collection.
+	BackupFormatJSON = "json"
+	// BackupFormatNDJSON is a JSON object per line, each holding a collection name and a document.
+	BackupFormatNDJSON = "ndjson"
+	// BackupFormatCBOR is a sequence of CBOR maps, each holding a collection name and a document.
+	BackupFormatCBOR = "cbor"
+)
+
+// The supported backup compressions.
+const (
+	BackupCompressionNone = ""
+	BackupCompressionGzip = "gzip"
+	BackupCompressionZstd = "zstd"
 )
 
 // Backup contains DefraDB's supported backup operations.
@@ -21,16 +40,53 @@ type Backup interface {
 	BasicImport(ctx context.Context, filepath string) error
 	// BasicExport exports the current data or subset of data to file in json format.
 	BasicExport(ctx context.Context, config *BackupConfig) error
+	// BasicImportFrom imports a dataset read from the given reader.
+	//
+	// Only the format, compression and batch size of the config are used. If no compression
+	// is set, it is detected from the content.
+	BasicImportFrom(ctx context.Context, config *BackupConfig, r io.Reader) error
+	// BasicExportTo exports the current data or subset of data to the given writer.
+	//
+	// The filepath of the config is ignored.
+	BasicExportTo(ctx context.Context, config *BackupConfig, w io.Writer) error
 }
 
 // BackupConfig holds the configuration parameters for database backups.
 type BackupConfig struct {
 	// If a file already exists at this location, it will be truncated and overwriten.
 	Filepath string `json:"filepath"`
-	// Only JSON is supported at the moment.
+	// Format of the backup, one of json (default), ndjson or cbor.
+	//
+	// When importing from a file, the format is detected from the file extension.
 	Format string `json:"format"`
+	// Compression of the backup, one of gzip or zstd. The backup is not compressed by default.
+	Compression string `json:"compression"`
 	// Pretty print JSON.
 	Pretty bool `json:"pretty"`
 	// List of collection names to select which one to backup.
 	Collections []string `json:"collections"`
+	// Filter is an optional GraphQL filter selecting the documents to backup.
+	//
+	// It is applied to every exported collection.
+	Filter string `json:"filter"`
+	// ChangedSince, if set, only selects the documents changed by this node since the given time.
+	//
+	// The current state of the changed documents is exported, deleted documents are not.
+	ChangedSince time.Time `json:"changedSince"`
+	// BatchSize is the number of documents imported per transaction, it defaults to
+	// DefaultImportBatchSize.
+	//
+	// It is ignored if the import is made within a transaction, all the documents are
+	// then imported within that transaction.
+	BatchSize int `json:"batchSize"`
+}
+
+// SnapshotInfo describes a snapshot of the whole node state.
+type SnapshotInfo struct {
+	// Filepath is the location of the snapshot file on the node.
+	Filepath string `json:"filepath"`
+	// Entries is the number of datastore entries held by the snapshot.
+	Entries uint64 `json:"entries"`
+	// Checksum is the hex encoded SHA-256 checksum of the snapshot file contents.
+	Checksum string `json:"checksum"`
 }
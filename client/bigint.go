@@ -0,0 +1,55 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package client
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+)
+
+// ParseBigInt returns the integer written in base ten in the given string.
+func ParseBigInt(s string) (*big.Int, error) {
+	i, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, NewErrInvalidBigInt(s)
+	}
+	return i, nil
+}
+
+// NewBigIntFromValue returns the integer equal to the given value, which may be a big integer,
+// a string, an integer or an integral float.
+func NewBigIntFromValue(value any) (*big.Int, error) {
+	switch v := value.(type) {
+	case *big.Int:
+		return v, nil
+	case big.Int:
+		return &v, nil
+	case string:
+		return ParseBigInt(v)
+	case json.Number:
+		return ParseBigInt(string(v))
+	case int64:
+		return big.NewInt(v), nil
+	case int:
+		return big.NewInt(int64(v)), nil
+	case uint64:
+		return new(big.Int).SetUint64(v), nil
+	case float64:
+		if v != math.Trunc(v) || math.IsInf(v, 0) {
+			return nil, NewErrInvalidBigInt(v)
+		}
+		i, _ := big.NewFloat(v).Int(nil)
+		return i, nil
+	default:
+		return nil, NewErrInvalidBigInt(value)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package client
+
+// Change is an entry in the persistent change log of a collection.
+//
+// A new change is recorded for every composite block merged into a document of the
+// collection, regardless of whether the block was created locally or received from a peer.
+type Change struct {
+	// Seq is the position of this change within the change log of the collection.
+	//
+	// It is assigned once the transaction writing the change has been committed and
+	// is monotonically increasing, it may be used as a cursor when calling ChangesSince.
+	Seq uint64 `json:"seq"`
+	// DocKey is the key of the document that was changed.
+	DocKey string `json:"docKey"`
+	// Cid is the CID of the composite block holding the change.
+	Cid string `json:"cid"`
+	// SchemaVersionID is the schema version of the document at the time of the change.
+	SchemaVersionID string `json:"schemaVersionId"`
+	// Height is the height of the change within the Merkle DAG of the document.
+	Height uint64 `json:"height"`
+	// Deleted is true if the change deleted the document.
+	Deleted bool `json:"deleted"`
+}
+
+// ChangesResult wraps the changes returned by a ChangesSince call.
+type ChangesResult struct {
+	// Changes are the changes after the given cursor, in sequence order.
+	Changes []Change `json:"changes"`
+	// Cursor is the sequence number of the last change read, to be used as the cursor of
+	// the next call. It is the given cursor if no change was read.
+	Cursor uint64 `json:"cursor"`
+}
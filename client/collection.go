@@ -12,6 +12,7 @@ package client
 
 import (
 	"context"
+	"io"
 
 	"github.com/sourcenetwork/defradb/datastore"
 )
@@ -106,6 +107,19 @@ type Collection interface {
 	// Returns an ErrDocumentNotFound if a document is not found for any given DocKey.
 	UpdateWithKeys(context.Context, []DocKey, string) (*UpdateResult, error)
 
+	// Upsert updates the single document matching the given filter using the given updater,
+	// or creates the given document if no document matches the filter.
+	//
+	// The filter may target any field holding a natural key of the documents, such as an email
+	// address. The match and the write are made within a single transaction. Concurrent upserts
+	// with the same filter that would both create a document conflict, and all but the first to
+	// commit fail with a transaction conflict error and may be retried.
+	//
+	// The provided updater must be a string Merge Patch, else an ErrInvalidUpdater will be
+	// returned. Returns an ErrMultipleUpsertMatches error if more than one document matches
+	// the filter.
+	Upsert(ctx context.Context, filter any, create *Document, updater string) (*UpsertResult, error)
+
 	// DeleteWith deletes a target document.
 	//
 	// Target can be a Filter statement, a single docKey, a single document, an array of docKeys,
@@ -141,6 +155,33 @@ type Collection interface {
 	// Returns an ErrDocumentNotFound if a document matching the given DocKey is not found.
 	Get(ctx context.Context, key DocKey, showDeleted bool) (*Document, error)
 
+	// Diff returns the fields whose values differ between the two given versions of the
+	// document with the given DocKey, ordered by field name.
+	//
+	// The versions are identified by the CIDs of their composite commits. An empty fromCID
+	// compares against the state before the document was created, and an empty toCID compares
+	// against the current state of the document. Each version is reconstructed from its own
+	// history, so versions on concurrent branches may be compared.
+	Diff(ctx context.Context, key DocKey, fromCID string, toCID string) ([]FieldDiff, error)
+
+	// Revert sets the document with the given DocKey back to its state at the given version.
+	//
+	// The version is identified by the CID of its composite commit. The revert is written as
+	// a new update on top of the current heads, so it replicates like any other update, and
+	// documents that have since been deleted are undeleted.
+	//
+	// Returns an ErrDocumentNotFound if a document matching the given DocKey is not found.
+	Revert(ctx context.Context, key DocKey, cid string) error
+
+	// Import creates a document for every row of the CSV or NDJSON data read from the given reader.
+	//
+	// The values of the rows are converted to the kind of the fields they are imported into.
+	// Rows that cannot be imported are rejected and returned in the result, and do not
+	// prevent the other rows from being imported. An error is only returned if the data
+	// cannot be read, or if a document fails to be created within a transaction as its
+	// partial writes cannot be undone without discarding the transaction.
+	Import(ctx context.Context, config ImportConfig, r io.Reader) (ImportResult, error)
+
 	// WithTxn returns a new instance of the collection, with a transaction
 	// handle instead of a raw DB handle.
 	WithTxn(datastore.Txn) Collection
@@ -178,6 +219,14 @@ type UpdateResult struct {
 	DocKeys []string
 }
 
+// UpsertResult wraps the result of an upsert call.
+type UpsertResult struct {
+	// DocKey is the DocKey of the document updated or created by the upsert call.
+	DocKey string
+	// Created is true if no document matched the filter, and the document was created.
+	Created bool
+}
+
 // DeleteResult wraps the result of an delete call.
 type DeleteResult struct {
 	// Count contains the number of documents deleted by the delete call.
@@ -0,0 +1,164 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package client
+
+import (
+	"encoding/json"
+	"math"
+	"regexp"
+)
+
+// The names of the field constraint rules, as given to the `@constraint` directive.
+const (
+	ConstraintMinLength = "minLength"
+	ConstraintMaxLength = "maxLength"
+	ConstraintPattern   = "pattern"
+	ConstraintMin       = "min"
+	ConstraintMax       = "max"
+	ConstraintOneOf     = "oneOf"
+	ConstraintMinItems  = "minItems"
+	ConstraintMaxItems  = "maxItems"
+)
+
+// Constraints are the validation rules of the values of a field.
+//
+// String rules apply to string fields and to the items of string arrays, numeric rules apply to
+// numeric fields and to the items of numeric arrays. Null values are never validated.
+type Constraints struct {
+	// MinLength is the minimum number of characters of a string.
+	MinLength *int `json:"minLength,omitempty"`
+	// MaxLength is the maximum number of characters of a string.
+	MaxLength *int `json:"maxLength,omitempty"`
+	// Pattern is a regular expression strings must match.
+	Pattern string `json:"pattern,omitempty"`
+	// Min is the minimum value of a number.
+	Min *float64 `json:"min,omitempty"`
+	// Max is the maximum value of a number.
+	Max *float64 `json:"max,omitempty"`
+	// OneOf holds the allowed values of a string or a number.
+	OneOf []any `json:"oneOf,omitempty"`
+	// MinItems is the minimum number of items of an array.
+	MinItems *int `json:"minItems,omitempty"`
+	// MaxItems is the maximum number of items of an array.
+	MaxItems *int `json:"maxItems,omitempty"`
+}
+
+// IsEmpty returns true if no rule is set.
+func (c Constraints) IsEmpty() bool {
+	return c.MinLength == nil && c.MaxLength == nil && c.Pattern == "" && c.Min == nil &&
+		c.Max == nil && len(c.OneOf) == 0 && c.MinItems == nil && c.MaxItems == nil
+}
+
+// Validate returns an error if the rules do not apply to the given field kind, or are
+// inconsistent.
+func (c Constraints) Validate(kind FieldKind) error {
+	isString := kind == FieldKind_STRING || kind == FieldKind_STRING_ARRAY ||
+		kind == FieldKind_NILLABLE_STRING_ARRAY
+	isNumber := kind == FieldKind_INT || kind == FieldKind_INT_ARRAY ||
+		kind == FieldKind_NILLABLE_INT_ARRAY || kind == FieldKind_FLOAT ||
+		kind == FieldKind_FLOAT_ARRAY || kind == FieldKind_NILLABLE_FLOAT_ARRAY
+	isArray := FieldDescription{Kind: kind}.IsArray()
+
+	rules := []struct {
+		name       string
+		isSet      bool
+		applicable bool
+	}{
+		{ConstraintMinLength, c.MinLength != nil, isString},
+		{ConstraintMaxLength, c.MaxLength != nil, isString},
+		{ConstraintPattern, c.Pattern != "", isString},
+		{ConstraintMin, c.Min != nil, isNumber},
+		{ConstraintMax, c.Max != nil, isNumber},
+		{ConstraintOneOf, len(c.OneOf) > 0, isString || isNumber},
+		{ConstraintMinItems, c.MinItems != nil, isArray},
+		{ConstraintMaxItems, c.MaxItems != nil, isArray},
+	}
+	for _, rule := range rules {
+		if rule.isSet && !rule.applicable {
+			return NewErrInvalidConstraint(rule.name, kind)
+		}
+	}
+
+	if c.MinLength != nil && c.MaxLength != nil && *c.MinLength > *c.MaxLength {
+		return NewErrInvalidConstraint(ConstraintMinLength, kind)
+	}
+	if c.Min != nil && c.Max != nil && *c.Min > *c.Max {
+		return NewErrInvalidConstraint(ConstraintMin, kind)
+	}
+	if c.MinItems != nil && c.MaxItems != nil && *c.MinItems > *c.MaxItems {
+		return NewErrInvalidConstraint(ConstraintMinItems, kind)
+	}
+	if c.Pattern != "" {
+		if _, err := regexp.Compile(c.Pattern); err != nil {
+			return NewErrInvalidConstraintPattern(err, c.Pattern)
+		}
+	}
+	for _, value := range c.OneOf {
+		switch v := value.(type) {
+		case string:
+			if !isString {
+				return NewErrInvalidConstraint(ConstraintOneOf, kind)
+			}
+		case float64:
+			isInt := kind == FieldKind_INT || kind == FieldKind_INT_ARRAY || kind == FieldKind_NILLABLE_INT_ARRAY
+			if !isNumber || (isInt && v != math.Trunc(v)) {
+				return NewErrInvalidConstraint(ConstraintOneOf, kind)
+			}
+		default:
+			return NewErrInvalidConstraint(ConstraintOneOf, kind)
+		}
+	}
+	return nil
+}
+
+// FieldConstraints are the JSON encoded [Constraints] of a field.
+//
+// The constraints are kept encoded so that field descriptions remain comparable, and are
+// serialized as the JSON object itself.
+type FieldConstraints string
+
+// NewFieldConstraints returns the encoded form of the given constraints, it is empty if no
+// rule is set.
+func NewFieldConstraints(constraints Constraints) (FieldConstraints, error) {
+	if constraints.IsEmpty() {
+		return "", nil
+	}
+	data, err := json.Marshal(constraints)
+	if err != nil {
+		return "", err
+	}
+	return FieldConstraints(data), nil
+}
+
+// Decode returns the decoded constraints.
+func (c FieldConstraints) Decode() (Constraints, error) {
+	var constraints Constraints
+	if c == "" {
+		return constraints, nil
+	}
+	err := json.Unmarshal([]byte(c), &constraints)
+	return constraints, err
+}
+
+// MarshalJSON returns the JSON encoded constraints.
+func (c FieldConstraints) MarshalJSON() ([]byte, error) {
+	return marshalRawJSON(string(c)), nil
+}
+
+// UnmarshalJSON stores the given JSON object in its compacted form.
+func (c *FieldConstraints) UnmarshalJSON(data []byte) error {
+	raw, err := unmarshalRawJSON(data)
+	if err != nil {
+		return err
+	}
+	*c = FieldConstraints(raw)
+	return nil
+}
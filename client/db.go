@@ -79,6 +79,62 @@ type DB interface {
 	// Currently this is only used within the P2P system and will not affect operations initiated by users.
 	MaxTxnRetries() int
 
+	// AddWebhook adds the given webhook to the persisted list, returning it with its assigned ID.
+	//
+	// Changes to documents of the webhook's collection will be posted to its URL for as long as
+	// it exists. Deliveries are retried with exponential backoff, payloads that could not be
+	// delivered are kept in the dead-letter list returned by [GetAllWebhookDeadLetters].
+	// Changes are delivered to each webhook one at a time, in the order they were made. Changes
+	// made while the delivery queue of the webhook is full are added to the dead-letter list
+	// directly, so that a slow webhook never holds back writes.
+	//
+	// An error is returned if the database has not been configured with update events.
+	AddWebhook(ctx context.Context, hook Webhook) (Webhook, error)
+
+	// DeleteWebhook deletes the webhook with the given ID from the persisted list.
+	DeleteWebhook(ctx context.Context, id string) error
+
+	// GetAllWebhooks returns the full list of webhooks.
+	//
+	// Webhook secrets are not included.
+	GetAllWebhooks(ctx context.Context) ([]Webhook, error)
+
+	// GetAllWebhookDeadLetters returns the full list of payloads that could not be delivered
+	// to their webhook.
+	GetAllWebhookDeadLetters(ctx context.Context) ([]WebhookDeadLetter, error)
+
+	// SetPolicy replaces the persisted authorization policy with the given one.
+	//
+	// The policy is enforced for authenticated HTTP API requests. It must grant the admin
+	// permission to at least one identity, unless it is empty in which case it is not enforced.
+	SetPolicy(ctx context.Context, policy Policy) error
+
+	// GetPolicy returns the persisted authorization policy.
+	//
+	// An empty policy is returned if none has been set.
+	GetPolicy(ctx context.Context) (Policy, error)
+
+	// SetEncryptionKey sets the key used to encrypt and decrypt the values of the encrypted
+	// fields of the collection with the given name.
+	//
+	// The key must be 16, 24 or 32 bytes long. The same key must be set on every node that
+	// needs to read the values of these fields, nodes without it only replicate their ciphertext.
+	// The key cannot be replaced once set, an error is returned if a key has already been set.
+	SetEncryptionKey(ctx context.Context, collectionName string, key []byte) error
+
+	// GetEncryptionKey returns the key used to encrypt the values of the encrypted fields of
+	// the collection with the given name.
+	//
+	// Nil is returned if no key has been set.
+	GetEncryptionKey(ctx context.Context, collectionName string) ([]byte, error)
+
+	// CreateSnapshot writes a consistent snapshot of the entire contents of the rootstore
+	// (data, heads, system and DAG stores) to the file at the given path on the node.
+	//
+	// The snapshot is taken from a read transaction, the node does not need to be stopped.
+	// If a file already exists at the given path it will be replaced.
+	CreateSnapshot(ctx context.Context, filepath string) (SnapshotInfo, error)
+
 	// PrintDump logs the entire contents of the rootstore (all the data managed by this DefraDB instance).
 	//
 	// It is likely unwise to call this on a large database instance.
@@ -182,6 +238,16 @@ type Store interface {
 
 	// ExecRequest executes the given GQL request against the [Store].
 	ExecRequest(context.Context, string) *RequestResult
+
+	// ChangesSince returns at most limit of the entries in the persistent change log of the named
+	// collection with a sequence number greater than the given cursor, in sequence order.
+	//
+	// A cursor of zero will return all retained changes, and a limit of zero no limit. The returned
+	// cursor may be used as the cursor of the next call to resume from where the caller left off.
+	// Changes older than the configured retention may have been removed from the log.
+	//
+	// Changes to documents the caller may not read are skipped.
+	ChangesSince(ctx context.Context, collectionName string, cursor uint64, limit uint64) (ChangesResult, error)
 }
 
 // GQLResult represents the immediate results of a GQL request.
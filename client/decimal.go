@@ -0,0 +1,238 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package client
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// DecimalDivisionScale is the number of decimal places the non terminating results of
+// decimal divisions, such as averages, are rounded to.
+const DecimalDivisionScale = 18
+
+// CBORTagDecimalFraction is the CBOR tag of decimal fractions, encoded as the array of their
+// base ten exponent and their integer mantissa.
+const CBORTagDecimalFraction = 4
+
+// MaxDecimalExponent is the greatest absolute exponent of the decimals written in scientific
+// notation, bounding the size of the parsed numbers.
+const MaxDecimalExponent = 10000
+
+var decimalPattern = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)(?:[eE]([+-]?\d+))?$`)
+
+// Decimal is an exact decimal number of arbitrary precision.
+//
+// Decimals are held in their normalized form, so that equal decimals are deeply equal. The
+// zero value is 0.
+type Decimal struct {
+	// mantissa is the integer the decimal is a power of ten multiple of, with no trailing
+	// zero, it is nil for 0.
+	mantissa *big.Int
+	exponent int64
+}
+
+// NewDecimal returns the decimal equal to mantissa * 10^exponent.
+func NewDecimal(mantissa *big.Int, exponent int64) Decimal {
+	if mantissa.Sign() == 0 {
+		return Decimal{}
+	}
+	m := new(big.Int).Set(mantissa)
+	ten := big.NewInt(10)
+	quo, rem := new(big.Int), new(big.Int)
+	for {
+		quo.QuoRem(m, ten, rem)
+		if rem.Sign() != 0 {
+			break
+		}
+		m.Set(quo)
+		exponent++
+	}
+	return Decimal{mantissa: m, exponent: exponent}
+}
+
+// NewDecimalFromRat returns the decimal equal to the given rational number, it is rounded
+// half away from zero to [DecimalDivisionScale] decimal places if it has no finite decimal
+// representation.
+func NewDecimalFromRat(rat *big.Rat) Decimal {
+	scale, isFinite := decimalScale(rat)
+	num := new(big.Int).Mul(rat.Num(), pow10(scale))
+	quo, rem := new(big.Int).QuoRem(num, rat.Denom(), new(big.Int))
+	if !isFinite && rem.Abs(rem).Lsh(rem, 1).Cmp(rat.Denom()) >= 0 {
+		quo.Add(quo, big.NewInt(int64(rat.Sign())))
+	}
+	return NewDecimal(quo, -scale)
+}
+
+// ParseDecimal returns the decimal written in the given string, in plain or scientific notation.
+func ParseDecimal(s string) (Decimal, error) {
+	match := decimalPattern.FindStringSubmatch(s)
+	if match == nil {
+		return Decimal{}, NewErrInvalidDecimal(s)
+	}
+	if exponent := match[3]; exponent != "" {
+		e, err := strconv.ParseInt(exponent, 10, 64)
+		if err != nil || abs(e) > MaxDecimalExponent {
+			return Decimal{}, NewErrInvalidDecimal(s)
+		}
+	}
+	rat, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Decimal{}, NewErrInvalidDecimal(s)
+	}
+	return NewDecimalFromRat(rat), nil
+}
+
+// NewDecimalFromValue returns the decimal equal to the given value, which may be a decimal, a
+// string, an integer or a float.
+//
+// Floats are converted from the shortest decimal representation that rounds to them.
+func NewDecimalFromValue(value any) (Decimal, error) {
+	switch v := value.(type) {
+	case Decimal:
+		return v, nil
+	case *Decimal:
+		return *v, nil
+	case string:
+		return ParseDecimal(v)
+	case json.Number:
+		return ParseDecimal(string(v))
+	case int64:
+		return NewDecimal(big.NewInt(v), 0), nil
+	case int:
+		return NewDecimal(big.NewInt(int64(v)), 0), nil
+	case uint64:
+		return NewDecimal(new(big.Int).SetUint64(v), 0), nil
+	case *big.Int:
+		return NewDecimal(v, 0), nil
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return Decimal{}, NewErrInvalidDecimal(v)
+		}
+		return ParseDecimal(strconv.FormatFloat(v, 'g', -1, 64))
+	default:
+		return Decimal{}, NewErrInvalidDecimal(value)
+	}
+}
+
+// Rat returns the decimal as a rational number.
+func (d Decimal) Rat() *big.Rat {
+	if d.mantissa == nil {
+		return new(big.Rat)
+	}
+	rat := new(big.Rat).SetInt(d.mantissa)
+	pow := new(big.Rat).SetInt(pow10(abs(d.exponent)))
+	if d.exponent < 0 {
+		return rat.Quo(rat, pow)
+	}
+	return rat.Mul(rat, pow)
+}
+
+// Cmp returns -1, 0 or +1 if the decimal is lower than, equal to, or greater than the other.
+func (d Decimal) Cmp(other Decimal) int {
+	return d.Rat().Cmp(other.Rat())
+}
+
+// Float64 returns the nearest float to the decimal.
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+// Parts returns the mantissa and the exponent of the decimal, the mantissa having no trailing
+// zero.
+func (d Decimal) Parts() (*big.Int, int64) {
+	if d.mantissa == nil {
+		return new(big.Int), 0
+	}
+	return new(big.Int).Set(d.mantissa), d.exponent
+}
+
+// String returns the decimal in plain notation, with no trailing zero after the decimal point.
+func (d Decimal) String() string {
+	if d.exponent >= 0 {
+		return d.Rat().FloatString(0)
+	}
+	return d.Rat().FloatString(int(-d.exponent))
+}
+
+// MarshalJSON returns the decimal as a JSON string, so that no precision is lost to the
+// consumers reading JSON numbers as floats.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON reads the decimal from a JSON string or number.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	var value any
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return err
+	}
+	if _, isString := value.(string); !isString {
+		value = json.Number(data)
+	}
+	*d, err = NewDecimalFromValue(value)
+	return err
+}
+
+// MarshalCBOR returns the decimal as a CBOR decimal fraction.
+func (d Decimal) MarshalCBOR() ([]byte, error) {
+	mantissa, exponent := d.Parts()
+	return cbor.Marshal(cbor.Tag{
+		Number:  CBORTagDecimalFraction,
+		Content: []any{exponent, mantissa},
+	})
+}
+
+// decimalScale returns the lowest number of decimal places the given number can be written
+// with, and false if it has no finite decimal representation.
+func decimalScale(rat *big.Rat) (int64, bool) {
+	denom := new(big.Int).Set(rat.Denom())
+	var twos, fives int64
+	for denom.Bit(0) == 0 {
+		denom.Rsh(denom, 1)
+		twos++
+	}
+	five := big.NewInt(5)
+	mod := new(big.Int)
+	for {
+		quo, rem := new(big.Int).QuoRem(denom, five, mod)
+		if rem.Sign() != 0 {
+			break
+		}
+		denom = quo
+		fives++
+	}
+	if denom.Cmp(big.NewInt(1)) != 0 {
+		return DecimalDivisionScale, false
+	}
+	if twos > fives {
+		return twos, true
+	}
+	return fives, true
+}
+
+func pow10(n int64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(n), nil)
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,96 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package client
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDecimal(t *testing.T) {
+	cases := map[string]string{
+		"1.50":                         "1.5",
+		"-0.000":                       "0",
+		"1e3":                          "1000",
+		".25":                          "0.25",
+		"+12.5E-3":                     "0.0125",
+		"123456789.123456789123456789": "123456789.123456789123456789",
+	}
+	for input, expected := range cases {
+		d, err := ParseDecimal(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, d.String(), input)
+	}
+
+	for _, input := range []string{"", "ten", "1/2", "1e", "1e100000", "NaN"} {
+		_, err := ParseDecimal(input)
+		assert.ErrorIs(t, err, ErrInvalidDecimal, input)
+	}
+}
+
+func TestDecimal_EqualDecimalsAreDeeplyEqual(t *testing.T) {
+	a, err := ParseDecimal("2.50")
+	require.NoError(t, err)
+	b := NewDecimal(big.NewInt(250), -2)
+	c, err := NewDecimalFromValue(2.5)
+	require.NoError(t, err)
+
+	assert.Equal(t, a, b)
+	assert.Equal(t, a, c)
+	assert.Equal(t, Decimal{}, NewDecimal(big.NewInt(0), 5))
+}
+
+func TestNewDecimalFromRat_RoundsNonTerminatingDecimals(t *testing.T) {
+	assert.Equal(t, "0.666666666666666667", NewDecimalFromRat(big.NewRat(2, 3)).String())
+	assert.Equal(t, "-0.333333333333333333", NewDecimalFromRat(big.NewRat(-1, 3)).String())
+	assert.Equal(t, "0.125", NewDecimalFromRat(big.NewRat(1, 8)).String())
+}
+
+func TestDecimal_JSONAndCBOR(t *testing.T) {
+	d, err := ParseDecimal("-1234.5600")
+	require.NoError(t, err)
+
+	data, err := json.Marshal(d)
+	require.NoError(t, err)
+	assert.Equal(t, `"-1234.56"`, string(data))
+
+	var decoded Decimal
+	require.NoError(t, json.Unmarshal([]byte(`-1234.56`), &decoded))
+	assert.Equal(t, d, decoded)
+
+	data, err = cbor.Marshal(d)
+	require.NoError(t, err)
+	var tag cbor.Tag
+	require.NoError(t, cbor.Unmarshal(data, &tag))
+	assert.Equal(t, uint64(CBORTagDecimalFraction), tag.Number)
+	assert.Equal(t, []any{int64(-2), int64(-123456)}, tag.Content)
+}
+
+func TestNewBigIntFromValue(t *testing.T) {
+	i, err := NewBigIntFromValue("-123456789012345678901234567890")
+	require.NoError(t, err)
+	assert.Equal(t, "-123456789012345678901234567890", i.String())
+
+	i, err = NewBigIntFromValue(float64(1e20))
+	require.NoError(t, err)
+	assert.Equal(t, "100000000000000000000", i.String())
+
+	_, err = NewBigIntFromValue(1.5)
+	assert.ErrorIs(t, err, ErrInvalidBigInt)
+
+	_, err = NewBigIntFromValue("1.5")
+	assert.ErrorIs(t, err, ErrInvalidBigInt)
+}
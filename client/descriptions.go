@@ -11,6 +11,8 @@
 package client
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 )
 
@@ -101,6 +103,44 @@ type SchemaDescription struct {
 	//
 	// Currently new fields may be added after initial declaration, but they cannot be removed.
 	Fields []FieldDescription
+
+	// Enums contains the enums used by the fields of this Schema.
+	//
+	// New enums and new enum values may be added after initial declaration, but they cannot
+	// be removed or reordered. They are omitted from the serialized schema if empty.
+	Enums []EnumDescription `json:",omitempty"`
+}
+
+// EnumDescription describes an enum type declared in a schema.
+type EnumDescription struct {
+	// Name is the name of the enum type.
+	Name string
+
+	// Values contains the values of this enum, in declaration order.
+	//
+	// Enum fields are ordered by the declaration order of their values.
+	Values []string
+}
+
+// IndexOf returns the position of the given value within the enum values, or -1 if the
+// value does not belong to the enum.
+func (e EnumDescription) IndexOf(value string) int {
+	for i, v := range e.Values {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// HasEncryptedFields returns true if any of the fields of this schema is encrypted.
+func (sd SchemaDescription) HasEncryptedFields() bool {
+	for _, field := range sd.Fields {
+		if field.IsEncrypted {
+			return true
+		}
+	}
+	return false
 }
 
 // GetField returns the field of the given name.
@@ -113,6 +153,16 @@ func (sd SchemaDescription) GetField(name string) (FieldDescription, bool) {
 	return FieldDescription{}, false
 }
 
+// GetEnum returns the enum of the given name.
+func (sd SchemaDescription) GetEnum(name string) (EnumDescription, bool) {
+	for _, enum := range sd.Enums {
+		if enum.Name == name {
+			return enum, true
+		}
+	}
+	return EnumDescription{}, false
+}
+
 // FieldKind describes the type of a field.
 type FieldKind uint8
 
@@ -134,6 +184,10 @@ func (f FieldKind) String() string {
 		return "[Int!]"
 	case FieldKind_DATETIME:
 		return "DateTime"
+	case FieldKind_NILLABLE_DATETIME_ARRAY:
+		return "[DateTime]"
+	case FieldKind_DATETIME_ARRAY:
+		return "[DateTime!]"
 	case FieldKind_FLOAT:
 		return "Float"
 	case FieldKind_NILLABLE_FLOAT_ARRAY:
@@ -148,6 +202,20 @@ func (f FieldKind) String() string {
 		return "[String!]"
 	case FieldKind_BLOB:
 		return "Blob"
+	case FieldKind_NILLABLE_BLOB_ARRAY:
+		return "[Blob]"
+	case FieldKind_BLOB_ARRAY:
+		return "[Blob!]"
+	case FieldKind_ENUM:
+		return "Enum"
+	case FieldKind_JSON:
+		return "JSON"
+	case FieldKind_DECIMAL:
+		return "Decimal"
+	case FieldKind_BIGINT:
+		return "BigInt"
+	case FieldKind_GEOPOINT:
+		return "GeoPoint"
 	default:
 		return fmt.Sprint(uint8(f))
 	}
@@ -155,22 +223,22 @@ func (f FieldKind) String() string {
 
 // Note: These values are serialized and persisted in the database, avoid modifying existing values.
 const (
-	FieldKind_None         FieldKind = 0
-	FieldKind_DocKey       FieldKind = 1
-	FieldKind_BOOL         FieldKind = 2
-	FieldKind_BOOL_ARRAY   FieldKind = 3
-	FieldKind_INT          FieldKind = 4
-	FieldKind_INT_ARRAY    FieldKind = 5
-	FieldKind_FLOAT        FieldKind = 6
-	FieldKind_FLOAT_ARRAY  FieldKind = 7
-	_                      FieldKind = 8 // safe to repurpose (was never used)
-	_                      FieldKind = 9 // safe to repurpose (previously old field)
-	FieldKind_DATETIME     FieldKind = 10
-	FieldKind_STRING       FieldKind = 11
-	FieldKind_STRING_ARRAY FieldKind = 12
-	FieldKind_BLOB         FieldKind = 13
-	_                      FieldKind = 14 // safe to repurpose (was never used)
-	_                      FieldKind = 15 // safe to repurpose (was never used)
+	FieldKind_None                    FieldKind = 0
+	FieldKind_DocKey                  FieldKind = 1
+	FieldKind_BOOL                    FieldKind = 2
+	FieldKind_BOOL_ARRAY              FieldKind = 3
+	FieldKind_INT                     FieldKind = 4
+	FieldKind_INT_ARRAY               FieldKind = 5
+	FieldKind_FLOAT                   FieldKind = 6
+	FieldKind_FLOAT_ARRAY             FieldKind = 7
+	FieldKind_DATETIME_ARRAY          FieldKind = 8
+	FieldKind_NILLABLE_DATETIME_ARRAY FieldKind = 9
+	FieldKind_DATETIME                FieldKind = 10
+	FieldKind_STRING                  FieldKind = 11
+	FieldKind_STRING_ARRAY            FieldKind = 12
+	FieldKind_BLOB                    FieldKind = 13
+	FieldKind_BLOB_ARRAY              FieldKind = 14
+	FieldKind_NILLABLE_BLOB_ARRAY     FieldKind = 15
 
 	// Embedded object, but accessed via foreign keys
 	FieldKind_FOREIGN_OBJECT FieldKind = 16
@@ -182,6 +250,22 @@ const (
 	FieldKind_NILLABLE_INT_ARRAY    FieldKind = 19
 	FieldKind_NILLABLE_FLOAT_ARRAY  FieldKind = 20
 	FieldKind_NILLABLE_STRING_ARRAY FieldKind = 21
+
+	// Value of an enum declared in the schema, the name of the enum is held by the
+	// Schema property of the field.
+	FieldKind_ENUM FieldKind = 22
+
+	// Semi-structured JSON value, stored in its canonical JSON encoding.
+	FieldKind_JSON FieldKind = 23
+
+	// Exact decimal number of arbitrary precision, stored as a CBOR decimal fraction.
+	FieldKind_DECIMAL FieldKind = 24
+
+	// Integer of arbitrary size, stored as a CBOR integer or bignum.
+	FieldKind_BIGINT FieldKind = 25
+
+	// Geographic point, stored as a CBOR array of its latitude and longitude.
+	FieldKind_GEOPOINT FieldKind = 26
 )
 
 // FieldKindStringToEnumMapping maps string representations of [FieldKind] values to
@@ -192,21 +276,30 @@ const (
 // in the future.  They currently roughly correspond to the GQL field types, but this
 // equality is not guaranteed.
 var FieldKindStringToEnumMapping = map[string]FieldKind{
-	"ID":         FieldKind_DocKey,
-	"Boolean":    FieldKind_BOOL,
-	"[Boolean]":  FieldKind_NILLABLE_BOOL_ARRAY,
-	"[Boolean!]": FieldKind_BOOL_ARRAY,
-	"Int":        FieldKind_INT,
-	"[Int]":      FieldKind_NILLABLE_INT_ARRAY,
-	"[Int!]":     FieldKind_INT_ARRAY,
-	"DateTime":   FieldKind_DATETIME,
-	"Float":      FieldKind_FLOAT,
-	"[Float]":    FieldKind_NILLABLE_FLOAT_ARRAY,
-	"[Float!]":   FieldKind_FLOAT_ARRAY,
-	"String":     FieldKind_STRING,
-	"[String]":   FieldKind_NILLABLE_STRING_ARRAY,
-	"[String!]":  FieldKind_STRING_ARRAY,
-	"Blob":       FieldKind_BLOB,
+	"ID":          FieldKind_DocKey,
+	"Boolean":     FieldKind_BOOL,
+	"[Boolean]":   FieldKind_NILLABLE_BOOL_ARRAY,
+	"[Boolean!]":  FieldKind_BOOL_ARRAY,
+	"Int":         FieldKind_INT,
+	"[Int]":       FieldKind_NILLABLE_INT_ARRAY,
+	"[Int!]":      FieldKind_INT_ARRAY,
+	"DateTime":    FieldKind_DATETIME,
+	"[DateTime]":  FieldKind_NILLABLE_DATETIME_ARRAY,
+	"[DateTime!]": FieldKind_DATETIME_ARRAY,
+	"Float":       FieldKind_FLOAT,
+	"[Float]":     FieldKind_NILLABLE_FLOAT_ARRAY,
+	"[Float!]":    FieldKind_FLOAT_ARRAY,
+	"String":      FieldKind_STRING,
+	"[String]":    FieldKind_NILLABLE_STRING_ARRAY,
+	"[String!]":   FieldKind_STRING_ARRAY,
+	"Blob":        FieldKind_BLOB,
+	"[Blob]":      FieldKind_NILLABLE_BLOB_ARRAY,
+	"[Blob!]":     FieldKind_BLOB_ARRAY,
+	"Enum":        FieldKind_ENUM,
+	"JSON":        FieldKind_JSON,
+	"Decimal":     FieldKind_DECIMAL,
+	"BigInt":      FieldKind_BIGINT,
+	"GeoPoint":    FieldKind_GEOPOINT,
 }
 
 // RelationType describes the type of relation between two types.
@@ -252,7 +345,8 @@ type FieldDescription struct {
 	Kind FieldKind
 
 	// Schema contains the schema name of the type this field contains if this field is
-	// a relation field.  Otherwise this will be empty.
+	// a relation field, or the name of the enum if this field is an enum field.  Otherwise
+	// this will be empty.
 	Schema string
 
 	// RelationName the name of the relationship that this field represents if this field is
@@ -267,6 +361,75 @@ type FieldDescription struct {
 	// RelationType contains the relationship type if this field is a relation field. Otherwise this
 	// will be empty.
 	RelationType RelationType
+
+	// IsEncrypted is true if the values of this field are encrypted with the key of the collection
+	// before being written to the DAG, it is set with the `@encrypted` directive.
+	//
+	// It is omitted from the serialized field if false so that the version IDs of existing
+	// schemas remain unchanged. It is immutable.
+	IsEncrypted bool `json:",omitempty"`
+
+	// IsRequired is true if documents must hold a non-null value for this field, it is set by
+	// declaring the field as non-null (`!`) in the SDL.
+	//
+	// It is omitted from the serialized field if false. It is immutable.
+	IsRequired bool `json:",omitempty"`
+
+	// DefaultValue is the value set on create for this field if the document does not hold one,
+	// it is set with the `@default` directive.
+	//
+	// Documents written before the field was added are read with this value.
+	//
+	// It is omitted from the serialized field if empty. It is immutable.
+	DefaultValue FieldDefaultValue `json:",omitempty"`
+
+	// Constraints are the validation rules of the values of this field, they are set with the
+	// `@constraint` directive.
+	//
+	// They are omitted from the serialized field if empty. They may be changed by schema patches,
+	// in which case the existing documents are validated against the new constraints.
+	Constraints FieldConstraints `json:",omitempty"`
+}
+
+// FieldDefaultValue is the JSON encoded default value of a field.
+//
+// The value is kept encoded so that field descriptions remain comparable, and is serialized
+// as the JSON value itself.
+type FieldDefaultValue string
+
+// MarshalJSON returns the JSON encoded default value.
+func (v FieldDefaultValue) MarshalJSON() ([]byte, error) {
+	return marshalRawJSON(string(v)), nil
+}
+
+// UnmarshalJSON stores the given JSON value in its compacted form.
+func (v *FieldDefaultValue) UnmarshalJSON(data []byte) error {
+	raw, err := unmarshalRawJSON(data)
+	if err != nil {
+		return err
+	}
+	*v = FieldDefaultValue(raw)
+	return nil
+}
+
+// marshalRawJSON returns the given JSON encoded value, or null if empty.
+func marshalRawJSON(raw string) []byte {
+	if raw == "" {
+		return []byte("null")
+	}
+	return []byte(raw)
+}
+
+// unmarshalRawJSON returns the given JSON value in its compacted form, or an empty string if null.
+func unmarshalRawJSON(data []byte) (string, error) {
+	if bytes.Equal(data, []byte("null")) {
+		return "", nil
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
 }
 
 // IsInternal returns true if this field is internally generated.
@@ -302,11 +465,15 @@ func (f FieldDescription) IsArray() bool {
 		f.Kind == FieldKind_INT_ARRAY ||
 		f.Kind == FieldKind_FLOAT_ARRAY ||
 		f.Kind == FieldKind_STRING_ARRAY ||
+		f.Kind == FieldKind_DATETIME_ARRAY ||
+		f.Kind == FieldKind_BLOB_ARRAY ||
 		f.Kind == FieldKind_FOREIGN_OBJECT_ARRAY ||
 		f.Kind == FieldKind_NILLABLE_BOOL_ARRAY ||
 		f.Kind == FieldKind_NILLABLE_INT_ARRAY ||
 		f.Kind == FieldKind_NILLABLE_FLOAT_ARRAY ||
-		f.Kind == FieldKind_NILLABLE_STRING_ARRAY
+		f.Kind == FieldKind_NILLABLE_STRING_ARRAY ||
+		f.Kind == FieldKind_NILLABLE_DATETIME_ARRAY ||
+		f.Kind == FieldKind_NILLABLE_BLOB_ARRAY
 }
 
 // IsSet returns true if the target relation type is set.
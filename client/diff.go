@@ -0,0 +1,23 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package client
+
+// FieldDiff is the change in value of a single field between two versions of a document.
+type FieldDiff struct {
+	// Field is the name of the field that changed.
+	//
+	// A change to whether the document is deleted is reported with the `_deleted` field name.
+	Field string `json:"field"`
+	// Before is the value of the field in the earlier version, nil if it was not set.
+	Before any `json:"before"`
+	// After is the value of the field in the later version, nil if it is not set.
+	After any `json:"after"`
+}
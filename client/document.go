@@ -11,12 +11,17 @@
 package client
 
 import (
+	"bytes"
+	"encoding/hex"
 	"encoding/json"
+	"math/big"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/fxamacker/cbor/v2"
 	"github.com/ipfs/go-cid"
+	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/client/request"
 	ccid "github.com/sourcenetwork/defradb/core/cid"
@@ -122,7 +127,7 @@ func NewDocFromMap(data map[string]any) (*Document, error) {
 // NewFromJSON creates a new instance of a Document from a raw JSON object byte array.
 func NewDocFromJSON(obj []byte) (*Document, error) {
 	data := make(map[string]any)
-	err := json.Unmarshal(obj, &data)
+	err := unmarshalJSONObject(obj, &data)
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +135,14 @@ func NewDocFromJSON(obj []byte) (*Document, error) {
 	return NewDocFromMap(data)
 }
 
+// unmarshalJSONObject decodes the given JSON object, keeping its numbers as [json.Number] so
+// that integers overflowing int64 are not rounded.
+func unmarshalJSONObject(obj []byte, data *map[string]any) error {
+	dec := json.NewDecoder(bytes.NewReader(obj))
+	dec.UseNumber()
+	return dec.Decode(data)
+}
+
 // Head returns the current head CID of the document.
 func (doc *Document) Head() cid.Cid {
 	doc.mu.RLock()
@@ -203,7 +216,7 @@ func (doc *Document) GetValueWithField(f Field) (Value, error) {
 // @todo: Handle sub documents for SetWithJSON
 func (doc *Document) SetWithJSON(patch []byte) error {
 	var patchObj map[string]any
-	err := json.Unmarshal(patch, &patchObj)
+	err := unmarshalJSONObject(patch, &patchObj)
 	if err != nil {
 		return err
 	}
@@ -301,13 +314,53 @@ func (doc *Document) setAndParseType(field string, value any) error {
 			}
 		}
 
+	// json numbers are kept exact if they are integers, integers overflowing int64 are
+	// held as big integers
+	case json.Number:
+		if i, err := val.Int64(); err == nil {
+			return doc.setCBOR(LWW_REGISTER, field, i)
+		}
+		if i, ok := new(big.Int).SetString(string(val), 10); ok {
+			return doc.setCBOR(LWW_REGISTER, field, i)
+		}
+		f, err := val.Float64()
+		if err != nil {
+			return err
+		}
+		return doc.setAndParseType(field, f)
+
+	// array items are held as floats, they are converted to the kind of the field on read
+	case []any:
+		err := doc.setCBOR(LWW_REGISTER, field, jsonNumbersToFloats(val))
+		if err != nil {
+			return err
+		}
+
 	// string, bool, and more
-	case string, bool, int64, []any, []bool, []*bool, []int64, []*int64, []float64, []*float64, []string, []*string:
+	case string, bool, int64, *big.Int, Decimal, GeoPoint,
+		[]bool, []*bool, []int64, []*int64, []float64, []*float64, []string, []*string:
 		err := doc.setCBOR(LWW_REGISTER, field, val)
 		if err != nil {
 			return err
 		}
 
+	// DateTime and Blob values are held as their RFC3339 and hex encoded strings
+	case time.Time, []time.Time, []*time.Time, []byte, [][]byte:
+		err := doc.setCBOR(LWW_REGISTER, field, encodeTimesAndBytes(val))
+		if err != nil {
+			return err
+		}
+
+	// nillable arrays read from the database are held as arrays of pointers
+	case []immutable.Option[bool]:
+		return doc.setCBOR(LWW_REGISTER, field, optionsToPointers(val))
+	case []immutable.Option[int64]:
+		return doc.setCBOR(LWW_REGISTER, field, optionsToPointers(val))
+	case []immutable.Option[float64]:
+		return doc.setCBOR(LWW_REGISTER, field, optionsToPointers(val))
+	case []immutable.Option[string]:
+		return doc.setCBOR(LWW_REGISTER, field, optionsToPointers(val))
+
 	// sub object, recurse down.
 	// @TODO: Object Definitions
 	// You can use an object as a way to override defaults
@@ -335,6 +388,79 @@ func (doc *Document) setAndParseType(field string, value any) error {
 	return nil
 }
 
+// jsonNumbersToFloats returns the given value with the json numbers it holds, at any depth,
+// replaced by floats.
+func jsonNumbersToFloats(value any) any {
+	switch v := value.(type) {
+	case json.Number:
+		f, _ := v.Float64()
+		return f
+	case []any:
+		items := make([]any, len(v))
+		for i, item := range v {
+			items[i] = jsonNumbersToFloats(item)
+		}
+		return items
+	case map[string]any:
+		fields := make(map[string]any, len(v))
+		for key, item := range v {
+			fields[key] = jsonNumbersToFloats(item)
+		}
+		return fields
+	default:
+		return value
+	}
+}
+
+// encodeTimesAndBytes returns the given time, or bytes, or array of them, with the times
+// replaced by their RFC3339 strings and the bytes by their hex encoded strings.
+func encodeTimesAndBytes(value any) any {
+	switch v := value.(type) {
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case []byte:
+		return hex.EncodeToString(v)
+	case []time.Time:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = item.Format(time.RFC3339)
+		}
+		return items
+	case []*time.Time:
+		items := make([]*string, len(v))
+		for i, item := range v {
+			if item != nil {
+				s := item.Format(time.RFC3339)
+				items[i] = &s
+			}
+		}
+		return items
+	case [][]byte:
+		items := make([]*string, len(v))
+		for i, item := range v {
+			if item != nil {
+				s := hex.EncodeToString(item)
+				items[i] = &s
+			}
+		}
+		return items
+	default:
+		return value
+	}
+}
+
+// optionsToPointers returns the given options as pointers, nil if the option has no value.
+func optionsToPointers[T any](options []immutable.Option[T]) []*T {
+	items := make([]*T, len(options))
+	for i, option := range options {
+		if option.HasValue() {
+			value := option.Value()
+			items[i] = &value
+		}
+	}
+	return items
+}
+
 func (doc *Document) setAndParseObjectType(value map[string]any) error {
 	for k, v := range value {
 		err := doc.setAndParseType(k, v)
@@ -450,6 +576,7 @@ func (doc *Document) toMap() (map[string]any, error) {
 				return nil, err
 			}
 			docMap[k] = subDocMap
+			continue
 		}
 
 		docMap[k] = value.Value()
@@ -470,11 +597,12 @@ func (doc *Document) toMapWithKey() (map[string]any, error) {
 
 		if value.IsDocument() {
 			subDoc := value.Value().(*Document)
-			subDocMap, err := subDoc.toMapWithKey()
+			subDocMap, err := subDoc.toMap()
 			if err != nil {
 				return nil, err
 			}
 			docMap[k] = subDocMap
+			continue
 		}
 
 		docMap[k] = value.Value()
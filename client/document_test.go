@@ -12,7 +12,9 @@ package client
 
 import (
 	"testing"
+	"time"
 
+	"github.com/sourcenetwork/immutable"
 	"github.com/stretchr/testify/assert"
 
 	ccid "github.com/sourcenetwork/defradb/core/cid"
@@ -147,3 +149,29 @@ func TestSetWithJSON(t *testing.T) {
 	// assert.Equal(t, subDoc.values[subDoc.fields["Street"]].IsDocument(), false)
 	// assert.Equal(t, subDoc.values[subDoc.fields["City"]].Value(), "Toronto")
 }
+
+func TestSetWithTimesAndBytes(t *testing.T) {
+	doc := newEmptyDoc()
+	meeting := time.Date(2023, 1, 2, 9, 0, 0, 0, time.UTC)
+
+	err := doc.Set("Meetings", []*time.Time{&meeting, nil})
+	assert.NoError(t, err)
+	err = doc.Set("Attachments", [][]byte{{0x00, 0xff}})
+	assert.NoError(t, err)
+	err = doc.Set("Tags", []immutable.Option[string]{immutable.Some("a"), immutable.None[string]()})
+	assert.NoError(t, err)
+
+	meetings, err := doc.Get("Meetings")
+	assert.NoError(t, err)
+	assert.Equal(t, "2023-01-02T09:00:00Z", *meetings.([]*string)[0])
+	assert.Nil(t, meetings.([]*string)[1])
+
+	attachments, err := doc.Get("Attachments")
+	assert.NoError(t, err)
+	assert.Equal(t, "00ff", *attachments.([]*string)[0])
+
+	tags, err := doc.Get("Tags")
+	assert.NoError(t, err)
+	assert.Equal(t, "a", *tags.([]*string)[0])
+	assert.Nil(t, tags.([]*string)[1])
+}
@@ -17,14 +17,21 @@ import (
 )
 
 const (
-	errFieldNotExist        string = "The given field does not exist"
-	errUnexpectedType       string = "unexpected type"
-	errParsingFailed        string = "failed to parse argument"
-	errUninitializeProperty string = "invalid state, required property is uninitialized"
-	errMaxTxnRetries        string = "reached maximum transaction reties"
-	errRelationOneSided     string = "relation must be defined on both schemas"
-	errCollectionNotFound   string = "collection not found"
-	errUnknownCRDT          string = "unknown crdt"
+	errFieldNotExist         string = "The given field does not exist"
+	errUnexpectedType        string = "unexpected type"
+	errParsingFailed         string = "failed to parse argument"
+	errUninitializeProperty  string = "invalid state, required property is uninitialized"
+	errMaxTxnRetries         string = "reached maximum transaction reties"
+	errRelationOneSided      string = "relation must be defined on both schemas"
+	errCollectionNotFound    string = "collection not found"
+	errUnknownCRDT           string = "unknown crdt"
+	errPermissionDenied      string = "permission denied"
+	errInvalidConstraint     string = "invalid field constraint"
+	errInvalidPattern        string = "invalid constraint pattern"
+	errInvalidDecimal        string = "invalid decimal value"
+	errInvalidBigInt         string = "invalid big integer value"
+	errInvalidGeoPoint       string = "invalid geo point value"
+	errMultipleUpsertMatches string = "cannot upsert, more than one document matches the filter"
 )
 
 // Errors returnable from this package.
@@ -32,23 +39,30 @@ const (
 // This list is incomplete and undefined errors may also be returned.
 // Errors returned from this package may be tested against these errors with errors.Is.
 var (
-	ErrFieldNotExist        = errors.New(errFieldNotExist)
-	ErrUnexpectedType       = errors.New(errUnexpectedType)
-	ErrParsingFailed        = errors.New(errParsingFailed)
-	ErrUninitializeProperty = errors.New(errUninitializeProperty)
-	ErrFieldNotObject       = errors.New("trying to access field on a non object type")
-	ErrValueTypeMismatch    = errors.New("value does not match indicated type")
-	ErrIndexNotFound        = errors.New("no index found for given ID")
-	ErrDocumentNotFound     = errors.New("no document for the given key exists")
-	ErrInvalidUpdateTarget  = errors.New("the target document to update is of invalid type")
-	ErrInvalidUpdater       = errors.New("the updater of a document is of invalid type")
-	ErrInvalidDeleteTarget  = errors.New("the target document to delete is of invalid type")
-	ErrMalformedDocKey      = errors.New("malformed DocKey, missing either version or cid")
-	ErrInvalidDocKeyVersion = errors.New("invalid DocKey version")
-	ErrMaxTxnRetries        = errors.New(errMaxTxnRetries)
-	ErrRelationOneSided     = errors.New(errRelationOneSided)
-	ErrCollectionNotFound   = errors.New(errCollectionNotFound)
-	ErrUnknownCRDT          = errors.New(errUnknownCRDT)
+	ErrFieldNotExist         = errors.New(errFieldNotExist)
+	ErrUnexpectedType        = errors.New(errUnexpectedType)
+	ErrParsingFailed         = errors.New(errParsingFailed)
+	ErrUninitializeProperty  = errors.New(errUninitializeProperty)
+	ErrFieldNotObject        = errors.New("trying to access field on a non object type")
+	ErrValueTypeMismatch     = errors.New("value does not match indicated type")
+	ErrIndexNotFound         = errors.New("no index found for given ID")
+	ErrDocumentNotFound      = errors.New("no document for the given key exists")
+	ErrInvalidUpdateTarget   = errors.New("the target document to update is of invalid type")
+	ErrInvalidUpdater        = errors.New("the updater of a document is of invalid type")
+	ErrInvalidDeleteTarget   = errors.New("the target document to delete is of invalid type")
+	ErrMalformedDocKey       = errors.New("malformed DocKey, missing either version or cid")
+	ErrInvalidDocKeyVersion  = errors.New("invalid DocKey version")
+	ErrMaxTxnRetries         = errors.New(errMaxTxnRetries)
+	ErrRelationOneSided      = errors.New(errRelationOneSided)
+	ErrCollectionNotFound    = errors.New(errCollectionNotFound)
+	ErrUnknownCRDT           = errors.New(errUnknownCRDT)
+	ErrPermissionDenied      = errors.New(errPermissionDenied)
+	ErrInvalidConstraint     = errors.New(errInvalidConstraint)
+	ErrInvalidPattern        = errors.New(errInvalidPattern)
+	ErrInvalidDecimal        = errors.New(errInvalidDecimal)
+	ErrInvalidBigInt         = errors.New(errInvalidBigInt)
+	ErrInvalidGeoPoint       = errors.New(errInvalidGeoPoint)
+	ErrMultipleUpsertMatches = errors.New(errMultipleUpsertMatches)
 )
 
 // NewErrFieldNotExist returns an error indicating that the given field does not exist.
@@ -132,3 +146,52 @@ func NewErrUnknownCRDT(cType CType) error {
 		errors.NewKV("Type", cType),
 	)
 }
+
+func NewErrPermissionDenied(identity string, permission Permission, collection string) error {
+	kvs := []errors.KV{
+		errors.NewKV("Identity", identity),
+		errors.NewKV("Permission", permission),
+	}
+	if collection != "" {
+		kvs = append(kvs, errors.NewKV("Collection", collection))
+	}
+	return errors.New(errPermissionDenied, kvs...)
+}
+
+// NewErrInvalidConstraint returns an error indicating the given constraint rule does not apply
+// to the given field kind, or is inconsistent with the other rules.
+func NewErrInvalidConstraint(rule string, kind FieldKind) error {
+	return errors.New(
+		errInvalidConstraint,
+		errors.NewKV("Constraint", rule),
+		errors.NewKV("Kind", kind),
+	)
+}
+
+// NewErrInvalidConstraintPattern returns an error indicating the given constraint pattern
+// is not a valid regular expression.
+func NewErrInvalidConstraintPattern(inner error, pattern string) error {
+	return errors.Wrap(errInvalidPattern, inner, errors.NewKV("Pattern", pattern))
+}
+
+// NewErrInvalidDecimal returns an error indicating the given value is not a decimal number.
+func NewErrInvalidDecimal(value any) error {
+	return errors.New(errInvalidDecimal, errors.NewKV("Value", value))
+}
+
+// NewErrInvalidBigInt returns an error indicating the given value is not an integer.
+func NewErrInvalidBigInt(value any) error {
+	return errors.New(errInvalidBigInt, errors.NewKV("Value", value))
+}
+
+// NewErrInvalidGeoPoint returns an error indicating the given value is not a geo point of
+// valid latitude and longitude.
+func NewErrInvalidGeoPoint(value any) error {
+	return errors.New(errInvalidGeoPoint, errors.NewKV("Value", value))
+}
+
+// NewErrMultipleUpsertMatches returns an error indicating that more than one document, of the
+// given DocKeys, matches the filter of an upsert.
+func NewErrMultipleUpsertMatches(docKeys []string) error {
+	return errors.New(errMultipleUpsertMatches, errors.NewKV("DocKeys", docKeys))
+}
@@ -0,0 +1,228 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package client
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// EarthRadius is the mean radius of the earth in meters, distances between geo points are
+// measured on the sphere of this radius.
+const EarthRadius = 6371008.8
+
+// The names of the properties of geo points, as given in documents and requests.
+const (
+	GeoPointLatitude  = "latitude"
+	GeoPointLongitude = "longitude"
+)
+
+// GeoPoint is a geographic point, given by its latitude and longitude in degrees.
+type GeoPoint struct {
+	// Latitude is the latitude of the point, within [-90, 90].
+	Latitude float64 `json:"latitude"`
+	// Longitude is the longitude of the point, within [-180, 180].
+	Longitude float64 `json:"longitude"`
+}
+
+// NewGeoPoint returns the geo point of the given latitude and longitude, or an error if they
+// are out of range.
+func NewGeoPoint(latitude float64, longitude float64) (GeoPoint, error) {
+	point := GeoPoint{Latitude: latitude, Longitude: longitude}
+	if math.IsNaN(latitude) || math.IsNaN(longitude) ||
+		math.Abs(latitude) > 90 || math.Abs(longitude) > 180 {
+		return GeoPoint{}, NewErrInvalidGeoPoint(point)
+	}
+	return point, nil
+}
+
+// NewGeoPointFromValue returns the geo point held by the given value, which may be a geo point,
+// an object holding the `latitude` and `longitude` properties, or the array of the latitude
+// and the longitude.
+func NewGeoPointFromValue(value any) (GeoPoint, error) {
+	switch v := value.(type) {
+	case GeoPoint:
+		return NewGeoPoint(v.Latitude, v.Longitude)
+	case *GeoPoint:
+		return NewGeoPoint(v.Latitude, v.Longitude)
+	case *Document:
+		m, err := v.toMap()
+		if err != nil {
+			return GeoPoint{}, err
+		}
+		return NewGeoPointFromValue(m)
+	case map[string]any:
+		if len(v) != 2 {
+			return GeoPoint{}, NewErrInvalidGeoPoint(value)
+		}
+		return newGeoPointFromCoordinates(value, v[GeoPointLatitude], v[GeoPointLongitude])
+	case []any:
+		if len(v) != 2 {
+			return GeoPoint{}, NewErrInvalidGeoPoint(value)
+		}
+		return newGeoPointFromCoordinates(value, v[0], v[1])
+	case []float64:
+		if len(v) != 2 {
+			return GeoPoint{}, NewErrInvalidGeoPoint(value)
+		}
+		return NewGeoPoint(v[0], v[1])
+	default:
+		return GeoPoint{}, NewErrInvalidGeoPoint(value)
+	}
+}
+
+func newGeoPointFromCoordinates(value any, latitude any, longitude any) (GeoPoint, error) {
+	lat, ok := GeoCoordinate(latitude)
+	if !ok {
+		return GeoPoint{}, NewErrInvalidGeoPoint(value)
+	}
+	lng, ok := GeoCoordinate(longitude)
+	if !ok {
+		return GeoPoint{}, NewErrInvalidGeoPoint(value)
+	}
+	return NewGeoPoint(lat, lng)
+}
+
+// GeoCoordinate returns the given number as a float, and true, or false if it is not a number.
+//
+// It is used to read the coordinates and distances of geo points, which may have been decoded
+// as integers.
+func GeoCoordinate(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case *big.Int:
+		f, _ := new(big.Float).SetInt(v).Float64()
+		return f, true
+	default:
+		return 0, false
+	}
+}
+
+// Distance returns the great-circle distance in meters between the point and the given point.
+func (p GeoPoint) Distance(other GeoPoint) float64 {
+	lat1 := toRadians(p.Latitude)
+	lat2 := toRadians(other.Latitude)
+	dLat := lat2 - lat1
+	dLng := toRadians(other.Longitude - p.Longitude)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// MarshalCBOR encodes the point as the array of its latitude and longitude.
+func (p GeoPoint) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal([]float64{p.Latitude, p.Longitude})
+}
+
+// GeoBox is the area within the parallels and meridians of its south-west and north-east
+// corners.
+//
+// Boxes whose western longitude is greater than their eastern longitude cross the antimeridian.
+type GeoBox struct {
+	SouthWest GeoPoint
+	NorthEast GeoPoint
+}
+
+// Contains returns true if the given point is within the box.
+func (b GeoBox) Contains(p GeoPoint) bool {
+	if p.Latitude < b.SouthWest.Latitude || p.Latitude > b.NorthEast.Latitude {
+		return false
+	}
+	if b.SouthWest.Longitude <= b.NorthEast.Longitude {
+		return p.Longitude >= b.SouthWest.Longitude && p.Longitude <= b.NorthEast.Longitude
+	}
+	return p.Longitude >= b.SouthWest.Longitude || p.Longitude <= b.NorthEast.Longitude
+}
+
+// Split returns the boxes covering the box that do not cross the antimeridian.
+func (b GeoBox) Split() []GeoBox {
+	if b.SouthWest.Longitude <= b.NorthEast.Longitude {
+		return []GeoBox{b}
+	}
+	return []GeoBox{
+		{
+			SouthWest: b.SouthWest,
+			NorthEast: GeoPoint{Latitude: b.NorthEast.Latitude, Longitude: 180},
+		},
+		{
+			SouthWest: GeoPoint{Latitude: b.SouthWest.Latitude, Longitude: -180},
+			NorthEast: b.NorthEast,
+		},
+	}
+}
+
+// GeoCircle is the area within a distance in meters of its center.
+type GeoCircle struct {
+	Center GeoPoint
+	Radius float64
+}
+
+// Contains returns true if the given point is within the circle.
+func (c GeoCircle) Contains(p GeoPoint) bool {
+	return c.Center.Distance(p) <= c.Radius
+}
+
+// Bounds returns the box covering the circle.
+func (c GeoCircle) Bounds() GeoBox {
+	dLat := toDegrees(c.Radius / EarthRadius)
+	minLat := c.Center.Latitude - dLat
+	maxLat := c.Center.Latitude + dLat
+	if minLat <= -90 || maxLat >= 90 {
+		// circles containing a pole cover every longitude
+		return GeoBox{
+			SouthWest: GeoPoint{Latitude: math.Max(minLat, -90), Longitude: -180},
+			NorthEast: GeoPoint{Latitude: math.Min(maxLat, 90), Longitude: 180},
+		}
+	}
+
+	dLng := toDegrees(math.Asin(math.Min(1, math.Sin(c.Radius/EarthRadius)/
+		math.Cos(toRadians(c.Center.Latitude)))))
+	return GeoBox{
+		SouthWest: GeoPoint{Latitude: minLat, Longitude: wrapLongitude(c.Center.Longitude - dLng)},
+		NorthEast: GeoPoint{Latitude: maxLat, Longitude: wrapLongitude(c.Center.Longitude + dLng)},
+	}
+}
+
+func wrapLongitude(lng float64) float64 {
+	if lng < -180 {
+		return lng + 360
+	}
+	if lng > 180 {
+		return lng - 360
+	}
+	return lng
+}
+
+func toRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func toDegrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
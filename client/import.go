@@ -0,0 +1,64 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package client
+
+// The supported bulk import formats.
+const (
+	// ImportFormatCSV is a CSV file whose first record holds the column names.
+	ImportFormatCSV = "csv"
+	// ImportFormatNDJSON is a JSON object per line.
+	ImportFormatNDJSON = "ndjson"
+)
+
+// DefaultImportBatchSize is the number of documents created per transaction by default.
+const DefaultImportBatchSize = 1000
+
+// ImportConfig holds the configuration parameters of a bulk import into a collection.
+type ImportConfig struct {
+	// Format of the imported data, one of csv or ndjson.
+	Format string `json:"format"`
+	// Mapping maps the CSV columns or NDJSON keys to the fields of the collection.
+	//
+	// Columns missing from the mapping are imported into the field of the same name,
+	// and columns mapped to an empty field name are ignored.
+	Mapping map[string]string `json:"mapping"`
+	// Relations maps relation fields to the natural key field of the related collection.
+	//
+	// The value imported into such a relation field is the value of the natural key of the
+	// related document, which must match exactly one document.
+	Relations map[string]string `json:"relations"`
+	// BatchSize is the number of documents created per transaction, it defaults to
+	// DefaultImportBatchSize.
+	//
+	// It is ignored if the collection is used within a transaction, all the documents
+	// are then created within that transaction.
+	BatchSize int `json:"batchSize"`
+}
+
+// ImportResult wraps the result of a bulk import.
+type ImportResult struct {
+	// Imported is the number of documents created by the import.
+	Imported uint64 `json:"imported"`
+	// Rejected contains the rows that could not be imported.
+	Rejected []ImportReject `json:"rejected"`
+}
+
+// ImportReject is a row of a bulk import that could not be imported.
+type ImportReject struct {
+	// Row is the number of the row within the imported data, starting at 1.
+	//
+	// The CSV header is not counted.
+	Row uint64 `json:"row"`
+	// Data is the content of the row, as read from the imported data.
+	Data string `json:"data"`
+	// Error describes why the row was rejected.
+	Error string `json:"error"`
+}
@@ -26,6 +26,10 @@ type IndexedFieldDescription struct {
 	Name string
 	// Direction contains the direction of the index.
 	Direction IndexDirection
+	// Path contains the path of the indexed value within a JSON field.
+	//
+	// It must be set for JSON fields, and only for them.
+	Path []string `json:",omitempty"`
 }
 
 // IndexDescription describes an index.
@@ -0,0 +1,147 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// NormalizeJSONValue returns the given value of a JSON field in its normalized form.
+//
+// Sub documents are converted to maps, and numbers are converted to int64 if they are
+// integers, float64 otherwise, so that equal JSON values are equal once normalized.
+func NormalizeJSONValue(value any) (any, error) {
+	switch v := value.(type) {
+	case *Document:
+		m, err := v.toMap()
+		if err != nil {
+			return nil, err
+		}
+		return NormalizeJSONValue(m)
+
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for key, item := range v {
+			normalized, err := NormalizeJSONValue(item)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = normalized
+		}
+		return m, nil
+
+	case []any:
+		arr := make([]any, len(v))
+		for i, item := range v {
+			normalized, err := NormalizeJSONValue(item)
+			if err != nil {
+				return nil, err
+			}
+			arr[i] = normalized
+		}
+		return arr, nil
+
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return NormalizeJSONValue(f)
+
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < math.MaxInt64 {
+			return int64(v), nil
+		}
+		return v, nil
+	case float32:
+		return NormalizeJSONValue(float64(v))
+	case int:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case uint64:
+		return int64(v), nil
+
+	default:
+		return value, nil
+	}
+}
+
+// CanonicalJSON returns the canonical JSON encoding of the given value of a JSON field.
+//
+// Values are normalized, object keys are sorted and no insignificant whitespace is kept, so
+// that equal JSON values have the same encoding.
+func CanonicalJSON(value any) (string, error) {
+	normalized, err := NormalizeJSONValue(value)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(normalized); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// DecodeJSONValue returns the normalized value of the given JSON encoded value.
+func DecodeJSONValue(data string) (any, error) {
+	dec := json.NewDecoder(strings.NewReader(data))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	return NormalizeJSONValue(value)
+}
+
+// JSONPathValue returns the value found at the given path within the given JSON value, and
+// true, or false if the path does not exist.
+//
+// Path elements are object keys, or indexes of array items.
+func JSONPathValue(value any, path []string) (any, bool) {
+	if doc, ok := value.(*Document); ok {
+		m, err := doc.toMap()
+		if err != nil {
+			return nil, false
+		}
+		value = m
+	}
+	for _, key := range path {
+		switch v := value.(type) {
+		case map[string]any:
+			item, ok := v[key]
+			if !ok {
+				return nil, false
+			}
+			value = item
+
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, false
+			}
+			value = v[i]
+
+		default:
+			return nil, false
+		}
+	}
+	return value, true
+}
@@ -0,0 +1,56 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+	doc, err := NewDocFromJSON([]byte(`{"meta": {"z": [1.0, 2.5, "<a>"], "a": {"c": null, "b": true}}}`))
+	require.NoError(t, err)
+	value, err := doc.Get("meta")
+	require.NoError(t, err)
+
+	encoded, err := CanonicalJSON(value)
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":{"b":true,"c":null},"z":[1,2.5,"<a>"]}`, encoded)
+
+	decoded, err := DecodeJSONValue(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"a": map[string]any{"b": true, "c": nil},
+		"z": []any{int64(1), 2.5, "<a>"},
+	}, decoded)
+}
+
+func TestJSONPathValue(t *testing.T) {
+	value := map[string]any{
+		"user": map[string]any{"roles": []any{"admin", "dev"}},
+	}
+
+	v, ok := JSONPathValue(value, []string{"user", "roles", "1"})
+	assert.True(t, ok)
+	assert.Equal(t, "dev", v)
+
+	_, ok = JSONPathValue(value, []string{"user", "roles", "2"})
+	assert.False(t, ok)
+
+	_, ok = JSONPathValue(value, []string{"user", "name"})
+	assert.False(t, ok)
+
+	v, ok = JSONPathValue(value, nil)
+	assert.True(t, ok)
+	assert.Equal(t, value, v)
+}
@@ -9,6 +9,8 @@ import (
 
 	datastore "github.com/sourcenetwork/defradb/datastore"
 
+	io "io"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -519,6 +521,63 @@ func (_c *Collection_Description_Call) RunAndReturn(run func() client.Collection
 	return _c
 }
 
+// Diff provides a mock function with given fields: ctx, key, fromCID, toCID
+func (_m *Collection) Diff(ctx context.Context, key client.DocKey, fromCID string, toCID string) ([]client.FieldDiff, error) {
+	ret := _m.Called(ctx, key, fromCID, toCID)
+
+	var r0 []client.FieldDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, client.DocKey, string, string) ([]client.FieldDiff, error)); ok {
+		return rf(ctx, key, fromCID, toCID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, client.DocKey, string, string) []client.FieldDiff); ok {
+		r0 = rf(ctx, key, fromCID, toCID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.FieldDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, client.DocKey, string, string) error); ok {
+		r1 = rf(ctx, key, fromCID, toCID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Collection_Diff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Diff'
+type Collection_Diff_Call struct {
+	*mock.Call
+}
+
+// Diff is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key client.DocKey
+//   - fromCID string
+//   - toCID string
+func (_e *Collection_Expecter) Diff(ctx interface{}, key interface{}, fromCID interface{}, toCID interface{}) *Collection_Diff_Call {
+	return &Collection_Diff_Call{Call: _e.mock.On("Diff", ctx, key, fromCID, toCID)}
+}
+
+func (_c *Collection_Diff_Call) Run(run func(ctx context.Context, key client.DocKey, fromCID string, toCID string)) *Collection_Diff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(client.DocKey), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *Collection_Diff_Call) Return(_a0 []client.FieldDiff, _a1 error) *Collection_Diff_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Collection_Diff_Call) RunAndReturn(run func(context.Context, client.DocKey, string, string) ([]client.FieldDiff, error)) *Collection_Diff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropIndex provides a mock function with given fields: ctx, indexName
 func (_m *Collection) DropIndex(ctx context.Context, indexName string) error {
 	ret := _m.Called(ctx, indexName)
@@ -820,6 +879,60 @@ func (_c *Collection_ID_Call) RunAndReturn(run func() uint32) *Collection_ID_Cal
 	return _c
 }
 
+// Import provides a mock function with given fields: ctx, config, r
+func (_m *Collection) Import(ctx context.Context, config client.ImportConfig, r io.Reader) (client.ImportResult, error) {
+	ret := _m.Called(ctx, config, r)
+
+	var r0 client.ImportResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, client.ImportConfig, io.Reader) (client.ImportResult, error)); ok {
+		return rf(ctx, config, r)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, client.ImportConfig, io.Reader) client.ImportResult); ok {
+		r0 = rf(ctx, config, r)
+	} else {
+		r0 = ret.Get(0).(client.ImportResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, client.ImportConfig, io.Reader) error); ok {
+		r1 = rf(ctx, config, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Collection_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type Collection_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - ctx context.Context
+//   - config client.ImportConfig
+//   - r io.Reader
+func (_e *Collection_Expecter) Import(ctx interface{}, config interface{}, r interface{}) *Collection_Import_Call {
+	return &Collection_Import_Call{Call: _e.mock.On("Import", ctx, config, r)}
+}
+
+func (_c *Collection_Import_Call) Run(run func(ctx context.Context, config client.ImportConfig, r io.Reader)) *Collection_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(client.ImportConfig), args[2].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *Collection_Import_Call) Return(_a0 client.ImportResult, _a1 error) *Collection_Import_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Collection_Import_Call) RunAndReturn(run func(context.Context, client.ImportConfig, io.Reader) (client.ImportResult, error)) *Collection_Import_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Name provides a mock function with given fields:
 func (_m *Collection) Name() string {
 	ret := _m.Called()
@@ -861,6 +974,50 @@ func (_c *Collection_Name_Call) RunAndReturn(run func() string) *Collection_Name
 	return _c
 }
 
+// Revert provides a mock function with given fields: ctx, key, cid
+func (_m *Collection) Revert(ctx context.Context, key client.DocKey, cid string) error {
+	ret := _m.Called(ctx, key, cid)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, client.DocKey, string) error); ok {
+		r0 = rf(ctx, key, cid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Collection_Revert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revert'
+type Collection_Revert_Call struct {
+	*mock.Call
+}
+
+// Revert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key client.DocKey
+//   - cid string
+func (_e *Collection_Expecter) Revert(ctx interface{}, key interface{}, cid interface{}) *Collection_Revert_Call {
+	return &Collection_Revert_Call{Call: _e.mock.On("Revert", ctx, key, cid)}
+}
+
+func (_c *Collection_Revert_Call) Run(run func(ctx context.Context, key client.DocKey, cid string)) *Collection_Revert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(client.DocKey), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Collection_Revert_Call) Return(_a0 error) *Collection_Revert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Collection_Revert_Call) RunAndReturn(run func(context.Context, client.DocKey, string) error) *Collection_Revert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function with given fields: _a0, _a1
 func (_m *Collection) Save(_a0 context.Context, _a1 *client.Document) error {
 	ret := _m.Called(_a0, _a1)
@@ -1253,6 +1410,63 @@ func (_c *Collection_UpdateWithKeys_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// Upsert provides a mock function with given fields: ctx, filter, create, updater
+func (_m *Collection) Upsert(ctx context.Context, filter interface{}, create *client.Document, updater string) (*client.UpsertResult, error) {
+	ret := _m.Called(ctx, filter, create, updater)
+
+	var r0 *client.UpsertResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, *client.Document, string) (*client.UpsertResult, error)); ok {
+		return rf(ctx, filter, create, updater)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, *client.Document, string) *client.UpsertResult); ok {
+		r0 = rf(ctx, filter, create, updater)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.UpsertResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}, *client.Document, string) error); ok {
+		r1 = rf(ctx, filter, create, updater)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Collection_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type Collection_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter interface{}
+//   - create *client.Document
+//   - updater string
+func (_e *Collection_Expecter) Upsert(ctx interface{}, filter interface{}, create interface{}, updater interface{}) *Collection_Upsert_Call {
+	return &Collection_Upsert_Call{Call: _e.mock.On("Upsert", ctx, filter, create, updater)}
+}
+
+func (_c *Collection_Upsert_Call) Run(run func(ctx context.Context, filter interface{}, create *client.Document, updater string)) *Collection_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interface{}), args[2].(*client.Document), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *Collection_Upsert_Call) Return(_a0 *client.UpsertResult, _a1 error) *Collection_Upsert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Collection_Upsert_Call) RunAndReturn(run func(context.Context, interface{}, *client.Document, string) (*client.UpsertResult, error)) *Collection_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithTxn provides a mock function with given fields: _a0
 func (_m *Collection) WithTxn(_a0 datastore.Txn) client.Collection {
 	ret := _m.Called(_a0)
@@ -12,6 +12,8 @@ import (
 
 	events "github.com/sourcenetwork/defradb/events"
 
+	io "io"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -83,6 +85,59 @@ func (_c *DB_AddSchema_Call) RunAndReturn(run func(context.Context, string) ([]c
 	return _c
 }
 
+// AddWebhook provides a mock function with given fields: ctx, hook
+func (_m *DB) AddWebhook(ctx context.Context, hook client.Webhook) (client.Webhook, error) {
+	ret := _m.Called(ctx, hook)
+
+	var r0 client.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, client.Webhook) (client.Webhook, error)); ok {
+		return rf(ctx, hook)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, client.Webhook) client.Webhook); ok {
+		r0 = rf(ctx, hook)
+	} else {
+		r0 = ret.Get(0).(client.Webhook)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, client.Webhook) error); ok {
+		r1 = rf(ctx, hook)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DB_AddWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddWebhook'
+type DB_AddWebhook_Call struct {
+	*mock.Call
+}
+
+// AddWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hook client.Webhook
+func (_e *DB_Expecter) AddWebhook(ctx interface{}, hook interface{}) *DB_AddWebhook_Call {
+	return &DB_AddWebhook_Call{Call: _e.mock.On("AddWebhook", ctx, hook)}
+}
+
+func (_c *DB_AddWebhook_Call) Run(run func(ctx context.Context, hook client.Webhook)) *DB_AddWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(client.Webhook))
+	})
+	return _c
+}
+
+func (_c *DB_AddWebhook_Call) Return(_a0 client.Webhook, _a1 error) *DB_AddWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DB_AddWebhook_Call) RunAndReturn(run func(context.Context, client.Webhook) (client.Webhook, error)) *DB_AddWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BasicExport provides a mock function with given fields: ctx, config
 func (_m *DB) BasicExport(ctx context.Context, config *client.BackupConfig) error {
 	ret := _m.Called(ctx, config)
@@ -126,6 +181,50 @@ func (_c *DB_BasicExport_Call) RunAndReturn(run func(context.Context, *client.Ba
 	return _c
 }
 
+// BasicExportTo provides a mock function with given fields: ctx, config, w
+func (_m *DB) BasicExportTo(ctx context.Context, config *client.BackupConfig, w io.Writer) error {
+	ret := _m.Called(ctx, config, w)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *client.BackupConfig, io.Writer) error); ok {
+		r0 = rf(ctx, config, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DB_BasicExportTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BasicExportTo'
+type DB_BasicExportTo_Call struct {
+	*mock.Call
+}
+
+// BasicExportTo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - config *client.BackupConfig
+//   - w io.Writer
+func (_e *DB_Expecter) BasicExportTo(ctx interface{}, config interface{}, w interface{}) *DB_BasicExportTo_Call {
+	return &DB_BasicExportTo_Call{Call: _e.mock.On("BasicExportTo", ctx, config, w)}
+}
+
+func (_c *DB_BasicExportTo_Call) Run(run func(ctx context.Context, config *client.BackupConfig, w io.Writer)) *DB_BasicExportTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*client.BackupConfig), args[2].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *DB_BasicExportTo_Call) Return(_a0 error) *DB_BasicExportTo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DB_BasicExportTo_Call) RunAndReturn(run func(context.Context, *client.BackupConfig, io.Writer) error) *DB_BasicExportTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BasicImport provides a mock function with given fields: ctx, filepath
 func (_m *DB) BasicImport(ctx context.Context, filepath string) error {
 	ret := _m.Called(ctx, filepath)
@@ -169,6 +268,50 @@ func (_c *DB_BasicImport_Call) RunAndReturn(run func(context.Context, string) er
 	return _c
 }
 
+// BasicImportFrom provides a mock function with given fields: ctx, config, r
+func (_m *DB) BasicImportFrom(ctx context.Context, config *client.BackupConfig, r io.Reader) error {
+	ret := _m.Called(ctx, config, r)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *client.BackupConfig, io.Reader) error); ok {
+		r0 = rf(ctx, config, r)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DB_BasicImportFrom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BasicImportFrom'
+type DB_BasicImportFrom_Call struct {
+	*mock.Call
+}
+
+// BasicImportFrom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - config *client.BackupConfig
+//   - r io.Reader
+func (_e *DB_Expecter) BasicImportFrom(ctx interface{}, config interface{}, r interface{}) *DB_BasicImportFrom_Call {
+	return &DB_BasicImportFrom_Call{Call: _e.mock.On("BasicImportFrom", ctx, config, r)}
+}
+
+func (_c *DB_BasicImportFrom_Call) Run(run func(ctx context.Context, config *client.BackupConfig, r io.Reader)) *DB_BasicImportFrom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*client.BackupConfig), args[2].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *DB_BasicImportFrom_Call) Return(_a0 error) *DB_BasicImportFrom_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DB_BasicImportFrom_Call) RunAndReturn(run func(context.Context, *client.BackupConfig, io.Reader) error) *DB_BasicImportFrom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Blockstore provides a mock function with given fields:
 func (_m *DB) Blockstore() blockstore.Blockstore {
 	ret := _m.Called()
@@ -212,6 +355,61 @@ func (_c *DB_Blockstore_Call) RunAndReturn(run func() blockstore.Blockstore) *DB
 	return _c
 }
 
+// ChangesSince provides a mock function with given fields: ctx, collectionName, cursor, limit
+func (_m *DB) ChangesSince(ctx context.Context, collectionName string, cursor uint64, limit uint64) (client.ChangesResult, error) {
+	ret := _m.Called(ctx, collectionName, cursor, limit)
+
+	var r0 client.ChangesResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) (client.ChangesResult, error)); ok {
+		return rf(ctx, collectionName, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64) client.ChangesResult); ok {
+		r0 = rf(ctx, collectionName, cursor, limit)
+	} else {
+		r0 = ret.Get(0).(client.ChangesResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64, uint64) error); ok {
+		r1 = rf(ctx, collectionName, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DB_ChangesSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangesSince'
+type DB_ChangesSince_Call struct {
+	*mock.Call
+}
+
+// ChangesSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionName string
+//   - cursor uint64
+//   - limit uint64
+func (_e *DB_Expecter) ChangesSince(ctx interface{}, collectionName interface{}, cursor interface{}, limit interface{}) *DB_ChangesSince_Call {
+	return &DB_ChangesSince_Call{Call: _e.mock.On("ChangesSince", ctx, collectionName, cursor, limit)}
+}
+
+func (_c *DB_ChangesSince_Call) Run(run func(ctx context.Context, collectionName string, cursor uint64, limit uint64)) *DB_ChangesSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uint64), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *DB_ChangesSince_Call) Return(_a0 client.ChangesResult, _a1 error) *DB_ChangesSince_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DB_ChangesSince_Call) RunAndReturn(run func(context.Context, string, uint64, uint64) (client.ChangesResult, error)) *DB_ChangesSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *DB) Close() {
 	_m.Called()
@@ -244,6 +442,102 @@ func (_c *DB_Close_Call) RunAndReturn(run func()) *DB_Close_Call {
 	return _c
 }
 
+// CreateSnapshot provides a mock function with given fields: ctx, filepath
+func (_m *DB) CreateSnapshot(ctx context.Context, filepath string) (client.SnapshotInfo, error) {
+	ret := _m.Called(ctx, filepath)
+
+	var r0 client.SnapshotInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (client.SnapshotInfo, error)); ok {
+		return rf(ctx, filepath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) client.SnapshotInfo); ok {
+		r0 = rf(ctx, filepath)
+	} else {
+		r0 = ret.Get(0).(client.SnapshotInfo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, filepath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DB_CreateSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSnapshot'
+type DB_CreateSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filepath string
+func (_e *DB_Expecter) CreateSnapshot(ctx interface{}, filepath interface{}) *DB_CreateSnapshot_Call {
+	return &DB_CreateSnapshot_Call{Call: _e.mock.On("CreateSnapshot", ctx, filepath)}
+}
+
+func (_c *DB_CreateSnapshot_Call) Run(run func(ctx context.Context, filepath string)) *DB_CreateSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DB_CreateSnapshot_Call) Return(_a0 client.SnapshotInfo, _a1 error) *DB_CreateSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DB_CreateSnapshot_Call) RunAndReturn(run func(context.Context, string) (client.SnapshotInfo, error)) *DB_CreateSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function with given fields: ctx, id
+func (_m *DB) DeleteWebhook(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DB_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type DB_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *DB_Expecter) DeleteWebhook(ctx interface{}, id interface{}) *DB_DeleteWebhook_Call {
+	return &DB_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", ctx, id)}
+}
+
+func (_c *DB_DeleteWebhook_Call) Run(run func(ctx context.Context, id string)) *DB_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DB_DeleteWebhook_Call) Return(_a0 error) *DB_DeleteWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DB_DeleteWebhook_Call) RunAndReturn(run func(context.Context, string) error) *DB_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Events provides a mock function with given fields:
 func (_m *DB) Events() events.Events {
 	ret := _m.Called()
@@ -492,6 +786,114 @@ func (_c *DB_GetAllSchema_Call) RunAndReturn(run func(context.Context) ([]client
 	return _c
 }
 
+// GetAllWebhookDeadLetters provides a mock function with given fields: ctx
+func (_m *DB) GetAllWebhookDeadLetters(ctx context.Context) ([]client.WebhookDeadLetter, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []client.WebhookDeadLetter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]client.WebhookDeadLetter, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []client.WebhookDeadLetter); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.WebhookDeadLetter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DB_GetAllWebhookDeadLetters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllWebhookDeadLetters'
+type DB_GetAllWebhookDeadLetters_Call struct {
+	*mock.Call
+}
+
+// GetAllWebhookDeadLetters is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DB_Expecter) GetAllWebhookDeadLetters(ctx interface{}) *DB_GetAllWebhookDeadLetters_Call {
+	return &DB_GetAllWebhookDeadLetters_Call{Call: _e.mock.On("GetAllWebhookDeadLetters", ctx)}
+}
+
+func (_c *DB_GetAllWebhookDeadLetters_Call) Run(run func(ctx context.Context)) *DB_GetAllWebhookDeadLetters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DB_GetAllWebhookDeadLetters_Call) Return(_a0 []client.WebhookDeadLetter, _a1 error) *DB_GetAllWebhookDeadLetters_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DB_GetAllWebhookDeadLetters_Call) RunAndReturn(run func(context.Context) ([]client.WebhookDeadLetter, error)) *DB_GetAllWebhookDeadLetters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllWebhooks provides a mock function with given fields: ctx
+func (_m *DB) GetAllWebhooks(ctx context.Context) ([]client.Webhook, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []client.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]client.Webhook, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []client.Webhook); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Webhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DB_GetAllWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllWebhooks'
+type DB_GetAllWebhooks_Call struct {
+	*mock.Call
+}
+
+// GetAllWebhooks is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DB_Expecter) GetAllWebhooks(ctx interface{}) *DB_GetAllWebhooks_Call {
+	return &DB_GetAllWebhooks_Call{Call: _e.mock.On("GetAllWebhooks", ctx)}
+}
+
+func (_c *DB_GetAllWebhooks_Call) Run(run func(ctx context.Context)) *DB_GetAllWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DB_GetAllWebhooks_Call) Return(_a0 []client.Webhook, _a1 error) *DB_GetAllWebhooks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DB_GetAllWebhooks_Call) RunAndReturn(run func(context.Context) ([]client.Webhook, error)) *DB_GetAllWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCollectionByName provides a mock function with given fields: _a0, _a1
 func (_m *DB) GetCollectionByName(_a0 context.Context, _a1 string) (client.Collection, error) {
 	ret := _m.Called(_a0, _a1)
@@ -657,6 +1059,113 @@ func (_c *DB_GetCollectionsByVersionID_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetEncryptionKey provides a mock function with given fields: ctx, collectionName
+func (_m *DB) GetEncryptionKey(ctx context.Context, collectionName string) ([]byte, error) {
+	ret := _m.Called(ctx, collectionName)
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]byte, error)); ok {
+		return rf(ctx, collectionName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(ctx, collectionName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, collectionName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DB_GetEncryptionKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEncryptionKey'
+type DB_GetEncryptionKey_Call struct {
+	*mock.Call
+}
+
+// GetEncryptionKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionName string
+func (_e *DB_Expecter) GetEncryptionKey(ctx interface{}, collectionName interface{}) *DB_GetEncryptionKey_Call {
+	return &DB_GetEncryptionKey_Call{Call: _e.mock.On("GetEncryptionKey", ctx, collectionName)}
+}
+
+func (_c *DB_GetEncryptionKey_Call) Run(run func(ctx context.Context, collectionName string)) *DB_GetEncryptionKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DB_GetEncryptionKey_Call) Return(_a0 []byte, _a1 error) *DB_GetEncryptionKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DB_GetEncryptionKey_Call) RunAndReturn(run func(context.Context, string) ([]byte, error)) *DB_GetEncryptionKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPolicy provides a mock function with given fields: ctx
+func (_m *DB) GetPolicy(ctx context.Context) (client.Policy, error) {
+	ret := _m.Called(ctx)
+
+	var r0 client.Policy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (client.Policy, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) client.Policy); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(client.Policy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DB_GetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPolicy'
+type DB_GetPolicy_Call struct {
+	*mock.Call
+}
+
+// GetPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DB_Expecter) GetPolicy(ctx interface{}) *DB_GetPolicy_Call {
+	return &DB_GetPolicy_Call{Call: _e.mock.On("GetPolicy", ctx)}
+}
+
+func (_c *DB_GetPolicy_Call) Run(run func(ctx context.Context)) *DB_GetPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DB_GetPolicy_Call) Return(_a0 client.Policy, _a1 error) *DB_GetPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DB_GetPolicy_Call) RunAndReturn(run func(context.Context) (client.Policy, error)) *DB_GetPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSchemasByName provides a mock function with given fields: _a0, _a1
 func (_m *DB) GetSchemasByName(_a0 context.Context, _a1 string) ([]client.SchemaDescription, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1229,6 +1738,50 @@ func (_c *DB_SetDefaultSchemaVersion_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// SetEncryptionKey provides a mock function with given fields: ctx, collectionName, key
+func (_m *DB) SetEncryptionKey(ctx context.Context, collectionName string, key []byte) error {
+	ret := _m.Called(ctx, collectionName, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) error); ok {
+		r0 = rf(ctx, collectionName, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DB_SetEncryptionKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEncryptionKey'
+type DB_SetEncryptionKey_Call struct {
+	*mock.Call
+}
+
+// SetEncryptionKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionName string
+//   - key []byte
+func (_e *DB_Expecter) SetEncryptionKey(ctx interface{}, collectionName interface{}, key interface{}) *DB_SetEncryptionKey_Call {
+	return &DB_SetEncryptionKey_Call{Call: _e.mock.On("SetEncryptionKey", ctx, collectionName, key)}
+}
+
+func (_c *DB_SetEncryptionKey_Call) Run(run func(ctx context.Context, collectionName string, key []byte)) *DB_SetEncryptionKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *DB_SetEncryptionKey_Call) Return(_a0 error) *DB_SetEncryptionKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DB_SetEncryptionKey_Call) RunAndReturn(run func(context.Context, string, []byte) error) *DB_SetEncryptionKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetMigration provides a mock function with given fields: _a0, _a1
 func (_m *DB) SetMigration(_a0 context.Context, _a1 client.LensConfig) error {
 	ret := _m.Called(_a0, _a1)
@@ -1272,6 +1825,49 @@ func (_c *DB_SetMigration_Call) RunAndReturn(run func(context.Context, client.Le
 	return _c
 }
 
+// SetPolicy provides a mock function with given fields: ctx, policy
+func (_m *DB) SetPolicy(ctx context.Context, policy client.Policy) error {
+	ret := _m.Called(ctx, policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, client.Policy) error); ok {
+		r0 = rf(ctx, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DB_SetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPolicy'
+type DB_SetPolicy_Call struct {
+	*mock.Call
+}
+
+// SetPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy client.Policy
+func (_e *DB_Expecter) SetPolicy(ctx interface{}, policy interface{}) *DB_SetPolicy_Call {
+	return &DB_SetPolicy_Call{Call: _e.mock.On("SetPolicy", ctx, policy)}
+}
+
+func (_c *DB_SetPolicy_Call) Run(run func(ctx context.Context, policy client.Policy)) *DB_SetPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(client.Policy))
+	})
+	return _c
+}
+
+func (_c *DB_SetPolicy_Call) Return(_a0 error) *DB_SetPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DB_SetPolicy_Call) RunAndReturn(run func(context.Context, client.Policy) error) *DB_SetPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithTxn provides a mock function with given fields: _a0
 func (_m *DB) WithTxn(_a0 datastore.Txn) client.Store {
 	ret := _m.Called(_a0)
@@ -0,0 +1,234 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package client
+
+import (
+	"context"
+
+	"golang.org/x/exp/slices"
+)
+
+// Permission is an operation that a [Role] may allow.
+type Permission string
+
+const (
+	// ReadPermission allows executing GraphQL queries and reading documents and descriptions.
+	ReadPermission Permission = "read"
+	// WritePermission allows creating, updating, deleting and reverting documents.
+	//
+	// It includes [ReadPermission] so that mutations may return their results.
+	WritePermission Permission = "write"
+	// SchemaAdminPermission allows adding and patching schemas, managing indexes and
+	// configuring schema migrations.
+	SchemaAdminPermission Permission = "schema_admin"
+	// P2PAdminPermission allows configuring replicators and P2P collections.
+	P2PAdminPermission Permission = "p2p_admin"
+	// BackupPermission allows exporting and importing backups.
+	BackupPermission Permission = "backup"
+	// AdminPermission allows managing the policy and webhooks, and dumping the database.
+	AdminPermission Permission = "admin"
+)
+
+// Permissions is the list of all known permissions.
+var Permissions = []Permission{
+	ReadPermission,
+	WritePermission,
+	SchemaAdminPermission,
+	P2PAdminPermission,
+	BackupPermission,
+	AdminPermission,
+}
+
+// Role is a named set of permissions.
+type Role struct {
+	// Name is the unique name of the role.
+	Name string `json:"name"`
+	// Permissions is the list of operations allowed by the role.
+	Permissions []Permission `json:"permissions"`
+	// Collections is an optional list of names of the collections that the role is scoped to.
+	//
+	// If empty, the role applies to all collections. Operations that do not target a single
+	// collection, such as adding a schema, are only allowed by roles that are not scoped.
+	Collections []string `json:"collections,omitempty"`
+}
+
+// allows returns true if the role allows the given permission on the given collection.
+//
+// If no collection is given, a scoped role only allows reads and writes, as they are checked
+// again against each collection they target.
+func (r Role) allows(permission Permission, collection string) bool {
+	if !slices.Contains(r.Permissions, permission) &&
+		!(permission == ReadPermission && slices.Contains(r.Permissions, WritePermission)) {
+		return false
+	}
+	if len(r.Collections) == 0 {
+		return true
+	}
+	if collection == "" {
+		return permission == ReadPermission || permission == WritePermission
+	}
+	return slices.Contains(r.Collections, collection)
+}
+
+// DocumentPolicy restricts access to the documents of a collection based on their owner.
+//
+// The owner of a document is the identity that created it. Documents without an owner
+// are not restricted.
+//
+// Each commit records the identity that made it, so changes replicated from other nodes
+// are subject to the same write restrictions as local ones.
+type DocumentPolicy struct {
+	// Collection is the name of the collection whose documents are restricted.
+	Collection string `json:"collection"`
+	// OwnerWrite restricts updating and deleting documents to their owner.
+	OwnerWrite bool `json:"ownerWrite,omitempty"`
+	// OwnerRead restricts reading documents to their owner and the members of the ReadRoles.
+	OwnerRead bool `json:"ownerRead,omitempty"`
+	// ReadRoles is the list of names of the roles whose members may read all documents
+	// when OwnerRead is set.
+	ReadRoles []string `json:"readRoles,omitempty"`
+}
+
+// Policy restricts the operations that authenticated callers may perform.
+//
+// An empty policy is not enforced, all callers may perform all operations.
+type Policy struct {
+	// Roles is the list of roles defined by the policy.
+	Roles []Role `json:"roles"`
+	// Members maps the identity of each caller to the names of the roles granted to them.
+	Members map[string][]string `json:"members"`
+	// Documents is the list of document level restrictions, at most one per collection.
+	Documents []DocumentPolicy `json:"documents,omitempty"`
+}
+
+// IsEmpty returns true if the policy defines no roles, no members and no document restrictions.
+func (p Policy) IsEmpty() bool {
+	return len(p.Roles) == 0 && len(p.Members) == 0 && len(p.Documents) == 0
+}
+
+// documentPolicy returns the document policy of the given collection, if any.
+func (p Policy) documentPolicy(collection string) (DocumentPolicy, bool) {
+	for _, documents := range p.Documents {
+		if documents.Collection == collection {
+			return documents, true
+		}
+	}
+	return DocumentPolicy{}, false
+}
+
+// IsDocumentAllowed returns true if the given identity may perform the given operation
+// on a document of the given collection owned by the given owner.
+//
+// Only the document level restrictions are taken into account, [Policy.IsAllowed] must also
+// be satisfied.
+func (p Policy) IsDocumentAllowed(identity string, permission Permission, collection string, owner string) bool {
+	documents, ok := p.documentPolicy(collection)
+	if !ok || owner == "" || owner == identity {
+		return true
+	}
+	switch permission {
+	case ReadPermission:
+		if !documents.OwnerRead {
+			return true
+		}
+		for _, name := range p.Members[identity] {
+			if slices.Contains(documents.ReadRoles, name) {
+				return true
+			}
+		}
+		return false
+	case WritePermission:
+		return !documents.OwnerWrite
+	default:
+		return true
+	}
+}
+
+// IsAllowed returns true if any of the roles granted to the given identity allows the
+// given permission on the given collection.
+//
+// If no collection is given, the permission is allowed as described by [Role].
+func (p Policy) IsAllowed(identity string, permission Permission, collection string) bool {
+	for _, name := range p.Members[identity] {
+		for _, role := range p.Roles {
+			if role.Name == name && role.allows(permission, collection) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type authorizationContextKey struct{}
+
+type identityContextKey struct{}
+
+// WithIdentity returns a copy of the given context that identifies the caller executing
+// operations with it.
+//
+// Documents created with the returned context will be owned by the given identity.
+func WithIdentity(ctx context.Context, identity string) context.Context {
+	return context.WithValue(ctx, identityContextKey{}, identity)
+}
+
+// IdentityFromContext returns the identity set on the given context by [WithIdentity]
+// or [WithAuthorization], if any.
+func IdentityFromContext(ctx context.Context) (string, bool) {
+	identity, ok := ctx.Value(identityContextKey{}).(string)
+	return identity, ok
+}
+
+// authorization is the identity of a caller along with the policy restricting them.
+type authorization struct {
+	identity string
+	policy   Policy
+}
+
+// WithAuthorization returns a copy of the given context that restricts the operations
+// executed with it to those the given identity is allowed to perform by the given policy.
+//
+// The identity is also set as by [WithIdentity].
+func WithAuthorization(ctx context.Context, identity string, policy Policy) context.Context {
+	ctx = WithIdentity(ctx, identity)
+	return context.WithValue(ctx, authorizationContextKey{}, authorization{identity, policy})
+}
+
+// CheckPermission returns an [ErrPermissionDenied] error if the given context is restricted
+// by [WithAuthorization] and does not allow the given permission on the given collection.
+func CheckPermission(ctx context.Context, permission Permission, collection string) error {
+	auth, ok := ctx.Value(authorizationContextKey{}).(authorization)
+	if !ok || auth.policy.IsAllowed(auth.identity, permission, collection) {
+		return nil
+	}
+	return NewErrPermissionDenied(auth.identity, permission, collection)
+}
+
+// HasDocumentPolicy returns true if the given context is restricted by [WithAuthorization]
+// with a policy that restricts access to the documents of the given collection.
+func HasDocumentPolicy(ctx context.Context, collection string) bool {
+	auth, ok := ctx.Value(authorizationContextKey{}).(authorization)
+	if !ok {
+		return false
+	}
+	_, ok = auth.policy.documentPolicy(collection)
+	return ok
+}
+
+// CheckDocumentPermission returns an [ErrPermissionDenied] error if the given context is
+// restricted by [WithAuthorization] and does not allow the given operation on a document
+// of the given collection owned by the given owner.
+func CheckDocumentPermission(ctx context.Context, permission Permission, collection string, owner string) error {
+	auth, ok := ctx.Value(authorizationContextKey{}).(authorization)
+	if !ok || auth.policy.IsDocumentAllowed(auth.identity, permission, collection, owner) {
+		return nil
+	}
+	return NewErrPermissionDenied(auth.identity, permission, collection)
+}
@@ -0,0 +1,91 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testPolicy = Policy{
+	Roles: []Role{
+		{Name: "admin", Permissions: []Permission{AdminPermission}},
+		{Name: "reader", Permissions: []Permission{ReadPermission}},
+		{Name: "user-writer", Permissions: []Permission{WritePermission}, Collections: []string{"User"}},
+		{Name: "user-schema", Permissions: []Permission{SchemaAdminPermission}, Collections: []string{"User"}},
+	},
+	Members: map[string][]string{
+		"alice": {"admin"},
+		"bob":   {"reader"},
+		"carol": {"user-writer"},
+		"erin":  {"user-schema"},
+	},
+}
+
+func TestPolicyIsAllowed_WithGrantedPermission_ReturnsTrue(t *testing.T) {
+	assert.True(t, testPolicy.IsAllowed("alice", AdminPermission, ""))
+	assert.True(t, testPolicy.IsAllowed("bob", ReadPermission, "Book"))
+}
+
+func TestPolicyIsAllowed_WithoutGrantedPermission_ReturnsFalse(t *testing.T) {
+	assert.False(t, testPolicy.IsAllowed("alice", ReadPermission, ""))
+	assert.False(t, testPolicy.IsAllowed("bob", WritePermission, "User"))
+	assert.False(t, testPolicy.IsAllowed("dave", ReadPermission, ""))
+}
+
+func TestPolicyIsAllowed_WithScopedRole_ReturnsTrueOnlyForScopedCollections(t *testing.T) {
+	assert.True(t, testPolicy.IsAllowed("carol", WritePermission, "User"))
+	assert.True(t, testPolicy.IsAllowed("carol", ReadPermission, "User"))
+	assert.True(t, testPolicy.IsAllowed("carol", WritePermission, ""))
+	assert.False(t, testPolicy.IsAllowed("carol", WritePermission, "Book"))
+	assert.False(t, testPolicy.IsAllowed("carol", ReadPermission, "Book"))
+}
+
+func TestPolicyIsAllowed_WithScopedRoleAndNoCollection_ReturnsFalseForAdminPermissions(t *testing.T) {
+	assert.True(t, testPolicy.IsAllowed("erin", SchemaAdminPermission, "User"))
+	assert.False(t, testPolicy.IsAllowed("erin", SchemaAdminPermission, ""))
+	assert.False(t, testPolicy.IsAllowed("erin", SchemaAdminPermission, "Book"))
+}
+
+func TestCheckPermission_WithoutAuthorization_ReturnsNil(t *testing.T) {
+	err := CheckPermission(context.Background(), AdminPermission, "")
+	require.NoError(t, err)
+}
+
+func TestCheckPermission_WithDeniedPermission_ReturnsError(t *testing.T) {
+	ctx := WithAuthorization(context.Background(), "bob", testPolicy)
+
+	err := CheckPermission(ctx, ReadPermission, "User")
+	require.NoError(t, err)
+
+	err = CheckPermission(ctx, WritePermission, "User")
+	require.ErrorIs(t, err, ErrPermissionDenied)
+}
+
+func TestPolicyIsDocumentAllowed_WithOwnerRestrictions_AllowsOwnerAndReadRoles(t *testing.T) {
+	policy := testPolicy
+	policy.Documents = []DocumentPolicy{{
+		Collection: "User",
+		OwnerWrite: true,
+		OwnerRead:  true,
+		ReadRoles:  []string{"admin"},
+	}}
+
+	assert.True(t, policy.IsDocumentAllowed("carol", WritePermission, "User", "carol"))
+	assert.True(t, policy.IsDocumentAllowed("carol", WritePermission, "User", ""))
+	assert.False(t, policy.IsDocumentAllowed("carol", WritePermission, "User", "bob"))
+	assert.True(t, policy.IsDocumentAllowed("alice", ReadPermission, "User", "carol"))
+	assert.False(t, policy.IsDocumentAllowed("bob", ReadPermission, "User", "carol"))
+	assert.True(t, policy.IsDocumentAllowed("bob", ReadPermission, "Book", "carol"))
+}
@@ -20,6 +20,8 @@ const (
 	RelatedObjectID = "_id"
 
 	Cid         = "cid"
+	Connect     = "connect"
+	Create      = "create"
 	Data        = "data"
 	DocKey      = "dockey"
 	DocKeys     = "dockeys"
@@ -27,7 +29,9 @@ const (
 	FieldIDName = "fieldId"
 	Id          = "id"
 	Ids         = "ids"
+	Input       = "input"
 	ShowDeleted = "showDeleted"
+	Update      = "update"
 
 	FilterClause  = "filter"
 	GroupByClause = "groupBy"
@@ -35,6 +39,10 @@ const (
 	OffsetClause  = "offset"
 	OrderClause   = "order"
 	DepthClause   = "depth"
+	AsOfClause    = "asOf"
+
+	AsOfHeight = "height"
+	AsOfTime   = "time"
 
 	AverageFieldName = "_avg"
 	CountFieldName   = "_count"
@@ -43,6 +51,8 @@ const (
 	DeletedFieldName = "_deleted"
 	SumFieldName     = "_sum"
 	VersionFieldName = "_version"
+	DiffFieldName    = "_diff"
+	OwnerFieldName   = "_owner"
 
 	ExplainLabel = "explain"
 
@@ -59,10 +69,21 @@ const (
 	FieldNameFieldName       = "fieldName"
 	FieldIDFieldName         = "fieldId"
 	DeltaFieldName           = "delta"
+	SignerFieldName          = "signer"
 
 	LinksNameFieldName = "name"
 	LinksCidFieldName  = "cid"
 
+	DiffTypeName        = "FieldDiff"
+	DiffFieldFieldName  = "field"
+	DiffBeforeFieldName = "before"
+	DiffAfterFieldName  = "after"
+	DiffFrom            = "from"
+	DiffTo              = "to"
+
+	OrderDistanceFrom = "_distanceFrom"
+	OrderDirectionArg = "_direction"
+
 	ASC  = OrderDirection("ASC")
 	DESC = OrderDirection("DESC")
 )
@@ -82,6 +103,8 @@ var (
 		AverageFieldName:  true,
 		KeyFieldName:      true,
 		DeletedFieldName:  true,
+		DiffFieldName:     true,
+		OwnerFieldName:    true,
 	}
 
 	Aggregates = map[string]struct{}{
@@ -104,10 +127,17 @@ var (
 		FieldNameFieldName,
 		FieldIDFieldName,
 		DeltaFieldName,
+		SignerFieldName,
 	}
 
 	LinksFields = []string{
 		LinksNameFieldName,
 		LinksCidFieldName,
 	}
+
+	DiffFields = []string{
+		DiffFieldFieldName,
+		DiffBeforeFieldName,
+		DiffAfterFieldName,
+	}
 )
@@ -14,8 +14,29 @@ const (
 	FilterOpOr  = "_or"
 	FilterOpAnd = "_and"
 	FilterOpNot = "_not"
+
+	FilterOpNear         = "_near"
+	FilterOpWithinRadius = "_withinRadius"
+	FilterOpWithinBox    = "_withinBox"
+
+	// The names of the properties of the geo filter operators.
+	GeoNearPoint       = "point"
+	GeoNearMaxDistance = "maxDistance"
+	GeoNearMinDistance = "minDistance"
+	GeoRadiusCenter    = "center"
+	GeoRadiusRadius    = "radius"
+	GeoBoxSouthWest    = "southWest"
+	GeoBoxNorthEast    = "northEast"
 )
 
+// GeoFilterOps are the filter operators of geo points, whose conditions are objects of their own
+// properties rather than of fields.
+var GeoFilterOps = map[string]struct{}{
+	FilterOpNear:         {},
+	FilterOpWithinRadius: {},
+	FilterOpWithinBox:    {},
+}
+
 // Filter contains the parsed condition map to be
 // run by the Filter Evaluator.
 // @todo: Cache filter structure for faster condition
@@ -19,6 +19,8 @@ const (
 	CreateObjects
 	UpdateObjects
 	DeleteObjects
+	RevertObjects
+	UpsertObjects
 )
 
 // ObjectMutation is a field on the `mutation` operation of a graphql request. It includes
@@ -35,9 +37,43 @@ type ObjectMutation struct {
 	Filter immutable.Option[Filter]
 	Data   string
 
+	// Input holds the documents given as typed input objects, if any, in which
+	// case Data is empty.
+	Input []MutationInput
+
+	// Cid is the version that the document should be reverted to
+	// if this is a revert mutation.
+	Cid string
+
+	// Update is the patch applied to the matching document if this is an
+	// upsert mutation, Data then holds the document to create if nothing matches.
+	Update string
+
 	Fields []Selection
 }
 
+// MutationInput is a document given as a typed input object of a mutation.
+type MutationInput struct {
+	// Data is the json representation of the non-relation fields of the document.
+	Data string
+
+	// Relations holds the documents to relate the document to, in the order they were
+	// given. Relation fields on object arrays may hold more than one entry.
+	Relations []RelationInput
+}
+
+// RelationInput relates a document to either an existing document, or a new one.
+type RelationInput struct {
+	// Name is the name of the relation field.
+	Name string
+
+	// Connect is the dockey of the existing document to relate to.
+	Connect string
+
+	// Create is the new document to create and relate to, if Connect is empty.
+	Create *MutationInput
+}
+
 // ToSelect returns a basic Select object, with the same Name, Alias, and Fields as
 // the Mutation object. Used to create a Select planNode for the mutation return objects.
 func (m ObjectMutation) ToSelect() *Select {
@@ -10,6 +10,8 @@
 
 package request
 
+import "github.com/sourcenetwork/immutable"
+
 type (
 	OrderDirection string
 
@@ -23,6 +25,13 @@ type (
 		// and the direction would be "DESC"
 		Fields    []string
 		Direction OrderDirection
+
+		// DistanceFrom is the latitude and longitude of the point the distance of the geo point
+		// field is measured from, if ordering by distance.
+		//
+		// Given the statement: {order: {location: {_distanceFrom: {latitude: 1, longitude: 2}}}}
+		// The value would be [1, 2].
+		DistanceFrom immutable.Option[[2]float64]
 	}
 
 	OrderBy struct {
@@ -11,6 +11,8 @@
 package request
 
 import (
+	"time"
+
 	"github.com/sourcenetwork/immutable"
 )
 
@@ -20,6 +22,7 @@ type SelectionType int
 const (
 	ObjectSelection SelectionType = iota
 	CommitSelection
+	DiffSelection
 )
 
 // Select is a complex Field with strong typing.
@@ -31,6 +34,14 @@ type Select struct {
 	DocKeys immutable.Option[[]string]
 	CID     immutable.Option[string]
 
+	// AsOf is the point in history at which documents should be read.
+	AsOf immutable.Option[AsOf]
+
+	// DiffFrom and DiffTo are the composite commit CIDs of the document versions
+	// compared by a _diff selection.
+	DiffFrom immutable.Option[string]
+	DiffTo   immutable.Option[string]
+
 	// Root is the top level type of parsed request
 	Root SelectionType
 
@@ -45,6 +56,17 @@ type Select struct {
 	ShowDeleted bool
 }
 
+// AsOf describes a point in the history of a collection.
+//
+// Exactly one of Height and Time should be set.
+type AsOf struct {
+	// Height is the maximum height of the document versions to read.
+	Height immutable.Option[uint64]
+	// Time is the latest point in time at which document versions to read were
+	// recorded by the local node.
+	Time immutable.Option[time.Time]
+}
+
 // Validate validates the Select.
 func (s *Select) Validate() []error {
 	result := []error{}
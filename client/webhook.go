@@ -0,0 +1,72 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package client
+
+import "time"
+
+// Webhook is an HTTP endpoint that changes made to the documents of a local collection
+// are posted to.
+type Webhook struct {
+	// ID is the unique identifier of the webhook.
+	//
+	// It is assigned by the database when the webhook is added.
+	ID string `json:"id"`
+	// Collection is the name of the collection whose changes will be posted.
+	Collection string `json:"collection"`
+	// Filter is an optional GQL filter, e.g. `{age: {_gt: 20}}`, that the changed document
+	// must match in order for the change to be posted.
+	Filter string `json:"filter,omitempty"`
+	// URL is the address that changes will be posted to.
+	URL string `json:"url"`
+	// Secret is an optional key used to sign the body of each request.
+	//
+	// If provided, the hex encoded HMAC-SHA256 of the request body will be sent in
+	// the [WebhookSignatureHeader] header. It is never returned once the webhook has
+	// been added.
+	Secret string `json:"secret,omitempty"`
+}
+
+// WebhookSignatureHeader is the name of the header holding the signature of a webhook request.
+const WebhookSignatureHeader = "X-Defra-Signature"
+
+// WebhookPayload is the body of the request posted to a webhook for each change.
+type WebhookPayload struct {
+	// WebhookID is the ID of the webhook that the payload is being posted to.
+	WebhookID string `json:"webhookId"`
+	// Collection is the name of the collection the changed document belongs to.
+	Collection string `json:"collection"`
+	// DocKey is the key of the changed document.
+	DocKey string `json:"docKey"`
+	// Cid is the CID of the composite block holding the change.
+	Cid string `json:"cid"`
+	// SchemaRoot is the schema root of the collection the changed document belongs to.
+	SchemaRoot string `json:"schemaRoot"`
+	// Height is the height of the change within the Merkle DAG of the document.
+	Height uint64 `json:"height"`
+}
+
+// WebhookDeadLetter is a payload that could not be delivered to a webhook after
+// all retry attempts were exhausted.
+type WebhookDeadLetter struct {
+	// ID is the unique identifier of the dead letter.
+	ID string `json:"id"`
+	// Payload is the payload that failed to be delivered.
+	Payload WebhookPayload `json:"payload"`
+	// URL is the address that the payload was posted to.
+	URL string `json:"url"`
+	// Attempts is the number of delivery attempts made, zero if the payload was dropped
+	// because the delivery queue of the webhook was full.
+	Attempts int `json:"attempts"`
+	// Error is the error returned by the final delivery attempt.
+	Error string `json:"error"`
+	// Time is the time at which the final delivery attempt failed.
+	Time time.Time `json:"time"`
+}
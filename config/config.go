@@ -44,14 +44,17 @@ package config
 
 import (
 	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net"
+	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
 	"text/template"
 
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/mitchellh/mapstructure"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/spf13/pflag"
@@ -97,6 +100,14 @@ func DefaultConfig() *Config {
 
 	cfg.v.SetEnvPrefix(defraEnvPrefix)
 	cfg.v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	// The encryption key is left out of the config file, it has to be bound
+	// explicitly for viper to read it from the environment.
+	if err := cfg.v.BindEnv("datastore.encryptionkey"); err != nil {
+		panic(err)
+	}
+	if err := cfg.v.BindEnv("datastore.encryptionkeypath"); err != nil {
+		panic(err)
+	}
 
 	cfg.v.SetConfigName(DefaultConfigFileName)
 	cfg.v.SetConfigType(configType)
@@ -202,12 +213,25 @@ func (cfg *Config) paramsPreprocessing() error {
 	if !filepath.IsAbs(cfg.v.GetString("datastore.badger.path")) {
 		cfg.v.Set("datastore.badger.path", filepath.Join(cfg.Rootdir, cfg.v.GetString("datastore.badger.path")))
 	}
+	if !filepath.IsAbs(cfg.v.GetString("datastore.pebble.path")) {
+		cfg.v.Set("datastore.pebble.path", filepath.Join(cfg.Rootdir, cfg.v.GetString("datastore.pebble.path")))
+	}
 	if !filepath.IsAbs(cfg.v.GetString("api.privkeypath")) {
 		cfg.v.Set("api.privkeypath", filepath.Join(cfg.Rootdir, cfg.v.GetString("api.privkeypath")))
 	}
 	if !filepath.IsAbs(cfg.v.GetString("api.pubkeypath")) {
 		cfg.v.Set("api.pubkeypath", filepath.Join(cfg.Rootdir, cfg.v.GetString("api.pubkeypath")))
 	}
+	if cfg.v.GetString("datastore.encryptionkeypath") != "" &&
+		!filepath.IsAbs(cfg.v.GetString("datastore.encryptionkeypath")) {
+		cfg.v.Set(
+			"datastore.encryptionkeypath",
+			filepath.Join(cfg.Rootdir, cfg.v.GetString("datastore.encryptionkeypath")),
+		)
+	}
+	if cfg.v.GetString("api.jwkspath") != "" && !filepath.IsAbs(cfg.v.GetString("api.jwkspath")) {
+		cfg.v.Set("api.jwkspath", filepath.Join(cfg.Rootdir, cfg.v.GetString("api.jwkspath")))
+	}
 
 	// log.logger configuration as a string
 	logloggerAsStringSlice := cfg.v.GetStringSlice("log.logger")
@@ -223,6 +247,16 @@ func (cfg *Config) paramsPreprocessing() error {
 	if err := expandHomeDir(&cfg.API.PubKeyPath); err != nil {
 		return err
 	}
+	if cfg.API.JWKSPath != "" {
+		if err := expandHomeDir(&cfg.API.JWKSPath); err != nil {
+			return err
+		}
+	}
+	if cfg.Datastore.EncryptionKeyPath != "" {
+		if err := expandHomeDir(&cfg.Datastore.EncryptionKeyPath); err != nil {
+			return err
+		}
+	}
 
 	var bs ByteSize
 	if err := bs.Set(cfg.v.GetString("datastore.badger.valuelogfilesize")); err != nil {
@@ -245,7 +279,20 @@ type DatastoreConfig struct {
 	Store         string
 	Memory        MemoryConfig
 	Badger        BadgerConfig
+	Pebble        PebbleConfig
 	MaxTxnRetries int
+	// ChangeLogRetention is the maximum number of change log entries retained per collection.
+	//
+	// A value of zero will retain all entries indefinitely.
+	ChangeLogRetention uint64
+	// EncryptionKeyPath is the path to the file holding the hex encoded key used to encrypt
+	// the datastore at rest.
+	EncryptionKeyPath string
+	// EncryptionKey is the hex encoded key used to encrypt the datastore at rest.
+	//
+	// It is intended to be set through the DEFRA_DATASTORE_ENCRYPTIONKEY environment variable
+	// and cannot be set together with EncryptionKeyPath.
+	EncryptionKey string
 }
 
 // BadgerConfig configures Badger's on-disk / filesystem mode.
@@ -255,6 +302,11 @@ type BadgerConfig struct {
 	*badgerds.Options
 }
 
+// PebbleConfig configures the Pebble on-disk / filesystem datastore.
+type PebbleConfig struct {
+	Path string
+}
+
 // MemoryConfig configures of Badger's memory mode.
 type MemoryConfig struct {
 	Size uint64
@@ -270,19 +322,67 @@ func defaultDatastoreConfig() *DatastoreConfig {
 			ValueLogFileSize: 1 * GiB,
 			Options:          &opts,
 		},
+		Pebble: PebbleConfig{
+			Path: "pebble",
+		},
 		MaxTxnRetries: 5,
 	}
 }
 
 func (dbcfg DatastoreConfig) validate() error {
 	switch dbcfg.Store {
-	case "badger", "memory":
+	case "badger", "memory", "pebble":
 	default:
 		return NewErrInvalidDatastoreType(dbcfg.Store)
 	}
+	if dbcfg.Store == "pebble" && (dbcfg.EncryptionKey != "" || dbcfg.EncryptionKeyPath != "") {
+		return NewErrEncryptionNotSupported(dbcfg.Store)
+	}
+	if dbcfg.EncryptionKey != "" && dbcfg.EncryptionKeyPath != "" {
+		return ErrConflictingEncryptionKeys
+	}
+	if dbcfg.EncryptionKey != "" {
+		if _, err := ParseEncryptionKey(dbcfg.EncryptionKey); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// LoadEncryptionKey returns the key used to encrypt the datastore at rest.
+//
+// The key is read from the file at EncryptionKeyPath if set, otherwise it is taken from
+// EncryptionKey. Nil is returned if the datastore is not encrypted.
+func (dbcfg DatastoreConfig) LoadEncryptionKey() ([]byte, error) {
+	if dbcfg.EncryptionKeyPath == "" {
+		if dbcfg.EncryptionKey == "" {
+			return nil, nil
+		}
+		return ParseEncryptionKey(dbcfg.EncryptionKey)
+	}
+	data, err := os.ReadFile(dbcfg.EncryptionKeyPath)
+	if err != nil {
+		return nil, NewErrFailedToReadEncryptionKey(err, dbcfg.EncryptionKeyPath)
+	}
+	return ParseEncryptionKey(string(data))
+}
+
+// ParseEncryptionKey decodes the given hex encoded AES key.
+//
+// The key must be 16, 24 or 32 bytes long to respectively select AES-128, AES-192 or AES-256.
+func ParseEncryptionKey(encoded string) ([]byte, error) {
+	key, err := hex.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, NewErrInvalidEncryptionKey(err)
+	}
+	switch len(key) {
+	case 16, 24, 32:
+		return key, nil
+	default:
+		return nil, ErrInvalidEncryptionKey
+	}
+}
+
 // APIConfig configures the API endpoints.
 type APIConfig struct {
 	Address        string
@@ -291,6 +391,18 @@ type APIConfig struct {
 	PubKeyPath     string
 	PrivKeyPath    string
 	Email          string
+	// APIKeys are the static API keys accepted by the server, as <identity>=<key> pairs.
+	APIKeys []string `mapstructure:"apikeys"`
+	// JWKSPath is the path to the JWKS file used to verify JWT bearer tokens.
+	JWKSPath string
+	// JWTIssuer is the issuer JWT bearer tokens are required to have (optional).
+	JWTIssuer string
+	// JWTAudience is the audience JWT bearer tokens are required to have (optional).
+	JWTAudience string
+	// ClientAPIKey is the API key sent by clients connecting to the API.
+	ClientAPIKey string
+	// ClientToken is the JWT bearer token sent by clients connecting to the API.
+	ClientToken string
 }
 
 func defaultAPIConfig() *APIConfig {
@@ -301,10 +413,15 @@ func defaultAPIConfig() *APIConfig {
 		PubKeyPath:     "certs/server.key",
 		PrivKeyPath:    "certs/server.crt",
 		Email:          DefaultAPIEmail,
+		APIKeys:        []string{},
 	}
 }
 
 func (apicfg *APIConfig) validate() error {
+	if _, err := apicfg.ParseAPIKeys(); err != nil {
+		return err
+	}
+
 	if apicfg.Address == "" {
 		return ErrInvalidDatabaseURL
 	}
@@ -339,6 +456,22 @@ func isValidDomainName(domain string) bool {
 	return asciiDomain == domain
 }
 
+// ParseAPIKeys returns the configured API keys mapped to the identity of their holder.
+func (apicfg *APIConfig) ParseAPIKeys() (map[string]string, error) {
+	keys := make(map[string]string, len(apicfg.APIKeys))
+	for _, pair := range apicfg.APIKeys {
+		identity, key, ok := strings.Cut(pair, "=")
+		if !ok || identity == "" || key == "" {
+			return nil, ErrInvalidAPIKey
+		}
+		if _, exists := keys[key]; exists {
+			return nil, NewErrDuplicateAPIKey(identity)
+		}
+		keys[key] = identity
+	}
+	return keys, nil
+}
+
 // AddressToURL provides the API address as URL.
 func (apicfg *APIConfig) AddressToURL() string {
 	if apicfg.TLS {
@@ -349,20 +482,24 @@ func (apicfg *APIConfig) AddressToURL() string {
 
 // NetConfig configures aspects of network and peer-to-peer.
 type NetConfig struct {
-	P2PAddress    string
-	P2PDisabled   bool
-	Peers         string
-	PubSubEnabled bool `mapstructure:"pubsub"`
-	RelayEnabled  bool `mapstructure:"relay"`
+	P2PAddress          string
+	P2PDisabled         bool
+	Peers               string
+	PubSubEnabled       bool `mapstructure:"pubsub"`
+	RelayEnabled        bool `mapstructure:"relay"`
+	TrustedSigners      string
+	AllowUnsignedBlocks bool
 }
 
 func defaultNetConfig() *NetConfig {
 	return &NetConfig{
-		P2PAddress:    "/ip4/0.0.0.0/tcp/9171",
-		P2PDisabled:   false,
-		Peers:         "",
-		PubSubEnabled: true,
-		RelayEnabled:  false,
+		P2PAddress:          "/ip4/0.0.0.0/tcp/9171",
+		P2PDisabled:         false,
+		Peers:               "",
+		PubSubEnabled:       true,
+		RelayEnabled:        false,
+		TrustedSigners:      "",
+		AllowUnsignedBlocks: false,
 	}
 }
 
@@ -382,6 +519,14 @@ func (netcfg *NetConfig) validate() error {
 			maddrs[i] = addr
 		}
 	}
+	if len(netcfg.TrustedSigners) > 0 {
+		for _, signer := range strings.Split(netcfg.TrustedSigners, ",") {
+			_, err := peer.Decode(signer)
+			if err != nil {
+				return NewErrInvalidTrustedSigners(err, netcfg.TrustedSigners)
+			}
+		}
+	}
 	return nil
 }
 
@@ -383,6 +383,20 @@ func TestValidationInvalidNetConfigPeers(t *testing.T) {
 	assert.ErrorIs(t, err, ErrFailedToValidateConfig)
 }
 
+func TestValidationNetConfigTrustedSigners(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Net.TrustedSigners = "QmYyQSo1c1Ym7orWxLYvCrM2EmxFTANf8wXmmE7DWjhx5N"
+	err := cfg.validate()
+	assert.NoError(t, err)
+}
+
+func TestValidationInvalidNetConfigTrustedSigners(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Net.TrustedSigners = "/ip4/127.0.0.1/udp/1234"
+	err := cfg.validate()
+	assert.ErrorIs(t, err, ErrFailedToValidateConfig)
+}
+
 func TestValidationInvalidLoggingConfig(t *testing.T) {
 	cfg := DefaultConfig()
 	cfg.Log.Level = "546578"
@@ -433,3 +447,89 @@ func TestValidationAddressDomainWithSubdomainWrongPortIsInvalid(t *testing.T) {
 	err := cfg.validate()
 	assert.ErrorIs(t, err, ErrNoPortWithDomain)
 }
+
+func TestValidationAPIKeysValid(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.API.APIKeys = []string{"alice=secret1", "bob=secret2"}
+	err := cfg.validate()
+	assert.NoError(t, err)
+
+	keys, err := cfg.API.ParseAPIKeys()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"secret1": "alice", "secret2": "bob"}, keys)
+}
+
+func TestValidationAPIKeysWithoutIdentityIsInvalid(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.API.APIKeys = []string{"secret"}
+	err := cfg.validate()
+	assert.ErrorIs(t, err, ErrInvalidAPIKey)
+}
+
+func TestValidationAPIKeysDuplicateIsInvalid(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.API.APIKeys = []string{"alice=secret", "bob=secret"}
+	err := cfg.validate()
+	assert.ErrorIs(t, err, ErrDuplicateAPIKey)
+}
+
+func TestValidationEncryptionKeyAndPathIsInvalid(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Datastore.EncryptionKey = "000102030405060708090a0b0c0d0e0f"
+	cfg.Datastore.EncryptionKeyPath = "datastore.key"
+	err := cfg.validate()
+	assert.ErrorIs(t, err, ErrConflictingEncryptionKeys)
+}
+
+func TestValidationPebbleStoreIsValid(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Datastore.Store = "pebble"
+	err := cfg.validate()
+	assert.NoError(t, err)
+}
+
+func TestValidationPebbleStoreWithEncryptionKeyIsInvalid(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Datastore.Store = "pebble"
+	cfg.Datastore.EncryptionKey = "000102030405060708090a0b0c0d0e0f"
+	err := cfg.validate()
+	assert.ErrorIs(t, err, ErrEncryptionNotSupported)
+}
+
+func TestValidationEncryptionKeyWithInvalidLengthIsInvalid(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Datastore.EncryptionKey = "0001020304"
+	err := cfg.validate()
+	assert.ErrorIs(t, err, ErrInvalidEncryptionKey)
+}
+
+func TestLoadEncryptionKeyFromEnv(t *testing.T) {
+	cfg := DefaultConfig()
+	FixtureEnvKeyValue(t, "DEFRA_DATASTORE_ENCRYPTIONKEY", "000102030405060708090a0b0c0d0e0f")
+
+	err := cfg.LoadWithRootdir(false)
+	assert.NoError(t, err)
+
+	key, err := cfg.Datastore.LoadEncryptionKey()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}, key)
+}
+
+func TestLoadEncryptionKeyFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "datastore.key")
+	err := os.WriteFile(path, []byte("000102030405060708090a0b0c0d0e0f\n"), 0o600)
+	assert.NoError(t, err)
+
+	cfg := DefaultConfig()
+	cfg.Datastore.EncryptionKeyPath = path
+	key, err := cfg.Datastore.LoadEncryptionKey()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}, key)
+}
+
+func TestLoadEncryptionKeyWithoutKeyReturnsNil(t *testing.T) {
+	cfg := DefaultConfig()
+	key, err := cfg.Datastore.LoadEncryptionKey()
+	assert.NoError(t, err)
+	assert.Nil(t, key)
+}
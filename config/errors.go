@@ -30,6 +30,7 @@ const (
 	errInvalidP2PAddress           string = "invalid P2P address"
 	errInvalidRPCAddress           string = "invalid RPC address"
 	errInvalidBootstrapPeers       string = "invalid bootstrap peers"
+	errInvalidTrustedSigners       string = "invalid trusted signers"
 	errInvalidLogLevel             string = "invalid log level"
 	errInvalidDatastoreType        string = "invalid store type"
 	errInvalidLogFormat            string = "invalid log format"
@@ -50,6 +51,12 @@ const (
 	errMissingPortNumber           string = "missing port number"
 	errNoPortWithDomain            string = "cannot provide port with domain name"
 	errInvalidRootDir              string = "invalid root directory"
+	errInvalidAPIKey               string = "api key must be provided as <identity>=<key> pair"
+	errDuplicateAPIKey             string = "duplicate api key"
+	errConflictingEncryptionKeys   string = "encryption key and encryption key path cannot both be set"
+	errInvalidEncryptionKey        string = "encryption key must be a hex encoded 16, 24 or 32 bytes key"
+	errFailedToReadEncryptionKey   string = "failed to read encryption key file"
+	errEncryptionNotSupported      string = "encryption at rest is not supported by the store"
 )
 
 var (
@@ -66,6 +73,7 @@ var (
 	ErrInvalidP2PAddress           = errors.New(errInvalidP2PAddress)
 	ErrInvalidRPCAddress           = errors.New(errInvalidRPCAddress)
 	ErrInvalidBootstrapPeers       = errors.New(errInvalidBootstrapPeers)
+	ErrInvalidTrustedSigners       = errors.New(errInvalidTrustedSigners)
 	ErrInvalidLogLevel             = errors.New(errInvalidLogLevel)
 	ErrInvalidDatastoreType        = errors.New(errInvalidDatastoreType)
 	ErrOverrideConfigConvertFailed = errors.New(errOverrideConfigConvertFailed)
@@ -88,6 +96,12 @@ var (
 	ErrMissingPortNumber           = errors.New(errMissingPortNumber)
 	ErrNoPortWithDomain            = errors.New(errNoPortWithDomain)
 	ErrorInvalidRootDir            = errors.New(errInvalidRootDir)
+	ErrInvalidAPIKey               = errors.New(errInvalidAPIKey)
+	ErrDuplicateAPIKey             = errors.New(errDuplicateAPIKey)
+	ErrConflictingEncryptionKeys   = errors.New(errConflictingEncryptionKeys)
+	ErrInvalidEncryptionKey        = errors.New(errInvalidEncryptionKey)
+	ErrFailedToReadEncryptionKey   = errors.New(errFailedToReadEncryptionKey)
+	ErrEncryptionNotSupported      = errors.New(errEncryptionNotSupported)
 )
 
 func NewErrFailedToWriteFile(inner error, path string) error {
@@ -142,6 +156,10 @@ func NewErrInvalidBootstrapPeers(inner error, peers string) error {
 	return errors.Wrap(errInvalidBootstrapPeers, inner, errors.NewKV("peers", peers))
 }
 
+func NewErrInvalidTrustedSigners(inner error, signers string) error {
+	return errors.Wrap(errInvalidTrustedSigners, inner, errors.NewKV("signers", signers))
+}
+
 func NewErrInvalidLogLevel(level string) error {
 	return errors.New(errInvalidLogLevel, errors.NewKV("level", level))
 }
@@ -217,3 +235,19 @@ func NewErrInvalidDatastorePath(path string) error {
 func NewErrInvalidRootDir(path string) error {
 	return errors.New(errInvalidRootDir, errors.NewKV("path", path))
 }
+
+func NewErrDuplicateAPIKey(identity string) error {
+	return errors.New(errDuplicateAPIKey, errors.NewKV("identity", identity))
+}
+
+func NewErrInvalidEncryptionKey(inner error) error {
+	return errors.Wrap(errInvalidEncryptionKey, inner)
+}
+
+func NewErrFailedToReadEncryptionKey(inner error, path string) error {
+	return errors.Wrap(errFailedToReadEncryptionKey, inner, errors.NewKV("path", path))
+}
+
+func NewErrEncryptionNotSupported(storeType string) error {
+	return errors.New(errEncryptionNotSupported, errors.NewKV("store_type", storeType))
+}
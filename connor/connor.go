@@ -20,6 +20,8 @@ func matchWith(op string, conditions, data any) (bool, error) {
 		return and(conditions, data)
 	case "_eq":
 		return eq(conditions, data)
+	case "_exists":
+		return exists(conditions, data)
 	case "_ge":
 		return ge(conditions, data)
 	case "_gt":
@@ -42,6 +44,12 @@ func matchWith(op string, conditions, data any) (bool, error) {
 		return nlike(conditions, data)
 	case "_not":
 		return not(conditions, data)
+	case "_near":
+		return near(conditions, data)
+	case "_withinRadius":
+		return withinRadius(conditions, data)
+	case "_withinBox":
+		return withinBox(conditions, data)
 	default:
 		return false, NewErrUnknownOperator(op)
 	}
@@ -1,11 +1,13 @@
 package connor
 
 import (
+	"math/big"
 	"reflect"
 	"time"
 
 	"github.com/sourcenetwork/immutable"
 
+	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/connor/numbers"
 	ctime "github.com/sourcenetwork/defradb/connor/time"
 	"github.com/sourcenetwork/defradb/core"
@@ -65,7 +67,13 @@ func eq(condition, data any) (bool, error) {
 		return numbers.Equal(cn, data), nil
 	case float64:
 		return numbers.Equal(cn, data), nil
+	case *big.Int, client.Decimal:
+		return numbers.Equal(cn, data), nil
 	case map[FilterKey]any:
+		if p, hasPath := getPath(cn); hasPath {
+			return path(p, cn, data)
+		}
+
 		m := true
 		for prop, cond := range cn {
 			var err error
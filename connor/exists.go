@@ -0,0 +1,17 @@
+package connor
+
+import "github.com/sourcenetwork/defradb/client"
+
+// exists is an operator which checks whether a value
+// is set.
+func exists(condition, data any) (bool, error) {
+	return matchExists(condition, data != nil)
+}
+
+func matchExists(condition any, exists bool) (bool, error) {
+	cn, ok := condition.(bool)
+	if !ok {
+		return false, client.NewErrUnhandledType("condition", condition)
+	}
+	return cn == exists, nil
+}
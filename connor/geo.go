@@ -0,0 +1,158 @@
+package connor
+
+import (
+	"math"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+)
+
+// geoArea is the area of the points matching the condition of a geo operator.
+type geoArea interface {
+	contains(client.GeoPoint) bool
+	// bounds returns the boxes covering the area, or false if it is unbounded.
+	bounds() ([]client.GeoBox, bool)
+}
+
+// nearArea is the area of the points whose distance from a point is within a range.
+type nearArea struct {
+	point       client.GeoPoint
+	minDistance float64
+	maxDistance float64
+}
+
+func (a nearArea) contains(p client.GeoPoint) bool {
+	distance := a.point.Distance(p)
+	return distance >= a.minDistance && distance <= a.maxDistance
+}
+
+func (a nearArea) bounds() ([]client.GeoBox, bool) {
+	if math.IsInf(a.maxDistance, 1) {
+		return nil, false
+	}
+	circle := client.GeoCircle{Center: a.point, Radius: a.maxDistance}
+	return circle.Bounds().Split(), true
+}
+
+type circleArea client.GeoCircle
+
+func (a circleArea) contains(p client.GeoPoint) bool {
+	return client.GeoCircle(a).Contains(p)
+}
+
+func (a circleArea) bounds() ([]client.GeoBox, bool) {
+	return client.GeoCircle(a).Bounds().Split(), true
+}
+
+type boxArea client.GeoBox
+
+func (a boxArea) contains(p client.GeoPoint) bool {
+	return client.GeoBox(a).Contains(p)
+}
+
+func (a boxArea) bounds() ([]client.GeoBox, bool) {
+	return client.GeoBox(a).Split(), true
+}
+
+// near is an operator which checks whether the distance of a geo point from
+// the given point is within the given range.
+func near(condition, data any) (bool, error) {
+	return matchGeoArea(request.FilterOpNear, condition, data)
+}
+
+// withinRadius is an operator which checks whether a geo point is within
+// the given radius of the given center.
+func withinRadius(condition, data any) (bool, error) {
+	return matchGeoArea(request.FilterOpWithinRadius, condition, data)
+}
+
+// withinBox is an operator which checks whether a geo point is within the
+// box of the given corners.
+func withinBox(condition, data any) (bool, error) {
+	return matchGeoArea(request.FilterOpWithinBox, condition, data)
+}
+
+func matchGeoArea(op string, condition, data any) (bool, error) {
+	area, err := newGeoArea(op, condition)
+	if err != nil {
+		return false, err
+	}
+	point, ok := data.(client.GeoPoint)
+	if !ok {
+		return false, nil
+	}
+	return area.contains(point), nil
+}
+
+// GeoBounds returns the boxes covering the points matching the given condition of the given
+// geo operator, and true, or false if the operator is not a geo operator, or the area of its
+// condition is unbounded.
+func GeoBounds(op string, condition any) ([]client.GeoBox, bool) {
+	area, err := newGeoArea(op, condition)
+	if err != nil {
+		return nil, false
+	}
+	return area.bounds()
+}
+
+func newGeoArea(op string, condition any) (geoArea, error) {
+	cond, ok := condition.(map[string]any)
+	if !ok {
+		return nil, client.NewErrUnhandledType("condition", condition)
+	}
+
+	switch op {
+	case request.FilterOpNear:
+		point, err := client.NewGeoPointFromValue(cond[request.GeoNearPoint])
+		if err != nil {
+			return nil, err
+		}
+		area := nearArea{point: point, maxDistance: math.Inf(1)}
+		if value, ok := cond[request.GeoNearMinDistance]; ok && value != nil {
+			area.minDistance, err = getDistance(value)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if value, ok := cond[request.GeoNearMaxDistance]; ok && value != nil {
+			area.maxDistance, err = getDistance(value)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return area, nil
+
+	case request.FilterOpWithinRadius:
+		center, err := client.NewGeoPointFromValue(cond[request.GeoRadiusCenter])
+		if err != nil {
+			return nil, err
+		}
+		radius, err := getDistance(cond[request.GeoRadiusRadius])
+		if err != nil {
+			return nil, err
+		}
+		return circleArea{Center: center, Radius: radius}, nil
+
+	case request.FilterOpWithinBox:
+		southWest, err := client.NewGeoPointFromValue(cond[request.GeoBoxSouthWest])
+		if err != nil {
+			return nil, err
+		}
+		northEast, err := client.NewGeoPointFromValue(cond[request.GeoBoxNorthEast])
+		if err != nil {
+			return nil, err
+		}
+		return boxArea{SouthWest: southWest, NorthEast: northEast}, nil
+
+	default:
+		return nil, NewErrUnknownOperator(op)
+	}
+}
+
+func getDistance(value any) (float64, error) {
+	distance, ok := client.GeoCoordinate(value)
+	if !ok || distance < 0 || math.IsNaN(distance) {
+		return 0, client.NewErrUnhandledType("distance", value)
+	}
+	return distance, nil
+}
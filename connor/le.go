@@ -1,6 +1,7 @@
 package connor
 
 import (
+	"math/big"
 	"time"
 
 	"github.com/sourcenetwork/defradb/client"
@@ -29,6 +30,9 @@ func le(condition, data any) (bool, error) {
 		default:
 			return false, client.NewErrUnhandledType("data", d)
 		}
+	case *big.Int, client.Decimal:
+		cmp, ok := numbers.Compare(data, condition)
+		return ok && cmp <= 0, nil
 	default:
 		switch cn := numbers.TryUpcast(condition).(type) {
 		case float64:
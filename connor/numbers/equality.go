@@ -1,6 +1,11 @@
 package numbers
 
 func Equal(condition, data any) bool {
+	if IsExact(condition) || IsExact(data) {
+		c, ok := Compare(condition, data)
+		return ok && c == 0
+	}
+
 	uc := TryUpcast(condition)
 	ud := TryUpcast(data)
 
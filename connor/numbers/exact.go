@@ -0,0 +1,56 @@
+package numbers
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// IsExact returns true if the given value is a number of arbitrary precision.
+func IsExact(n any) bool {
+	switch n.(type) {
+	case *big.Int, big.Int, client.Decimal:
+		return true
+	default:
+		return false
+	}
+}
+
+// Compare returns -1, 0 or +1 if a is lower than, equal to, or greater than b, and false if
+// either value is not a number.
+//
+// Both values are compared exactly, whatever their precision.
+func Compare(a, b any) (int, bool) {
+	ra, ok := toRat(a)
+	if !ok {
+		return 0, false
+	}
+	rb, ok := toRat(b)
+	if !ok {
+		return 0, false
+	}
+	return ra.Cmp(rb), true
+}
+
+func toRat(n any) (*big.Rat, bool) {
+	switch nn := TryUpcast(n).(type) {
+	case int64:
+		return new(big.Rat).SetInt64(nn), true
+	case uint64:
+		return new(big.Rat).SetUint64(nn), true
+	case float64:
+		if math.IsNaN(nn) || math.IsInf(nn, 0) {
+			return nil, false
+		}
+		return new(big.Rat).SetFloat64(nn), true
+	case *big.Int:
+		return new(big.Rat).SetInt(nn), true
+	case big.Int:
+		return new(big.Rat).SetInt(&nn), true
+	case client.Decimal:
+		return nn.Rat(), true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,50 @@
+package connor
+
+import "github.com/sourcenetwork/defradb/client"
+
+// getPath returns the `_path` condition of the given conditions, and true, or false if there is
+// none.
+func getPath(conditions map[FilterKey]any) (any, bool) {
+	for prop, cond := range conditions {
+		if prop.GetOperatorOrDefault("") == "_path" {
+			return cond, true
+		}
+	}
+	return nil, false
+}
+
+// path resolves the value found at the given path condition within the data, and matches the other
+// conditions against it.
+//
+// The `_exists` condition matches whether the path exists within the data.
+func path(condition any, conditions map[FilterKey]any, data any) (bool, error) {
+	items, ok := condition.([]any)
+	if !ok {
+		return false, client.NewErrUnhandledType("condition", condition)
+	}
+	keys := make([]string, len(items))
+	for i, item := range items {
+		keys[i], ok = item.(string)
+		if !ok {
+			return false, client.NewErrUnhandledType("condition", item)
+		}
+	}
+
+	value, exists := client.JSONPathValue(data, keys)
+	for prop, cond := range conditions {
+		var m bool
+		var err error
+		switch op := prop.GetOperatorOrDefault("_eq"); op {
+		case "_path":
+			continue
+		case "_exists":
+			m, err = matchExists(cond, exists)
+		default:
+			m, err = matchWith(op, cond, value)
+		}
+		if err != nil || !m {
+			return false, err
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,47 @@
+package connor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEq_WithPath(t *testing.T) {
+	data := map[string]any{
+		"user": map[string]any{"id": int64(1)},
+	}
+
+	result, err := eq(map[FilterKey]any{
+		&operator{"_path"}: []any{"user", "id"},
+		&operator{"_eq"}:   int64(1),
+	}, data)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	result, err = eq(map[FilterKey]any{
+		&operator{"_path"}: []any{"user", "id"},
+		&operator{"_gt"}:   int64(1),
+	}, data)
+	require.NoError(t, err)
+	require.False(t, result)
+}
+
+func TestEq_WithPathExists(t *testing.T) {
+	data := map[string]any{
+		"user": map[string]any{"id": nil},
+	}
+
+	result, err := eq(map[FilterKey]any{
+		&operator{"_path"}:   []any{"user", "id"},
+		&operator{"_exists"}: true,
+	}, data)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	result, err = eq(map[FilterKey]any{
+		&operator{"_path"}:   []any{"user", "name"},
+		&operator{"_exists"}: true,
+	}, data)
+	require.NoError(t, err)
+	require.False(t, result)
+}
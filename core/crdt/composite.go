@@ -42,8 +42,22 @@ type CompositeDAGDelta struct {
 	// Status represents the status of the document. By default it is `Active`.
 	// Alternatively, if can be set to `Deleted`.
 	Status client.DocumentStatus
+	// Restored is true if the delta undeletes a previously deleted document.
+	//
+	// It is kept apart from Status so that peers unaware of restoration still read the
+	// document as active.
+	Restored bool
+	// Identity is the identity of the caller that made the change, if any.
+	//
+	// The identity that created a document is its owner. Peers only accept it from blocks signed
+	// by a trusted signer, as it is asserted by the node that made the change.
+	Identity string
 
 	FieldName string
+	// Signer is the marshalled public key of the signer of the block, if it is signed.
+	Signer []byte
+	// Signature is the signature of the block, if it is signed.
+	Signature []byte
 }
 
 var _ core.CompositeDelta = (*CompositeDAGDelta)(nil)
@@ -58,6 +72,17 @@ func (delta *CompositeDAGDelta) SetPriority(prio uint64) {
 	delta.Priority = prio
 }
 
+// GetSignature returns the signer and signature of the block containing this delta.
+func (delta *CompositeDAGDelta) GetSignature() ([]byte, []byte) {
+	return delta.Signer, delta.Signature
+}
+
+// SetSignature sets the signer and signature of the block containing this delta.
+func (delta *CompositeDAGDelta) SetSignature(signer []byte, signature []byte) {
+	delta.Signer = signer
+	delta.Signature = signature
+}
+
 // Marshal will serialize this delta to a byte array.
 func (delta *CompositeDAGDelta) Marshal() ([]byte, error) {
 	h := &codec.CborHandle{}
@@ -70,7 +95,22 @@ func (delta *CompositeDAGDelta) Marshal() ([]byte, error) {
 		DocKey          []byte
 		Status          uint8
 		FieldName       string
-	}{delta.SchemaVersionID, delta.Priority, delta.Data, delta.DocKey, delta.Status.UInt8(), delta.FieldName})
+		Restored        bool   `codec:",omitempty"`
+		Identity        string `codec:",omitempty"`
+		Signer          []byte `codec:",omitempty"`
+		Signature       []byte `codec:",omitempty"`
+	}{
+		delta.SchemaVersionID,
+		delta.Priority,
+		delta.Data,
+		delta.DocKey,
+		delta.Status.UInt8(),
+		delta.FieldName,
+		delta.Restored,
+		delta.Identity,
+		delta.Signer,
+		delta.Signature,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -137,6 +177,19 @@ func (c CompositeDAG) Merge(ctx context.Context, delta core.Delta) error {
 		return c.deleteWithPrefix(ctx, c.key.WithValueFlag().WithFieldId(""))
 	}
 
+	// Unlike other updates, a restoration is always causally after the deletion it reverts
+	// and so it may undelete the local representation of the document.
+	if isDagDelta && dagDelta.Restored {
+		err := c.restoreWithPrefix(ctx, c.key.WithDeletedFlag().WithFieldId(""))
+		if err != nil {
+			return err
+		}
+		err = c.store.Put(ctx, c.key.ToPrimaryDataStoreKey().ToDS(), []byte{base.ObjectMarker})
+		if err != nil {
+			return err
+		}
+	}
+
 	// We cannot rely on the dagDelta.Status here as it may have been deleted locally, this is not
 	// reflected in `dagDelta.Status` if sourced via P2P.  Updates synced via P2P should not undelete
 	// the local reperesentation of the document.
@@ -204,6 +257,39 @@ func (c CompositeDAG) deleteWithPrefix(ctx context.Context, key core.DataStoreKe
 	return nil
 }
 
+func (c CompositeDAG) restoreWithPrefix(ctx context.Context, key core.DataStoreKey) error {
+	q := query.Query{
+		Prefix: key.ToString(),
+	}
+	res, err := c.store.Query(ctx, q)
+	if err != nil {
+		return err
+	}
+	for e := range res.Next() {
+		if e.Error != nil {
+			return e.Error
+		}
+		dsKey, err := core.NewDataStoreKey(e.Key)
+		if err != nil {
+			return err
+		}
+
+		if dsKey.InstanceType == core.DeletedKey {
+			err = c.store.Put(ctx, dsKey.WithValueFlag().ToDS(), e.Value)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = c.store.Delete(ctx, dsKey.ToDS())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // DeltaDecode is a typed helper to extract.
 // a CompositeDAGDelta from a ipld.Node
 // for now let's do cbor (quick to implement)
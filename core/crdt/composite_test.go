@@ -0,0 +1,111 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"context"
+	"testing"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/require"
+	"github.com/ugorji/go/codec"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/db/base"
+)
+
+func setupDeletedCompositeDAG(t *testing.T, ctx context.Context) (datastore.DSReaderWriter, CompositeDAG) {
+	store := newMockStore()
+	key := core.DataStoreKey{
+		CollectionID: "1",
+		DocKey:       "bae-123",
+		FieldId:      core.COMPOSITE_NAMESPACE,
+	}
+	composite := NewCompositeDAG(store, core.CollectionSchemaVersionKey{}, key, "")
+
+	err := store.Put(ctx, key.WithValueFlag().WithFieldId("1").ToDS(), []byte("John"))
+	require.NoError(t, err)
+
+	addDelta := composite.Set([]byte{}, nil)
+	addDelta.SetPriority(1)
+	err = composite.Merge(ctx, addDelta)
+	require.NoError(t, err)
+
+	deleteDelta := composite.Set([]byte{}, nil)
+	deleteDelta.SetPriority(2)
+	deleteDelta.Status = client.Deleted
+	err = composite.Merge(ctx, deleteDelta)
+	require.NoError(t, err)
+
+	return store, composite
+}
+
+func TestCompositeDAGMerge_WithRestoredDeltaAfterDelete_RestoresDocument(t *testing.T) {
+	ctx := context.Background()
+	store, composite := setupDeletedCompositeDAG(t, ctx)
+
+	restoreDelta := composite.Set([]byte{}, nil)
+	restoreDelta.SetPriority(3)
+	restoreDelta.Restored = true
+	err := composite.Merge(ctx, restoreDelta)
+	require.NoError(t, err)
+
+	marker, err := store.Get(ctx, composite.key.ToPrimaryDataStoreKey().ToDS())
+	require.NoError(t, err)
+	require.Equal(t, []byte{base.ObjectMarker}, marker)
+
+	value, err := store.Get(ctx, composite.key.WithValueFlag().WithFieldId("1").ToDS())
+	require.NoError(t, err)
+	require.Equal(t, []byte("John"), value)
+
+	_, err = store.Get(ctx, composite.key.WithDeletedFlag().WithFieldId("1").ToDS())
+	require.ErrorIs(t, err, ds.ErrNotFound)
+}
+
+func TestCompositeDAGMerge_WithActiveDeltaAfterDelete_DoesNotRestoreDocument(t *testing.T) {
+	ctx := context.Background()
+	store, composite := setupDeletedCompositeDAG(t, ctx)
+
+	updateDelta := composite.Set([]byte{}, nil)
+	updateDelta.SetPriority(3)
+	err := composite.Merge(ctx, updateDelta)
+	require.NoError(t, err)
+
+	marker, err := store.Get(ctx, composite.key.ToPrimaryDataStoreKey().ToDS())
+	require.NoError(t, err)
+	require.Equal(t, []byte{base.DeletedObjectMarker}, marker)
+
+	_, err = store.Get(ctx, composite.key.WithValueFlag().WithFieldId("1").ToDS())
+	require.ErrorIs(t, err, ds.ErrNotFound)
+}
+
+func TestCompositeDAGDeltaMarshal_WithRestored_IsNotDeletedForOlderPeers(t *testing.T) {
+	delta := &CompositeDAGDelta{Priority: 3, Status: client.Active, Restored: true}
+	buf, err := delta.Marshal()
+	require.NoError(t, err)
+
+	// Peers that are unaware of restoration decode the delta without the Restored field.
+	var legacy struct {
+		Priority uint64
+		Status   uint8
+	}
+	err = codec.NewDecoderBytes(buf, &codec.CborHandle{}).Decode(&legacy)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), legacy.Priority)
+	require.False(t, client.DocumentStatus(legacy.Status).IsDeleted())
+
+	decoded := &CompositeDAGDelta{}
+	err = codec.NewDecoderBytes(buf, &codec.CborHandle{}).Decode(decoded)
+	require.NoError(t, err)
+	require.True(t, decoded.Restored)
+}
@@ -34,6 +34,10 @@ type LWWRegDelta struct {
 	Data            []byte
 	DocKey          []byte
 	FieldName       string
+	// Signer is the marshalled public key of the signer of the block, if it is signed.
+	Signer []byte
+	// Signature is the signature of the block, if it is signed.
+	Signature []byte
 }
 
 var _ core.Delta = (*LWWRegDelta)(nil)
@@ -48,6 +52,17 @@ func (delta *LWWRegDelta) SetPriority(prio uint64) {
 	delta.Priority = prio
 }
 
+// GetSignature returns the signer and signature of the block containing this delta.
+func (delta *LWWRegDelta) GetSignature() ([]byte, []byte) {
+	return delta.Signer, delta.Signature
+}
+
+// SetSignature sets the signer and signature of the block containing this delta.
+func (delta *LWWRegDelta) SetSignature(signer []byte, signature []byte) {
+	delta.Signer = signer
+	delta.Signature = signature
+}
+
 // Marshal encodes the delta using CBOR.
 // for now le'ts do cbor (quick to implement)
 func (delta *LWWRegDelta) Marshal() ([]byte, error) {
@@ -60,7 +75,9 @@ func (delta *LWWRegDelta) Marshal() ([]byte, error) {
 		Data            []byte
 		DocKey          []byte
 		FieldName       string
-	}{delta.SchemaVersionID, delta.Priority, delta.Data, delta.DocKey, delta.FieldName})
+		Signer          []byte `codec:",omitempty"`
+		Signature       []byte `codec:",omitempty"`
+	}{delta.SchemaVersionID, delta.Priority, delta.Data, delta.DocKey, delta.FieldName, delta.Signer, delta.Signature})
 	if err != nil {
 		return nil, err
 	}
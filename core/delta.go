@@ -21,6 +21,12 @@ type Delta interface {
 	SetPriority(uint64)
 	Marshal() ([]byte, error)
 	Value() any
+	// GetSignature returns the marshalled public key of the signer of the block containing
+	// the delta and the signature of the block, if any.
+	GetSignature() (signer []byte, signature []byte)
+	// SetSignature sets the marshalled public key of the signer of the block containing
+	// the delta and the signature of the block.
+	SetSignature(signer []byte, signature []byte)
 }
 
 // CompositeDelta represents a delta-state update to a composite CRDT.
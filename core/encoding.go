@@ -13,6 +13,7 @@ package core
 import (
 	"fmt"
 
+	"github.com/fxamacker/cbor/v2"
 	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/client"
@@ -77,7 +78,8 @@ func DecodeFieldValue(fieldDesc client.FieldDescription, val any) (any, error) {
 				return nil, err
 			}
 
-		case client.FieldKind_STRING_ARRAY:
+		// DateTime and Blob items are stored as their RFC3339 and hex encoded strings.
+		case client.FieldKind_STRING_ARRAY, client.FieldKind_DATETIME_ARRAY, client.FieldKind_BLOB_ARRAY:
 			stringArray := make([]string, len(array))
 			for i, untypedValue := range array {
 				stringArray[i], ok = untypedValue.(string)
@@ -87,11 +89,19 @@ func DecodeFieldValue(fieldDesc client.FieldDescription, val any) (any, error) {
 			}
 			val = stringArray
 
-		case client.FieldKind_NILLABLE_STRING_ARRAY:
+		case client.FieldKind_NILLABLE_STRING_ARRAY, client.FieldKind_NILLABLE_DATETIME_ARRAY,
+			client.FieldKind_NILLABLE_BLOB_ARRAY:
 			val, err = convertNillableArray[string](fieldDesc.Name, array)
 			if err != nil {
 				return nil, err
 			}
+
+		case client.FieldKind_GEOPOINT:
+			// Geo points are stored as the array of their latitude and longitude.
+			val, err = client.NewGeoPointFromValue(array)
+			if err != nil {
+				return nil, err
+			}
 		}
 	} else { // CBOR often encodes values typed as floats as ints
 		switch fieldDesc.Kind {
@@ -106,6 +116,20 @@ func DecodeFieldValue(fieldDesc client.FieldDescription, val any) (any, error) {
 			case uint:
 				return float64(v), nil
 			}
+		case client.FieldKind_JSON:
+			// JSON values are stored in their canonical JSON encoding.
+			if v, ok := val.(string); ok {
+				return client.DecodeJSONValue(v)
+			}
+		case client.FieldKind_DECIMAL:
+			// Decimals are stored as CBOR decimal fractions.
+			if tag, ok := val.(cbor.Tag); ok {
+				return decodeDecimal(fieldDesc.Name, tag)
+			}
+			return client.NewDecimalFromValue(val)
+		case client.FieldKind_BIGINT:
+			// Big integers are stored as CBOR integers, or bignums if they overflow them.
+			return client.NewBigIntFromValue(val)
 		case client.FieldKind_INT:
 			switch v := val.(type) {
 			case float64:
@@ -125,6 +149,23 @@ func DecodeFieldValue(fieldDesc client.FieldDescription, val any) (any, error) {
 	return val, nil
 }
 
+// decodeDecimal returns the decimal held by the given CBOR decimal fraction.
+func decodeDecimal(propertyName string, tag cbor.Tag) (client.Decimal, error) {
+	parts, ok := tag.Content.([]any)
+	if tag.Number != client.CBORTagDecimalFraction || !ok || len(parts) != 2 {
+		return client.Decimal{}, client.NewErrUnexpectedType[client.Decimal](propertyName, tag.Content)
+	}
+	exponent, err := convertToInt(propertyName, parts[0])
+	if err != nil {
+		return client.Decimal{}, err
+	}
+	mantissa, err := client.NewBigIntFromValue(parts[1])
+	if err != nil {
+		return client.Decimal{}, err
+	}
+	return client.NewDecimal(mantissa, exponent), nil
+}
+
 func convertNillableArray[T any](propertyName string, items []any) ([]immutable.Option[T], error) {
 	resultArray := make([]immutable.Option[T], len(items))
 	for i, untypedValue := range items {
@@ -0,0 +1,64 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package core
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+)
+
+// EncryptFieldValue encrypts the given encoded field value with the given AES key.
+//
+// The value is sealed with AES-GCM, the random nonce is prepended to the returned ciphertext.
+// Empty values mark deleted fields and are returned as is.
+func EncryptFieldValue(key []byte, value []byte) ([]byte, error) {
+	if len(value) == 0 {
+		return value, nil
+	}
+	gcm, err := newFieldCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize(), gcm.NonceSize()+len(value)+gcm.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, value, nil), nil
+}
+
+// DecryptFieldValue decrypts the given field value encrypted by [EncryptFieldValue].
+func DecryptFieldValue(key []byte, value []byte) ([]byte, error) {
+	if len(value) == 0 {
+		return value, nil
+	}
+	gcm, err := newFieldCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(value) < gcm.NonceSize() {
+		return nil, ErrInvalidEncryptedValue
+	}
+	nonce, ciphertext := value[:gcm.NonceSize()], value[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, NewErrFailedToDecryptValue(err)
+	}
+	return plaintext, nil
+}
+
+func newFieldCipher(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,44 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptFieldValue_RoundTrip(t *testing.T) {
+	key := []byte("0123456789abcdef")
+	value := []byte("secret")
+
+	ciphertext, err := EncryptFieldValue(key, value)
+	require.NoError(t, err)
+	assert.NotContains(t, string(ciphertext), string(value))
+
+	plaintext, err := DecryptFieldValue(key, ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, value, plaintext)
+}
+
+func TestDecryptFieldValue_WithWrongKey_ReturnsError(t *testing.T) {
+	ciphertext, err := EncryptFieldValue([]byte("0123456789abcdef"), []byte("secret"))
+	require.NoError(t, err)
+
+	_, err = DecryptFieldValue([]byte("fedcba9876543210"), ciphertext)
+	assert.ErrorIs(t, err, ErrFailedToDecryptValue)
+}
+
+func TestDecryptFieldValue_WithTruncatedValue_ReturnsError(t *testing.T) {
+	_, err := DecryptFieldValue([]byte("0123456789abcdef"), []byte("short"))
+	assert.ErrorIs(t, err, ErrInvalidEncryptedValue)
+}
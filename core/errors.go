@@ -16,15 +16,23 @@ import (
 
 const (
 	errFailedToGetFieldIdOfKey string = "failed to get FieldID of Key"
+	errFailedToDecryptValue    string = "failed to decrypt value"
 )
 
 var (
 	ErrFailedToGetFieldIdOfKey = errors.New(errFailedToGetFieldIdOfKey)
 	ErrEmptyKey                = errors.New("received empty key string")
 	ErrInvalidKey              = errors.New("invalid key string")
+	ErrInvalidEncryptedValue   = errors.New("invalid encrypted value")
+	ErrFailedToDecryptValue    = errors.New(errFailedToDecryptValue)
 )
 
 // NewErrFailedToGetFieldIdOfKey returns the error indicating failure to get FieldID of Key.
 func NewErrFailedToGetFieldIdOfKey(inner error) error {
 	return errors.Wrap(errFailedToGetFieldIdOfKey, inner)
 }
+
+// NewErrFailedToDecryptValue returns the error indicating failure to decrypt an encrypted value.
+func NewErrFailedToDecryptValue(inner error) error {
+	return errors.Wrap(errFailedToDecryptValue, inner)
+}
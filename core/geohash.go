@@ -0,0 +1,132 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package core
+
+import (
+	"math"
+	"sort"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// MaxGeoHashPrecision is the number of characters of the most precise geohashes geo points are
+// indexed by, its cells are about 38m wide and 19m high at the equator.
+const MaxGeoHashPrecision = 8
+
+// MaxGeoHashCoverCells is the greatest number of geohash cells covering an area, above which a
+// coarser precision is used.
+const MaxGeoHashCoverCells = 32
+
+const geoHashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// EncodeGeoHash returns the geohash of the given precision of the cell holding the given point.
+func EncodeGeoHash(point client.GeoPoint, precision int) string {
+	latBits, lngBits := geoHashBits(precision)
+	return encodeGeoHashCell(
+		geoHashCellIndex(point.Latitude, -90, 180, latBits),
+		geoHashCellIndex(point.Longitude, -180, 360, lngBits),
+		precision,
+	)
+}
+
+// GeoHashCover returns the geohashes of the cells covering the given boxes, which must not cross
+// the antimeridian.
+//
+// The cells are of the finest precision for which there are at most [MaxGeoHashCoverCells]
+// cells. As all cells have the same precision, none of them holds another one.
+func GeoHashCover(boxes []client.GeoBox) []string {
+	precision := 1
+	for p := MaxGeoHashPrecision; p > 1; p-- {
+		if countGeoHashCells(boxes, p) <= MaxGeoHashCoverCells {
+			precision = p
+			break
+		}
+	}
+
+	latBits, lngBits := geoHashBits(precision)
+	cells := map[string]struct{}{}
+	for _, box := range boxes {
+		minLat, maxLat, minLng, maxLng := geoHashCellRange(box, latBits, lngBits)
+		for lat := minLat; lat <= maxLat; lat++ {
+			for lng := minLng; lng <= maxLng; lng++ {
+				cells[encodeGeoHashCell(lat, lng, precision)] = struct{}{}
+			}
+		}
+	}
+
+	hashes := make([]string, 0, len(cells))
+	for hash := range cells {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+	return hashes
+}
+
+func countGeoHashCells(boxes []client.GeoBox, precision int) int64 {
+	latBits, lngBits := geoHashBits(precision)
+	var count int64
+	for _, box := range boxes {
+		minLat, maxLat, minLng, maxLng := geoHashCellRange(box, latBits, lngBits)
+		count += (maxLat - minLat + 1) * (maxLng - minLng + 1)
+	}
+	return count
+}
+
+// geoHashCellRange returns the range of the latitude and longitude indexes of the cells
+// covering the given box.
+func geoHashCellRange(box client.GeoBox, latBits, lngBits int) (int64, int64, int64, int64) {
+	return geoHashCellIndex(box.SouthWest.Latitude, -90, 180, latBits),
+		geoHashCellIndex(box.NorthEast.Latitude, -90, 180, latBits),
+		geoHashCellIndex(box.SouthWest.Longitude, -180, 360, lngBits),
+		geoHashCellIndex(box.NorthEast.Longitude, -180, 360, lngBits)
+}
+
+// geoHashBits returns the number of bits of the latitude and of the longitude of the geohashes
+// of the given precision, bits alternate starting with the longitude.
+func geoHashBits(precision int) (int, int) {
+	bits := 5 * precision
+	return bits / 2, (bits + 1) / 2
+}
+
+// geoHashCellIndex returns the index of the cell holding the given coordinate, among the 2^bits
+// cells of the given range.
+func geoHashCellIndex(coordinate float64, min float64, size float64, bits int) int64 {
+	cells := int64(1) << bits
+	index := int64(math.Floor((coordinate - min) / size * float64(cells)))
+	if index < 0 {
+		return 0
+	}
+	if index >= cells {
+		return cells - 1
+	}
+	return index
+}
+
+func encodeGeoHashCell(latIndex int64, lngIndex int64, precision int) string {
+	latBits, lngBits := geoHashBits(precision)
+	hash := make([]byte, precision)
+	for i := range hash {
+		var char int64
+		for bit := 0; bit < 5; bit++ {
+			char <<= 1
+			// bits alternate between the longitude and the latitude, from the most significant
+			if (5*i+bit)%2 == 0 {
+				lngBits--
+				char |= (lngIndex >> lngBits) & 1
+			} else {
+				latBits--
+				char |= (latIndex >> latBits) & 1
+			}
+		}
+		hash[i] = geoHashAlphabet[char]
+	}
+	return string(hash)
+}
@@ -0,0 +1,54 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func TestEncodeGeoHash(t *testing.T) {
+	point := client.GeoPoint{Latitude: 57.64911, Longitude: 10.40744}
+
+	assert.Equal(t, "u", EncodeGeoHash(point, 1))
+	assert.Equal(t, "u4pru", EncodeGeoHash(point, 5))
+	assert.Equal(t, "u4pruydq", EncodeGeoHash(point, 8))
+	assert.Equal(t, "7zzzzzzz", EncodeGeoHash(client.GeoPoint{Latitude: -0.0000001, Longitude: -0.0000001}, 8))
+	assert.Equal(t, "zzzzzzzz", EncodeGeoHash(client.GeoPoint{Latitude: 90, Longitude: 180}, 8))
+}
+
+func TestGeoHashCover_HoldsThePointsOfTheBox(t *testing.T) {
+	center := client.GeoPoint{Latitude: 48.8566, Longitude: 2.3522}
+	box := client.GeoCircle{Center: center, Radius: 5000}.Bounds()
+
+	cover := GeoHashCover([]client.GeoBox{box})
+	require.NotEmpty(t, cover)
+	require.LessOrEqual(t, len(cover), MaxGeoHashCoverCells)
+
+	precision := len(cover[0])
+	for _, point := range []client.GeoPoint{center, box.SouthWest, box.NorthEast} {
+		assert.Contains(t, cover, EncodeGeoHash(point, precision))
+	}
+}
+
+func TestGeoHashCover_WithWholeWorld_ReturnsEveryCellOfTheCoarsestPrecision(t *testing.T) {
+	box := client.GeoBox{
+		SouthWest: client.GeoPoint{Latitude: -90, Longitude: -180},
+		NorthEast: client.GeoPoint{Latitude: 90, Longitude: 180},
+	}
+
+	cover := GeoHashCover([]client.GeoBox{box})
+	assert.Len(t, cover, 32)
+}
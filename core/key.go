@@ -54,6 +54,18 @@ const (
 	DATASTORE_DOC_VERSION_FIELD_ID = "v"
 	REPLICATOR                     = "/replicator/id"
 	P2P_COLLECTION                 = "/p2p/collection"
+	CHANGELOG                      = "/changelog/s"
+	CHANGELOG_PENDING              = "/changelog/p"
+	DOC_VERSION                    = "/docversion"
+	DOC_VERSION_BACKFILLED         = "/docversionbackfilled"
+	DOC_VERSION_TIME               = "/docversiontime"
+	DOC_VERSION_TIME_BACKFILLED    = "/docversiontimebackfilled"
+	WEBHOOK                        = "/webhook/id"
+	WEBHOOK_DEAD_LETTER            = "/webhook/deadletter"
+	POLICY                         = "/policy"
+	DOC_OWNER                      = "/docowner"
+	UPSERT                         = "/upsert"
+	COLLECTION_ENCRYPTION_KEY      = "/collection/encryptionkey"
 )
 
 // Key is an interface that represents a key in the database.
@@ -188,6 +200,102 @@ type ReplicatorKey struct {
 
 var _ Key = (*ReplicatorKey)(nil)
 
+// WebhookKey points to the json serialized [client.Webhook] with the given ID.
+type WebhookKey struct {
+	WebhookID string
+}
+
+var _ Key = (*WebhookKey)(nil)
+
+// WebhookDeadLetterKey points to the json serialized [client.WebhookDeadLetter] with the given ID.
+type WebhookDeadLetterKey struct {
+	DeadLetterID string
+}
+
+var _ Key = (*WebhookDeadLetterKey)(nil)
+
+// DocOwnerKey points to the identity of the owner of the document with the given key.
+//
+// It only exists for documents that were created by an identified caller.
+type DocOwnerKey struct {
+	CollectionID uint32
+	DocKey       string
+}
+
+var _ Key = (*DocOwnerKey)(nil)
+
+// UpsertKey is written by the upserts of the given collection that create a document, so that
+// concurrent upserts with the same filter conflict instead of both creating a document.
+//
+// The FilterHash is the hex encoded sha256 hash of the json serialized filter conditions.
+type UpsertKey struct {
+	CollectionID uint32
+	FilterHash   string
+}
+
+var _ Key = (*UpsertKey)(nil)
+
+// CollectionEncryptionKey points to the key used to encrypt the values of the encrypted
+// fields of the collection with the given name.
+type CollectionEncryptionKey struct {
+	CollectionName string
+}
+
+var _ Key = (*CollectionEncryptionKey)(nil)
+
+// PolicyKey points to the json serialized [client.Policy].
+type PolicyKey struct{}
+
+var _ Key = (*PolicyKey)(nil)
+
+// ChangeLogKey points to the json serialized [client.Change] that was assigned the
+// given sequence number within the change log of the given collection.
+type ChangeLogKey struct {
+	CollectionID uint32
+	Seq          uint64
+}
+
+var _ Key = (*ChangeLogKey)(nil)
+
+// PendingChangeKey points to the json serialized [client.Change] that has been
+// committed but not yet been assigned a sequence number.
+//
+// The ID is unique to the change and sorts roughly by the time of the write.
+type PendingChangeKey struct {
+	CollectionID uint32
+	ID           string
+}
+
+var _ Key = (*PendingChangeKey)(nil)
+
+// DocVersionKey marks the composite block with the given CID as having been merged
+// into the document with the given key at the given time (in unix nanoseconds).
+//
+// It allows the versions of a document to be looked up by the time at which they were
+// recorded by the local node.
+type DocVersionKey struct {
+	CollectionID uint32
+	DocKey       string
+	Time         int64
+	Cid          string
+}
+
+var _ Key = (*DocVersionKey)(nil)
+
+// DocVersionTimeKey indexes the versions recorded by [DocVersionKey] by time rather
+// than by document.
+//
+// It allows the documents of a collection that changed since a given time to be found
+// without reading the versions of every document.
+type DocVersionTimeKey struct {
+	CollectionID uint32
+	Time         int64
+	DocKey       string
+	Cid          string
+}
+
+var _ Key = (*DocVersionTimeKey)(nil)
+
 // Creates a new DataStoreKey from a string as best as it can,
 // splitting the input using '/' as a field deliminator.  It assumes
 // that the input string is in the following format:
@@ -783,6 +891,342 @@ func (k ReplicatorKey) ToDS() ds.Key {
 	return ds.NewKey(k.ToString())
 }
 
+func NewWebhookKey(id string) WebhookKey {
+	return WebhookKey{WebhookID: id}
+}
+
+func (k WebhookKey) ToString() string {
+	result := WEBHOOK
+
+	if k.WebhookID != "" {
+		result = result + "/" + k.WebhookID
+	}
+
+	return result
+}
+
+func (k WebhookKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k WebhookKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+func NewWebhookDeadLetterKey(id string) WebhookDeadLetterKey {
+	return WebhookDeadLetterKey{DeadLetterID: id}
+}
+
+func (k WebhookDeadLetterKey) ToString() string {
+	result := WEBHOOK_DEAD_LETTER
+
+	if k.DeadLetterID != "" {
+		result = result + "/" + k.DeadLetterID
+	}
+
+	return result
+}
+
+func (k WebhookDeadLetterKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k WebhookDeadLetterKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+func NewDocOwnerKey(collectionID uint32, docKey string) DocOwnerKey {
+	return DocOwnerKey{CollectionID: collectionID, DocKey: docKey}
+}
+
+func (k DocOwnerKey) ToString() string {
+	result := DOC_OWNER
+
+	if k.CollectionID != 0 {
+		result = fmt.Sprintf("%s/%d", result, k.CollectionID)
+	}
+	if k.DocKey != "" {
+		result = result + "/" + k.DocKey
+	}
+
+	return result
+}
+
+func (k DocOwnerKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k DocOwnerKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+func NewUpsertKey(collectionID uint32, filterHash string) UpsertKey {
+	return UpsertKey{CollectionID: collectionID, FilterHash: filterHash}
+}
+
+func (k UpsertKey) ToString() string {
+	result := UPSERT
+
+	if k.CollectionID != 0 {
+		result = fmt.Sprintf("%s/%d", result, k.CollectionID)
+	}
+	if k.FilterHash != "" {
+		result = result + "/" + k.FilterHash
+	}
+
+	return result
+}
+
+func (k UpsertKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k UpsertKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+func NewCollectionEncryptionKey(collectionName string) CollectionEncryptionKey {
+	return CollectionEncryptionKey{CollectionName: collectionName}
+}
+
+func (k CollectionEncryptionKey) ToString() string {
+	result := COLLECTION_ENCRYPTION_KEY
+
+	if k.CollectionName != "" {
+		result = result + "/" + k.CollectionName
+	}
+
+	return result
+}
+
+func (k CollectionEncryptionKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k CollectionEncryptionKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+func NewPolicyKey() PolicyKey {
+	return PolicyKey{}
+}
+
+func (k PolicyKey) ToString() string {
+	return POLICY
+}
+
+func (k PolicyKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k PolicyKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+func NewChangeLogKey(collectionID uint32, seq uint64) ChangeLogKey {
+	return ChangeLogKey{CollectionID: collectionID, Seq: seq}
+}
+
+// NewChangeLogKeyFromString creates a new ChangeLogKey from a string.
+//
+// It expects the input string to be in the following format:
+//
+// /changelog/s/[CollectionID]/[Seq]
+func NewChangeLogKeyFromString(key string) (ChangeLogKey, error) {
+	keyArr := strings.Split(key, "/")
+	if len(keyArr) != 5 {
+		return ChangeLogKey{}, errors.WithStack(ErrInvalidKey, errors.NewKV("Key", key))
+	}
+	colID, err := strconv.ParseUint(keyArr[3], 10, 32)
+	if err != nil {
+		return ChangeLogKey{}, errors.WithStack(ErrInvalidKey, errors.NewKV("Key", key))
+	}
+	seq, err := strconv.ParseUint(keyArr[4], 10, 64)
+	if err != nil {
+		return ChangeLogKey{}, errors.WithStack(ErrInvalidKey, errors.NewKV("Key", key))
+	}
+	return NewChangeLogKey(uint32(colID), seq), nil
+}
+
+// ToString returns the string representation of the key.
+//
+// The sequence number is zero padded so that the keys of a collection
+// sort in sequence order.
+func (k ChangeLogKey) ToString() string {
+	result := CHANGELOG
+
+	if k.CollectionID != 0 {
+		result = fmt.Sprintf("%s/%d", result, k.CollectionID)
+	}
+	if k.Seq != 0 {
+		result = fmt.Sprintf("%s/%020d", result, k.Seq)
+	}
+
+	return result
+}
+
+func (k ChangeLogKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k ChangeLogKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+func NewPendingChangeKey(collectionID uint32, id string) PendingChangeKey {
+	return PendingChangeKey{CollectionID: collectionID, ID: id}
+}
+
+// NewPendingChangeKeyFromString creates a new PendingChangeKey from a string.
+//
+// It expects the input string to be in the following format:
+//
+// /changelog/p/[CollectionID]/[ID]
+func NewPendingChangeKeyFromString(key string) (PendingChangeKey, error) {
+	keyArr := strings.Split(key, "/")
+	if len(keyArr) != 5 {
+		return PendingChangeKey{}, errors.WithStack(ErrInvalidKey, errors.NewKV("Key", key))
+	}
+	colID, err := strconv.ParseUint(keyArr[3], 10, 32)
+	if err != nil {
+		return PendingChangeKey{}, errors.WithStack(ErrInvalidKey, errors.NewKV("Key", key))
+	}
+	return NewPendingChangeKey(uint32(colID), keyArr[4]), nil
+}
+
+func (k PendingChangeKey) ToString() string {
+	result := CHANGELOG_PENDING
+
+	if k.CollectionID != 0 {
+		result = fmt.Sprintf("%s/%d", result, k.CollectionID)
+	}
+	if k.ID != "" {
+		result = result + "/" + k.ID
+	}
+
+	return result
+}
+
+func (k PendingChangeKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k PendingChangeKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+func NewDocVersionKey(collectionID uint32, docKey string, time int64, cid string) DocVersionKey {
+	return DocVersionKey{CollectionID: collectionID, DocKey: docKey, Time: time, Cid: cid}
+}
+
+// NewDocVersionKeyFromString creates a new DocVersionKey from a string.
+//
+// It expects the input string to be in the following format:
+//
+// /docversion/[CollectionID]/[DocKey]/[Time]/[Cid]
+func NewDocVersionKeyFromString(key string) (DocVersionKey, error) {
+	keyArr := strings.Split(key, "/")
+	if len(keyArr) != 6 {
+		return DocVersionKey{}, errors.WithStack(ErrInvalidKey, errors.NewKV("Key", key))
+	}
+	colID, err := strconv.ParseUint(keyArr[2], 10, 32)
+	if err != nil {
+		return DocVersionKey{}, errors.WithStack(ErrInvalidKey, errors.NewKV("Key", key))
+	}
+	t, err := strconv.ParseInt(keyArr[4], 10, 64)
+	if err != nil {
+		return DocVersionKey{}, errors.WithStack(ErrInvalidKey, errors.NewKV("Key", key))
+	}
+	return NewDocVersionKey(uint32(colID), keyArr[3], t, keyArr[5]), nil
+}
+
+// ToString returns the string representation of the key.
+//
+// The time is zero padded so that the versions of a document sort in time order.
+func (k DocVersionKey) ToString() string {
+	result := DOC_VERSION
+
+	if k.CollectionID != 0 {
+		result = fmt.Sprintf("%s/%d", result, k.CollectionID)
+	}
+	if k.DocKey != "" {
+		result = result + "/" + k.DocKey
+	}
+	// Versions that predate the index are recorded at time zero, so the time must
+	// be written whenever the key targets a specific version.
+	if k.Time != 0 || k.Cid != "" {
+		result = fmt.Sprintf("%s/%020d", result, k.Time)
+	}
+	if k.Cid != "" {
+		result = result + "/" + k.Cid
+	}
+
+	return result
+}
+
+func (k DocVersionKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k DocVersionKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+func NewDocVersionTimeKey(collectionID uint32, time int64, docKey string, cid string) DocVersionTimeKey {
+	return DocVersionTimeKey{CollectionID: collectionID, Time: time, DocKey: docKey, Cid: cid}
+}
+
+// NewDocVersionTimeKeyFromString creates a new DocVersionTimeKey from a string.
+//
+// It expects the input string to be in the following format:
+//
+// /docversiontime/[CollectionID]/[Time]/[DocKey]/[Cid]
+func NewDocVersionTimeKeyFromString(key string) (DocVersionTimeKey, error) {
+	keyArr := strings.Split(key, "/")
+	if len(keyArr) != 6 {
+		return DocVersionTimeKey{}, errors.WithStack(ErrInvalidKey, errors.NewKV("Key", key))
+	}
+	colID, err := strconv.ParseUint(keyArr[2], 10, 32)
+	if err != nil {
+		return DocVersionTimeKey{}, errors.WithStack(ErrInvalidKey, errors.NewKV("Key", key))
+	}
+	t, err := strconv.ParseInt(keyArr[3], 10, 64)
+	if err != nil {
+		return DocVersionTimeKey{}, errors.WithStack(ErrInvalidKey, errors.NewKV("Key", key))
+	}
+	return NewDocVersionTimeKey(uint32(colID), t, keyArr[4], keyArr[5]), nil
+}
+
+// ToString returns the string representation of the key.
+//
+// The time is zero padded so that the versions of a collection sort in time order.
+func (k DocVersionTimeKey) ToString() string {
+	result := DOC_VERSION_TIME
+
+	if k.CollectionID != 0 {
+		result = fmt.Sprintf("%s/%d", result, k.CollectionID)
+	}
+	if k.Time != 0 || k.DocKey != "" {
+		result = fmt.Sprintf("%s/%020d", result, k.Time)
+	}
+	if k.DocKey != "" {
+		result = result + "/" + k.DocKey
+	}
+	if k.Cid != "" {
+		result = result + "/" + k.Cid
+	}
+
+	return result
+}
+
+func (k DocVersionTimeKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k DocVersionTimeKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
 func (k HeadStoreKey) ToString() string {
 	var result string
 
@@ -81,6 +81,10 @@ type Options struct {
 	badger.Options
 }
 
+// encryptedIndexCacheSize is the size of the index cache used when encryption is enabled and no
+// index cache size is set, badger requires one to hold the decrypted table indexes.
+const encryptedIndexCacheSize = 100 << 20
+
 // DefaultOptions are the default options for the badger datastore.
 var DefaultOptions Options
 
@@ -149,6 +153,9 @@ func NewDatastore(path string, options *Options) (*Datastore, error) {
 
 	opt.Dir = path
 	opt.ValueDir = path
+	if len(opt.EncryptionKey) > 0 && opt.IndexCacheSize <= 0 {
+		opt.IndexCacheSize = encryptedIndexCacheSize
+	}
 	opt.Logger = &compatLogger{
 		SugaredLogger: *log.Desugar().WithOptions(zap.AddCallerSkip(1)).Sugar(),
 		skipLogger:    *log.Desugar().WithOptions(zap.AddCallerSkip(2)).Sugar(),
@@ -653,8 +660,14 @@ func (t *txn) query(q dsq.Query) (dsq.Results, error) {
 
 		defer it.Close()
 
-		// All iterators must be started by rewinding.
-		it.Rewind()
+		if opt.Reverse && len(opt.Prefix) > 0 {
+			// Rewinding a reverse iterator seeks before the keys with the prefix, so it
+			// must instead be started after the last key that may have the prefix.
+			it.Seek(append(append([]byte{}, opt.Prefix...), 0xff))
+		} else {
+			// All iterators must be started by rewinding.
+			it.Rewind()
+		}
 
 		// skip to the offset
 		for skipped := 0; skipped < q.Offset && it.Valid(); it.Next() {
@@ -422,6 +422,32 @@ func TestQueryOperation(t *testing.T) {
 	require.Equal(t, testValue2, result.Entry.Value)
 }
 
+func TestQueryOperation_WithPrefixAndDescendingOrder(t *testing.T) {
+	ctx := context.Background()
+	s := newLoadedDatastore(ctx, t)
+	defer func() {
+		err := s.Close()
+		require.NoError(t, err)
+	}()
+
+	err := s.Put(ctx, ds.NewKey("prefix/testKey3"), testValue3)
+	require.NoError(t, err)
+	err = s.Put(ctx, ds.NewKey("prefix/testKey4"), testValue4)
+	require.NoError(t, err)
+
+	results, err := s.Query(ctx, dsq.Query{
+		Prefix: "prefix",
+		Orders: []dsq.Order{dsq.OrderByKeyDescending{}},
+	})
+	require.NoError(t, err)
+	entries, err := results.Rest()
+	require.NoError(t, err)
+
+	require.Len(t, entries, 2)
+	require.Equal(t, "/prefix/testKey4", entries[0].Key)
+	require.Equal(t, "/prefix/testKey3", entries[1].Key)
+}
+
 func TestQueryOperationWithStoreClosed(t *testing.T) {
 	ctx := context.Background()
 	s := newLoadedDatastore(ctx, t)
@@ -0,0 +1,55 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package badger
+
+import (
+	badger "github.com/sourcenetwork/badger/v4"
+
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+// RotateEncryptionKey rotates the key of the encrypted badger store at the given path so that it
+// can only be opened with newKey instead of oldKey.
+//
+// Badger encrypts data with data keys which are themselves encrypted with the key given when
+// opening the store, only the data keys are re-encrypted to rotate that key. The data keys
+// are rotated by badger on its own as per the EncryptionKeyRotationDuration option.
+//
+// An unencrypted store can not be given a key this way, as its tables and value log would remain
+// unencrypted on disk, and ErrEncryptUnencryptedStore is returned if oldKey is empty while newKey
+// is not. An empty newKey stores the data keys unencrypted, the data remains encrypted with them.
+//
+// The store must not be open while its key is being rotated.
+func RotateEncryptionKey(path string, options *Options, oldKey []byte, newKey []byte) error {
+	if len(oldKey) == 0 && len(newKey) != 0 {
+		return ErrEncryptUnencryptedStore
+	}
+	if options == nil {
+		options = &DefaultOptions
+	}
+	opt := badger.KeyRegistryOptions{
+		Dir:                           path,
+		ReadOnly:                      true,
+		EncryptionKey:                 oldKey,
+		EncryptionKeyRotationDuration: options.EncryptionKeyRotationDuration,
+	}
+	registry, err := badger.OpenKeyRegistry(opt)
+	if err != nil {
+		return errors.Wrap("failed to open badger key registry", err)
+	}
+	defer registry.Close() //nolint:errcheck
+
+	opt.EncryptionKey = newKey
+	if err := badger.WriteKeyRegistry(registry, opt); err != nil {
+		return errors.Wrap("failed to write badger key registry", err)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package badger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sourcenetwork/badger/v4"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testEncryptionKey1 = []byte("0123456789abcdef0123456789abcdef")
+	testEncryptionKey2 = []byte("fedcba9876543210fedcba9876543210")
+)
+
+func newEncryptedDatastore(dir string, key []byte) (*Datastore, error) {
+	opt := DefaultOptions
+	opt.Options = opt.Options.WithEncryptionKey(key)
+	return NewDatastore(dir, &opt)
+}
+
+func TestNewDatastoreWithEncryptionKey(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	s, err := newEncryptedDatastore(dir, testEncryptionKey1)
+	require.NoError(t, err)
+	err = s.Put(ctx, testKey1, testValue1)
+	require.NoError(t, err)
+	err = s.Close()
+	require.NoError(t, err)
+
+	_, err = newEncryptedDatastore(dir, testEncryptionKey2)
+	require.ErrorIs(t, err, badger.ErrEncryptionKeyMismatch)
+
+	s, err = newEncryptedDatastore(dir, testEncryptionKey1)
+	require.NoError(t, err)
+	defer func() {
+		err := s.Close()
+		require.NoError(t, err)
+	}()
+
+	resp, err := s.Get(ctx, testKey1)
+	require.NoError(t, err)
+	require.Equal(t, testValue1, resp)
+}
+
+func TestRotateEncryptionKey(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	s, err := newEncryptedDatastore(dir, testEncryptionKey1)
+	require.NoError(t, err)
+	err = s.Put(ctx, testKey1, testValue1)
+	require.NoError(t, err)
+	err = s.Close()
+	require.NoError(t, err)
+
+	err = RotateEncryptionKey(dir, nil, testEncryptionKey1, testEncryptionKey2)
+	require.NoError(t, err)
+
+	_, err = newEncryptedDatastore(dir, testEncryptionKey1)
+	require.ErrorIs(t, err, badger.ErrEncryptionKeyMismatch)
+
+	s, err = newEncryptedDatastore(dir, testEncryptionKey2)
+	require.NoError(t, err)
+	defer func() {
+		err := s.Close()
+		require.NoError(t, err)
+	}()
+
+	resp, err := s.Get(ctx, testKey1)
+	require.NoError(t, err)
+	require.Equal(t, testValue1, resp)
+}
+
+func TestRotateEncryptionKeyOfUnencryptedDatastore(t *testing.T) {
+	ctx := context.Background()
+	s := newLoadedDatastore(ctx, t)
+	dir := s.DB.Opts().Dir
+	err := s.Close()
+	require.NoError(t, err)
+
+	err = RotateEncryptionKey(dir, nil, nil, testEncryptionKey1)
+	require.ErrorIs(t, err, ErrEncryptUnencryptedStore)
+
+	s, err = NewDatastore(dir, &DefaultOptions)
+	require.NoError(t, err)
+	defer func() {
+		err := s.Close()
+		require.NoError(t, err)
+	}()
+
+	resp, err := s.Get(ctx, testKey1)
+	require.NoError(t, err)
+	require.Equal(t, testValue1, resp)
+}
+
+func TestRotateEncryptionKeyWithWrongKey(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	s, err := newEncryptedDatastore(dir, testEncryptionKey1)
+	require.NoError(t, err)
+	err = s.Put(ctx, testKey1, testValue1)
+	require.NoError(t, err)
+	err = s.Close()
+	require.NoError(t, err)
+
+	err = RotateEncryptionKey(dir, nil, testEncryptionKey2, testEncryptionKey1)
+	require.ErrorIs(t, err, badger.ErrEncryptionKeyMismatch)
+}
@@ -16,7 +16,14 @@ import (
 	"github.com/sourcenetwork/defradb/errors"
 )
 
-const errOrderType string = "invalid order type"
+const (
+	errOrderType               string = "invalid order type"
+	errEncryptUnencryptedStore string = "an unencrypted store can not be encrypted by rotating its key"
+)
+
+// ErrEncryptUnencryptedStore is returned when a key is given to a store that is not encrypted yet,
+// as doing so would leave the data that was already written unencrypted on disk.
+var ErrEncryptUnencryptedStore = errors.New(errEncryptUnencryptedStore)
 
 func ErrOrderType(orderType dsq.Order) error {
 	return errors.New(errOrderType, errors.NewKV("Order type", orderType))
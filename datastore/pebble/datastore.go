@@ -0,0 +1,385 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package pebble
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+
+	"github.com/sourcenetwork/defradb/datastore/iterable"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+// Datastore is a persistent datastore backed by a Pebble LSM tree.
+//
+// Pebble has no native transactions, so optimistic transactions are implemented
+// on top of its snapshots: reads are served from a snapshot taken when the transaction
+// starts, writes are buffered until commit, and a transaction fails to commit with
+// ErrTxnConflict if any key it read was written by another transaction committed since
+// it started.
+type Datastore struct {
+	DB *pebble.DB
+
+	closeLk sync.RWMutex
+	closed  bool
+
+	// commitLk guards the fields below and serializes commits, so that conflict
+	// detection and the application of the write set happen atomically.
+	commitLk sync.Mutex
+	// version is incremented by every commit.
+	version uint64
+	// committed holds the write sets of the transactions committed while at least one
+	// read-write transaction was in flight, ordered by version.
+	committed []committedTxn
+	// inFlight counts the in flight read-write transactions by the version they started at.
+	inFlight map[uint64]int
+
+	writeOptions *pebble.WriteOptions
+}
+
+type committedTxn struct {
+	version uint64
+	keys    map[string]struct{}
+}
+
+// Options are the pebble datastore options, reexported here for convenience.
+type Options struct {
+	// Whether commits should wait for the write ahead log to be synced to disk.
+	SyncWrites bool
+
+	pebble.Options
+}
+
+// DefaultOptions are the default options for the pebble datastore.
+var DefaultOptions = Options{
+	SyncWrites: false,
+}
+
+var _ ds.Datastore = (*Datastore)(nil)
+var _ ds.TxnDatastore = (*Datastore)(nil)
+var _ ds.Batching = (*Datastore)(nil)
+var _ iterable.IterableTxnDatastore = (*Datastore)(nil)
+
+// NewDatastore creates a new pebble datastore at the given path.
+func NewDatastore(path string, options *Options) (*Datastore, error) {
+	if options == nil {
+		options = &DefaultOptions
+	}
+	// Copy the options because pebble modifies them.
+	opt := options.Options
+
+	db, err := pebble.Open(path, &opt)
+	if err != nil {
+		return nil, err
+	}
+
+	writeOptions := pebble.NoSync
+	if options.SyncWrites {
+		writeOptions = pebble.Sync
+	}
+
+	return &Datastore{
+		DB:           db,
+		inFlight:     make(map[uint64]int),
+		writeOptions: writeOptions,
+	}, nil
+}
+
+// NewTransaction starts a new transaction. The resulting transaction object
+// can be mutated without incurring changes to the underlying Datastore until
+// the transaction is Committed.
+func (d *Datastore) NewTransaction(ctx context.Context, readOnly bool) (ds.Txn, error) {
+	d.closeLk.RLock()
+	defer d.closeLk.RUnlock()
+	if d.closed {
+		return nil, ErrClosed
+	}
+
+	return d.newTransaction(readOnly), nil
+}
+
+func (d *Datastore) NewIterableTransaction(
+	ctx context.Context,
+	readOnly bool,
+) (iterable.IterableTxn, error) {
+	d.closeLk.RLock()
+	defer d.closeLk.RUnlock()
+	if d.closed {
+		return nil, ErrClosed
+	}
+
+	return d.newTransaction(readOnly), nil
+}
+
+// newTransaction creates a transaction reading from a snapshot of the current version.
+//
+// The closeLk must be held by the caller.
+func (d *Datastore) newTransaction(readOnly bool) *txn {
+	d.commitLk.Lock()
+	defer d.commitLk.Unlock()
+
+	t := &txn{
+		ds:       d,
+		snapshot: d.DB.NewSnapshot(),
+		version:  d.version,
+		readOnly: readOnly,
+	}
+	if !readOnly {
+		t.reads = make(map[string]struct{})
+		t.writes = make(map[string]pendingWrite)
+		d.inFlight[t.version]++
+	}
+	return t
+}
+
+func (d *Datastore) Put(ctx context.Context, key ds.Key, value []byte) error {
+	d.closeLk.RLock()
+	defer d.closeLk.RUnlock()
+	if d.closed {
+		return ErrClosed
+	}
+
+	t := d.newTransaction(false)
+	defer t.discard()
+
+	if err := t.put(key, value); err != nil {
+		return err
+	}
+	return t.commit()
+}
+
+func (d *Datastore) Sync(ctx context.Context, prefix ds.Key) error {
+	d.closeLk.RLock()
+	defer d.closeLk.RUnlock()
+	if d.closed {
+		return ErrClosed
+	}
+
+	// Pebble syncs its whole write ahead log, the prefix is ignored.
+	return d.DB.LogData(nil, pebble.Sync)
+}
+
+func (d *Datastore) Get(ctx context.Context, key ds.Key) ([]byte, error) {
+	d.closeLk.RLock()
+	defer d.closeLk.RUnlock()
+	if d.closed {
+		return nil, ErrClosed
+	}
+
+	return get(d.DB, key)
+}
+
+func (d *Datastore) Has(ctx context.Context, key ds.Key) (bool, error) {
+	d.closeLk.RLock()
+	defer d.closeLk.RUnlock()
+	if d.closed {
+		return false, ErrClosed
+	}
+
+	return has(d.DB, key)
+}
+
+func (d *Datastore) GetSize(ctx context.Context, key ds.Key) (int, error) {
+	d.closeLk.RLock()
+	defer d.closeLk.RUnlock()
+	if d.closed {
+		return -1, ErrClosed
+	}
+
+	value, err := get(d.DB, key)
+	if err != nil {
+		return -1, err
+	}
+	return len(value), nil
+}
+
+func (d *Datastore) Delete(ctx context.Context, key ds.Key) error {
+	d.closeLk.RLock()
+	defer d.closeLk.RUnlock()
+	if d.closed {
+		return ErrClosed
+	}
+
+	t := d.newTransaction(false)
+	defer t.discard()
+
+	if err := t.delete(key); err != nil {
+		return err
+	}
+	return t.commit()
+}
+
+func (d *Datastore) Query(ctx context.Context, q dsq.Query) (dsq.Results, error) {
+	d.closeLk.RLock()
+	defer d.closeLk.RUnlock()
+	if d.closed {
+		return nil, ErrClosed
+	}
+
+	// The snapshot must outlive this call as the results are streamed from it, it is
+	// released once the results are closed.
+	t := d.newTransaction(true)
+	results, err := t.query(q, func() {
+		d.closeLk.RLock()
+		defer d.closeLk.RUnlock()
+		if !d.closed {
+			t.discard()
+		}
+	})
+	if err != nil {
+		t.discard()
+		return nil, err
+	}
+	return results, nil
+}
+
+// DiskUsage returns the approximate size of the datastore on disk.
+func (d *Datastore) DiskUsage(ctx context.Context) (uint64, error) {
+	d.closeLk.RLock()
+	defer d.closeLk.RUnlock()
+	if d.closed {
+		return 0, ErrClosed
+	}
+
+	return d.DB.Metrics().DiskSpaceUsage(), nil
+}
+
+func (d *Datastore) Close() error {
+	d.closeLk.Lock()
+	defer d.closeLk.Unlock()
+	if d.closed {
+		return ErrClosed
+	}
+
+	d.closed = true
+	return d.DB.Close()
+}
+
+// Batch creates a new batch, batches are transactions that perform no reads.
+func (d *Datastore) Batch(ctx context.Context) (ds.Batch, error) {
+	d.closeLk.RLock()
+	defer d.closeLk.RUnlock()
+	if d.closed {
+		return nil, ErrClosed
+	}
+
+	return d.newTransaction(false), nil
+}
+
+// commit applies the write set of the given transaction if none of the keys it has read
+// have been written since it started.
+func (d *Datastore) commit(t *txn) error {
+	d.commitLk.Lock()
+	defer d.commitLk.Unlock()
+
+	for _, c := range d.committed {
+		if c.version <= t.version {
+			continue
+		}
+		for key := range t.reads {
+			if _, ok := c.keys[key]; ok {
+				return ErrTxnConflict
+			}
+		}
+	}
+
+	if len(t.writes) == 0 {
+		return nil
+	}
+
+	batch := d.DB.NewBatch()
+	defer batch.Close() //nolint:errcheck
+
+	keys := make(map[string]struct{}, len(t.writes))
+	for key, write := range t.writes {
+		var err error
+		if write.deleted {
+			err = batch.Delete([]byte(key), nil)
+		} else {
+			err = batch.Set([]byte(key), write.value, nil)
+		}
+		if err != nil {
+			return err
+		}
+		keys[key] = struct{}{}
+	}
+	if err := batch.Commit(d.writeOptions); err != nil {
+		return err
+	}
+
+	d.version++
+	d.committed = append(d.committed, committedTxn{
+		version: d.version,
+		keys:    keys,
+	})
+	return nil
+}
+
+// release marks the given read-write transaction as no longer in flight and drops the
+// write sets that can no longer conflict with any in flight transaction.
+func (d *Datastore) release(t *txn) {
+	d.commitLk.Lock()
+	defer d.commitLk.Unlock()
+
+	d.inFlight[t.version]--
+	if d.inFlight[t.version] == 0 {
+		delete(d.inFlight, t.version)
+	}
+
+	oldest := d.version
+	for version := range d.inFlight {
+		if version < oldest {
+			oldest = version
+		}
+	}
+	i := 0
+	for i < len(d.committed) && d.committed[i].version <= oldest {
+		i++
+	}
+	d.committed = d.committed[i:]
+}
+
+// reader is implemented by both pebble databases and snapshots.
+type reader interface {
+	Get(key []byte) ([]byte, io.Closer, error)
+	NewIter(o *pebble.IterOptions) *pebble.Iterator
+}
+
+func get(r reader, key ds.Key) ([]byte, error) {
+	value, closer, err := r.Get(key.Bytes())
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, ds.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close() //nolint:errcheck
+
+	// The value is only valid until the closer is closed.
+	return append([]byte{}, value...), nil
+}
+
+func has(r reader, key ds.Key) (bool, error) {
+	_, err := get(r, key)
+	switch {
+	case errors.Is(err, ds.ErrNotFound):
+		return false, nil
+	case err == nil:
+		return true, nil
+	default:
+		return false, err
+	}
+}
@@ -0,0 +1,378 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package pebble
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/vfs"
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testKey1   = ds.NewKey("/test/key1")
+	testValue1 = []byte("value1")
+	testKey2   = ds.NewKey("/test/key2")
+	testValue2 = []byte("value2")
+	testKey3   = ds.NewKey("/other/key3")
+	testValue3 = []byte("value3")
+)
+
+func newMemoryDatastore(t *testing.T) *Datastore {
+	opts := Options{Options: pebble.Options{FS: vfs.NewMem()}}
+	store, err := NewDatastore("", &opts)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		store.Close() //nolint:errcheck
+	})
+	return store
+}
+
+func TestPutAndGet(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryDatastore(t)
+
+	err := store.Put(ctx, testKey1, testValue1)
+	require.NoError(t, err)
+
+	value, err := store.Get(ctx, testKey1)
+	require.NoError(t, err)
+	require.Equal(t, testValue1, value)
+
+	size, err := store.GetSize(ctx, testKey1)
+	require.NoError(t, err)
+	require.Equal(t, len(testValue1), size)
+
+	exists, err := store.Has(ctx, testKey1)
+	require.NoError(t, err)
+	require.True(t, exists)
+}
+
+func TestGet_WithMissingKey_ReturnsNotFound(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryDatastore(t)
+
+	_, err := store.Get(ctx, testKey1)
+	require.ErrorIs(t, err, ds.ErrNotFound)
+
+	exists, err := store.Has(ctx, testKey1)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryDatastore(t)
+
+	err := store.Put(ctx, testKey1, testValue1)
+	require.NoError(t, err)
+
+	err = store.Delete(ctx, testKey1)
+	require.NoError(t, err)
+
+	_, err = store.Get(ctx, testKey1)
+	require.ErrorIs(t, err, ds.ErrNotFound)
+}
+
+func TestQuery_WithPrefix(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryDatastore(t)
+
+	require.NoError(t, store.Put(ctx, testKey2, testValue2))
+	require.NoError(t, store.Put(ctx, testKey1, testValue1))
+	require.NoError(t, store.Put(ctx, testKey3, testValue3))
+
+	results, err := store.Query(ctx, dsq.Query{Prefix: "/test"})
+	require.NoError(t, err)
+	entries, err := results.Rest()
+	require.NoError(t, err)
+
+	require.Len(t, entries, 2)
+	require.Equal(t, testKey1.String(), entries[0].Key)
+	require.Equal(t, testValue1, entries[0].Value)
+	require.Equal(t, testKey2.String(), entries[1].Key)
+	require.Equal(t, testValue2, entries[1].Value)
+}
+
+func TestQuery_WithOrderAndLimit(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryDatastore(t)
+
+	require.NoError(t, store.Put(ctx, testKey1, testValue1))
+	require.NoError(t, store.Put(ctx, testKey2, testValue2))
+
+	results, err := store.Query(ctx, dsq.Query{
+		Prefix:   "/test",
+		Orders:   []dsq.Order{dsq.OrderByKeyDescending{}},
+		Limit:    1,
+		KeysOnly: true,
+	})
+	require.NoError(t, err)
+	entries, err := results.Rest()
+	require.NoError(t, err)
+
+	require.Len(t, entries, 1)
+	require.Equal(t, testKey2.String(), entries[0].Key)
+	require.Nil(t, entries[0].Value)
+}
+
+func TestTxnQuery_WithPendingWrites_ReturnsMergedEntries(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryDatastore(t)
+
+	require.NoError(t, store.Put(ctx, testKey1, testValue1))
+	require.NoError(t, store.Put(ctx, testKey3, testValue3))
+
+	txn, err := store.NewTransaction(ctx, false)
+	require.NoError(t, err)
+	defer txn.Discard(ctx)
+
+	require.NoError(t, txn.Delete(ctx, testKey1))
+	require.NoError(t, txn.Put(ctx, testKey2, testValue2))
+
+	results, err := txn.Query(ctx, dsq.Query{Prefix: "/test"})
+	require.NoError(t, err)
+	entries, err := results.Rest()
+	require.NoError(t, err)
+
+	require.Len(t, entries, 1)
+	require.Equal(t, testKey2.String(), entries[0].Key)
+	require.Equal(t, testValue2, entries[0].Value)
+}
+
+func TestTxn_WithUncommittedWrites_IsIsolated(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryDatastore(t)
+
+	txn, err := store.NewTransaction(ctx, false)
+	require.NoError(t, err)
+
+	require.NoError(t, txn.Put(ctx, testKey1, testValue1))
+
+	value, err := txn.Get(ctx, testKey1)
+	require.NoError(t, err)
+	require.Equal(t, testValue1, value)
+
+	_, err = store.Get(ctx, testKey1)
+	require.ErrorIs(t, err, ds.ErrNotFound)
+
+	require.NoError(t, txn.Commit(ctx))
+
+	value, err = store.Get(ctx, testKey1)
+	require.NoError(t, err)
+	require.Equal(t, testValue1, value)
+}
+
+func TestTxn_ReadsFromSnapshot(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryDatastore(t)
+
+	txn, err := store.NewTransaction(ctx, true)
+	require.NoError(t, err)
+	defer txn.Discard(ctx)
+
+	require.NoError(t, store.Put(ctx, testKey1, testValue1))
+
+	_, err = txn.Get(ctx, testKey1)
+	require.ErrorIs(t, err, ds.ErrNotFound)
+}
+
+func TestTxnCommit_WithConflictingRead_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryDatastore(t)
+
+	require.NoError(t, store.Put(ctx, testKey1, testValue1))
+
+	txn1, err := store.NewTransaction(ctx, false)
+	require.NoError(t, err)
+	txn2, err := store.NewTransaction(ctx, false)
+	require.NoError(t, err)
+
+	_, err = txn1.Get(ctx, testKey1)
+	require.NoError(t, err)
+	require.NoError(t, txn1.Put(ctx, testKey2, testValue2))
+
+	require.NoError(t, txn2.Put(ctx, testKey1, testValue2))
+	require.NoError(t, txn2.Commit(ctx))
+
+	err = txn1.Commit(ctx)
+	require.ErrorIs(t, err, ErrTxnConflict)
+
+	_, err = store.Get(ctx, testKey2)
+	require.ErrorIs(t, err, ds.ErrNotFound)
+}
+
+func TestTxnCommit_WithBlindWrites_Succeeds(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryDatastore(t)
+
+	txn1, err := store.NewTransaction(ctx, false)
+	require.NoError(t, err)
+	txn2, err := store.NewTransaction(ctx, false)
+	require.NoError(t, err)
+
+	require.NoError(t, txn1.Put(ctx, testKey1, testValue1))
+	require.NoError(t, txn2.Put(ctx, testKey1, testValue2))
+
+	require.NoError(t, txn2.Commit(ctx))
+	require.NoError(t, txn1.Commit(ctx))
+
+	value, err := store.Get(ctx, testKey1)
+	require.NoError(t, err)
+	require.Equal(t, testValue1, value)
+}
+
+func TestTxnPut_WithReadOnlyTxn_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryDatastore(t)
+
+	txn, err := store.NewTransaction(ctx, true)
+	require.NoError(t, err)
+	defer txn.Discard(ctx)
+
+	err = txn.Put(ctx, testKey1, testValue1)
+	require.ErrorIs(t, err, ErrReadOnlyTxn)
+
+	err = txn.Delete(ctx, testKey1)
+	require.ErrorIs(t, err, ErrReadOnlyTxn)
+}
+
+func TestTxnGet_WithDiscardedTxn_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryDatastore(t)
+
+	txn, err := store.NewTransaction(ctx, false)
+	require.NoError(t, err)
+	txn.Discard(ctx)
+
+	_, err = txn.Get(ctx, testKey1)
+	require.ErrorIs(t, err, ErrTxnDiscarded)
+
+	err = txn.Commit(ctx)
+	require.ErrorIs(t, err, ErrTxnDiscarded)
+}
+
+func TestBatch(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryDatastore(t)
+
+	batch, err := store.Batch(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, batch.Put(ctx, testKey1, testValue1))
+	require.NoError(t, batch.Put(ctx, testKey2, testValue2))
+	require.NoError(t, batch.Commit(ctx))
+
+	value, err := store.Get(ctx, testKey2)
+	require.NoError(t, err)
+	require.Equal(t, testValue2, value)
+}
+
+func TestClose_ThenGet_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	opts := Options{Options: pebble.Options{FS: vfs.NewMem()}}
+	store, err := NewDatastore("", &opts)
+	require.NoError(t, err)
+
+	require.NoError(t, store.Close())
+
+	_, err = store.Get(ctx, testKey1)
+	require.ErrorIs(t, err, ErrClosed)
+
+	_, err = store.NewTransaction(ctx, false)
+	require.ErrorIs(t, err, ErrClosed)
+
+	err = store.Close()
+	require.ErrorIs(t, err, ErrClosed)
+}
+
+func TestNewDatastore_WithExistingPath_PersistsData(t *testing.T) {
+	ctx := context.Background()
+	path := t.TempDir()
+
+	store, err := NewDatastore(path, nil)
+	require.NoError(t, err)
+	require.NoError(t, store.Put(ctx, testKey1, testValue1))
+	require.NoError(t, store.Close())
+
+	store, err = NewDatastore(path, nil)
+	require.NoError(t, err)
+	defer store.Close() //nolint:errcheck
+
+	value, err := store.Get(ctx, testKey1)
+	require.NoError(t, err)
+	require.Equal(t, testValue1, value)
+}
+
+func TestIteratePrefix(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryDatastore(t)
+
+	require.NoError(t, store.Put(ctx, testKey1, testValue1))
+	require.NoError(t, store.Put(ctx, testKey3, testValue3))
+
+	txn, err := store.NewIterableTransaction(ctx, true)
+	require.NoError(t, err)
+	defer txn.Discard(ctx)
+
+	iter, err := txn.GetIterator(dsq.Query{})
+	require.NoError(t, err)
+	defer iter.Close() //nolint:errcheck
+
+	results, err := iter.IteratePrefix(ctx, ds.NewKey("/test"), testKey1)
+	require.NoError(t, err)
+	entries, err := results.Rest()
+	require.NoError(t, err)
+
+	require.Len(t, entries, 1)
+	require.Equal(t, testKey1.String(), entries[0].Key)
+}
+
+func TestIteratePrefix_WithPendingWritesAndDescendingOrder_ReturnsMergedEntries(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryDatastore(t)
+
+	require.NoError(t, store.Put(ctx, testKey1, testValue1))
+	require.NoError(t, store.Put(ctx, testKey2, testValue2))
+	require.NoError(t, store.Put(ctx, testKey3, testValue3))
+
+	txn, err := store.NewIterableTransaction(ctx, false)
+	require.NoError(t, err)
+	defer txn.Discard(ctx)
+
+	testKey4 := ds.NewKey("/test/key4")
+	require.NoError(t, txn.Delete(ctx, testKey1))
+	require.NoError(t, txn.Put(ctx, testKey2, testValue3))
+	require.NoError(t, txn.Put(ctx, testKey4, testValue1))
+
+	iter, err := txn.GetIterator(dsq.Query{Orders: []dsq.Order{dsq.OrderByKeyDescending{}}})
+	require.NoError(t, err)
+	defer iter.Close() //nolint:errcheck
+
+	results, err := iter.IteratePrefix(ctx, ds.NewKey("/test"), ds.NewKey("/tesu"))
+	require.NoError(t, err)
+
+	// Writes made while reading the results are not returned.
+	require.NoError(t, txn.Put(ctx, ds.NewKey("/test/key3"), testValue3))
+
+	entries, err := results.Rest()
+	require.NoError(t, err)
+
+	require.Len(t, entries, 2)
+	require.Equal(t, testKey4.String(), entries[0].Key)
+	require.Equal(t, testValue1, entries[0].Value)
+	require.Equal(t, testKey2.String(), entries[1].Key)
+	require.Equal(t, testValue3, entries[1].Value)
+}
@@ -0,0 +1,270 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package pebble
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+
+	"github.com/sourcenetwork/defradb/datastore/iterable"
+)
+
+// Iterator implements iterable.Iterator over a transaction, each prefix is iterated by a
+// pebble iterator over the snapshot of the transaction merged with its pending writes.
+type Iterator struct {
+	txn   *txn
+	query dsq.Query
+
+	results dsq.Results
+}
+
+var _ iterable.Iterator = (*Iterator)(nil)
+
+func (t *txn) GetIterator(q dsq.Query) (iterable.Iterator, error) {
+	return &Iterator{
+		txn:   t,
+		query: q,
+	}, nil
+}
+
+// IteratePrefix returns the entries with keys between the given start and end keys, or the
+// entries under the given start key if the end key is the end of its prefix.
+func (iterator *Iterator) IteratePrefix(
+	ctx context.Context,
+	startPrefix ds.Key,
+	endPrefix ds.Key,
+) (dsq.Results, error) {
+	if iterator.results != nil {
+		err := iterator.results.Close()
+		if err != nil {
+			return nil, err
+		}
+		iterator.results = nil
+	}
+
+	iterator.txn.ds.closeLk.RLock()
+	defer iterator.txn.ds.closeLk.RUnlock()
+	if iterator.txn.ds.closed {
+		return nil, ErrClosed
+	}
+
+	var lower, upper []byte
+	if bytes.Equal(prefixEnd(startPrefix.Bytes()), endPrefix.Bytes()) {
+		lower, upper = prefixBounds(startPrefix.String())
+	} else {
+		// The end key is included in the range.
+		lower = startPrefix.Bytes()
+		upper = append(endPrefix.Bytes(), 0)
+	}
+
+	results, err := iterator.txn.iterate(iterator.query, lower, upper, nil)
+	if err != nil {
+		return nil, err
+	}
+	iterator.results = results
+	return results, nil
+}
+
+func (iterator *Iterator) Close() error {
+	if iterator.results == nil {
+		return nil
+	}
+	return iterator.results.Close()
+}
+
+// mergedIterator iterates over the entries of a snapshot merged with the pending writes of
+// a transaction, in key order.
+type mergedIterator struct {
+	txn     *txn
+	iter    *pebble.Iterator
+	query   dsq.Query
+	reverse bool
+	// The pending writes within the iterated range, in iteration order.
+	writes []pendingEntry
+	// release is called once the iterator is closed.
+	release func()
+
+	// lk guards the iterators, results may be closed while being read.
+	lk     sync.Mutex
+	closed bool
+}
+
+type pendingEntry struct {
+	key string
+	pendingWrite
+}
+
+// iterate returns the results of the given query over the entries with keys within the given
+// bounds, the upper bound being excluded, streamed from the snapshot of the transaction and
+// merged with its pending writes.
+//
+// The given release function, if any, is called once the results are closed.
+func (t *txn) iterate(q dsq.Query, lower []byte, upper []byte, release func()) (dsq.Results, error) {
+	t.lk.Lock()
+	defer t.lk.Unlock()
+	if t.discarded {
+		return nil, ErrTxnDiscarded
+	}
+
+	// Orders by key are handled by the direction of the iteration, any other order requires
+	// all the entries to be read first.
+	naive := q
+	naive.Prefix = ""
+	reverse := false
+	if len(q.Orders) == 1 {
+		switch q.Orders[0].(type) {
+		case dsq.OrderByKey, *dsq.OrderByKey:
+			naive.Orders = nil
+		case dsq.OrderByKeyDescending, *dsq.OrderByKeyDescending:
+			naive.Orders = nil
+			reverse = true
+		}
+	}
+
+	// The pending writes are copied so that the transaction can keep being written to while
+	// the results are read.
+	writes := []pendingEntry{}
+	for key, write := range t.writes {
+		if (lower != nil && key < string(lower)) || (upper != nil && key >= string(upper)) {
+			continue
+		}
+		writes = append(writes, pendingEntry{key: key, pendingWrite: write})
+	}
+	sort.Slice(writes, func(i, j int) bool {
+		if reverse {
+			return writes[i].key > writes[j].key
+		}
+		return writes[i].key < writes[j].key
+	})
+
+	iter := t.snapshot.NewIter(&pebble.IterOptions{
+		LowerBound: lower,
+		UpperBound: upper,
+	})
+	if reverse {
+		iter.Last()
+	} else {
+		iter.First()
+	}
+
+	it := &mergedIterator{
+		txn:     t,
+		iter:    iter,
+		query:   q,
+		reverse: reverse,
+		writes:  writes,
+		release: release,
+	}
+	r := dsq.ResultsFromIterator(q, dsq.Iterator{
+		Next:  it.next,
+		Close: it.close,
+	})
+	return dsq.NaiveQueryApply(naive, r), nil
+}
+
+func (it *mergedIterator) next() (dsq.Result, bool) {
+	it.lk.Lock()
+	defer it.lk.Unlock()
+	if it.closed {
+		return dsq.Result{}, false
+	}
+
+	it.txn.ds.closeLk.RLock()
+	defer it.txn.ds.closeLk.RUnlock()
+	if it.txn.ds.closed {
+		return dsq.Result{Error: ErrClosed}, true
+	}
+
+	for {
+		var write *pendingEntry
+		if len(it.writes) > 0 {
+			write = &it.writes[0]
+		}
+
+		if it.iter.Valid() {
+			key := string(it.iter.Key())
+			switch {
+			case write == nil || it.before(key, write.key):
+				it.txn.lk.Lock()
+				it.txn.trackRead(key)
+				it.txn.lk.Unlock()
+				e := newEntry(key, it.iter.Value(), it.query)
+				it.advance()
+				return dsq.Result{Entry: e}, true
+
+			case key == write.key:
+				// The pending write shadows the entry of the snapshot.
+				it.advance()
+			}
+		}
+
+		if write == nil {
+			if err := it.iter.Error(); err != nil {
+				return dsq.Result{Error: err}, true
+			}
+			return dsq.Result{}, false
+		}
+
+		it.writes = it.writes[1:]
+		if !write.deleted {
+			return dsq.Result{Entry: newEntry(write.key, write.value, it.query)}, true
+		}
+	}
+}
+
+// before returns true if the given key of the snapshot comes before the given key of a
+// pending write in the iteration order.
+func (it *mergedIterator) before(key string, writeKey string) bool {
+	if it.reverse {
+		return key > writeKey
+	}
+	return key < writeKey
+}
+
+func (it *mergedIterator) advance() {
+	if it.reverse {
+		it.iter.Prev()
+	} else {
+		it.iter.Next()
+	}
+}
+
+func (it *mergedIterator) close() error {
+	it.lk.Lock()
+	defer it.lk.Unlock()
+	if it.closed {
+		return nil
+	}
+	it.closed = true
+
+	err := it.iter.Close()
+	if it.release != nil {
+		it.release()
+	}
+	return err
+}
+
+// prefixBounds returns the bounds of the keys under the given prefix, the upper bound
+// being excluded.
+func prefixBounds(prefix string) ([]byte, []byte) {
+	prefix = ds.NewKey(prefix).String()
+	if prefix == "/" {
+		return nil, nil
+	}
+	lower := []byte(prefix + "/")
+	return lower, prefixEnd(lower)
+}
@@ -0,0 +1,260 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package pebble
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+
+	"github.com/sourcenetwork/defradb/datastore/iterable"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+// Implements the datastore.Txn interface, enabling transaction support for
+// the pebble Datastore.
+type txn struct {
+	ds       *Datastore
+	snapshot *pebble.Snapshot
+
+	// The datastore version the snapshot was taken at.
+	version  uint64
+	readOnly bool
+
+	// lk guards the fields below, transactions may be used concurrently.
+	lk        sync.Mutex
+	discarded bool
+	// The keys read by this transaction, a transaction conflicts if any of
+	// them are written by another transaction committed after it started.
+	reads map[string]struct{}
+	// The writes buffered until the transaction is committed.
+	writes map[string]pendingWrite
+}
+
+type pendingWrite struct {
+	value   []byte
+	deleted bool
+}
+
+var _ ds.Txn = (*txn)(nil)
+var _ ds.Batch = (*txn)(nil)
+var _ iterable.IterableTxn = (*txn)(nil)
+
+func (t *txn) Put(ctx context.Context, key ds.Key, value []byte) error {
+	t.ds.closeLk.RLock()
+	defer t.ds.closeLk.RUnlock()
+	if t.ds.closed {
+		return ErrClosed
+	}
+
+	return t.put(key, value)
+}
+
+func (t *txn) put(key ds.Key, value []byte) error {
+	t.lk.Lock()
+	defer t.lk.Unlock()
+	if t.discarded {
+		return ErrTxnDiscarded
+	}
+	if t.readOnly {
+		return ErrReadOnlyTxn
+	}
+
+	// The caller may reuse the value once this returns.
+	t.writes[key.String()] = pendingWrite{value: append([]byte{}, value...)}
+	return nil
+}
+
+func (t *txn) Get(ctx context.Context, key ds.Key) ([]byte, error) {
+	t.ds.closeLk.RLock()
+	defer t.ds.closeLk.RUnlock()
+	if t.ds.closed {
+		return nil, ErrClosed
+	}
+
+	return t.get(key)
+}
+
+func (t *txn) get(key ds.Key) ([]byte, error) {
+	t.lk.Lock()
+	defer t.lk.Unlock()
+	if t.discarded {
+		return nil, ErrTxnDiscarded
+	}
+
+	if write, ok := t.writes[key.String()]; ok {
+		if write.deleted {
+			return nil, ds.ErrNotFound
+		}
+		return write.value, nil
+	}
+	t.trackRead(key.String())
+	return get(t.snapshot, key)
+}
+
+func (t *txn) Has(ctx context.Context, key ds.Key) (bool, error) {
+	t.ds.closeLk.RLock()
+	defer t.ds.closeLk.RUnlock()
+	if t.ds.closed {
+		return false, ErrClosed
+	}
+
+	_, err := t.get(key)
+	switch {
+	case errors.Is(err, ds.ErrNotFound):
+		return false, nil
+	case err == nil:
+		return true, nil
+	default:
+		return false, err
+	}
+}
+
+func (t *txn) GetSize(ctx context.Context, key ds.Key) (int, error) {
+	t.ds.closeLk.RLock()
+	defer t.ds.closeLk.RUnlock()
+	if t.ds.closed {
+		return -1, ErrClosed
+	}
+
+	value, err := t.get(key)
+	if err != nil {
+		return -1, err
+	}
+	return len(value), nil
+}
+
+func (t *txn) Delete(ctx context.Context, key ds.Key) error {
+	t.ds.closeLk.RLock()
+	defer t.ds.closeLk.RUnlock()
+	if t.ds.closed {
+		return ErrClosed
+	}
+
+	return t.delete(key)
+}
+
+func (t *txn) delete(key ds.Key) error {
+	t.lk.Lock()
+	defer t.lk.Unlock()
+	if t.discarded {
+		return ErrTxnDiscarded
+	}
+	if t.readOnly {
+		return ErrReadOnlyTxn
+	}
+
+	t.writes[key.String()] = pendingWrite{deleted: true}
+	return nil
+}
+
+func (t *txn) Query(ctx context.Context, q dsq.Query) (dsq.Results, error) {
+	t.ds.closeLk.RLock()
+	defer t.ds.closeLk.RUnlock()
+	if t.ds.closed {
+		return nil, ErrClosed
+	}
+
+	return t.query(q, nil)
+}
+
+// query streams the entries under the query prefix from the snapshot merged with the
+// pending writes of the transaction, and naively applies the rest of the query.
+func (t *txn) query(q dsq.Query, release func()) (dsq.Results, error) {
+	lower, upper := prefixBounds(q.Prefix)
+	return t.iterate(q, lower, upper, release)
+}
+
+func (t *txn) Commit(ctx context.Context) error {
+	t.ds.closeLk.RLock()
+	defer t.ds.closeLk.RUnlock()
+	if t.ds.closed {
+		return ErrClosed
+	}
+
+	return t.commit()
+}
+
+func (t *txn) commit() error {
+	t.lk.Lock()
+	defer t.lk.Unlock()
+	if t.discarded {
+		return ErrTxnDiscarded
+	}
+	defer t.discardLocked()
+
+	if t.readOnly {
+		return nil
+	}
+	return t.ds.commit(t)
+}
+
+func (t *txn) Discard(ctx context.Context) {
+	t.ds.closeLk.RLock()
+	defer t.ds.closeLk.RUnlock()
+	if t.ds.closed {
+		return
+	}
+
+	t.discard()
+}
+
+func (t *txn) discard() {
+	t.lk.Lock()
+	defer t.lk.Unlock()
+	t.discardLocked()
+}
+
+func (t *txn) discardLocked() {
+	if t.discarded {
+		return
+	}
+	t.discarded = true
+	t.snapshot.Close() //nolint:errcheck
+	if !t.readOnly {
+		t.ds.release(t)
+	}
+}
+
+func (t *txn) trackRead(key string) {
+	if t.readOnly {
+		return
+	}
+	t.reads[key] = struct{}{}
+}
+
+func newEntry(key string, value []byte, q dsq.Query) dsq.Entry {
+	e := dsq.Entry{
+		Key:  key,
+		Size: len(value),
+	}
+	if !q.KeysOnly {
+		// Iterator values are only valid until the iterator moves.
+		e.Value = append([]byte{}, value...)
+	}
+	return e
+}
+
+// prefixEnd returns the key that sorts right after all the keys starting with the given prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	// The prefix is all 0xff bytes, there is no upper bound.
+	return nil
+}
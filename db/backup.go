@@ -13,13 +13,17 @@ package db
 import (
 	"bufio"
 	"context"
-	"encoding/json"
-	"fmt"
+	"io"
 	"os"
+	"time"
+
+	"github.com/ipfs/go-datastore/query"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/core"
 	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
 )
 
 func (db *db) basicImport(ctx context.Context, txn datastore.Txn, filepath string) (err error) {
@@ -34,89 +38,176 @@ func (db *db) basicImport(ctx context.Context, txn datastore.Txn, filepath strin
 		}
 	}()
 
-	d := json.NewDecoder(bufio.NewReader(f))
+	config := &client.BackupConfig{
+		Filepath: filepath,
+		Format:   backupFormatFromPath(filepath),
+	}
+	return db.basicImportFrom(ctx, txn, config, f)
+}
 
-	t, err := d.Token()
+// basicImportFrom imports the documents read from the given reader within the given
+// transaction.
+//
+// If no transaction is given, a new transaction is committed every batch of documents so
+// that large imports are not held in a single transaction.
+func (db *db) basicImportFrom(
+	ctx context.Context,
+	txn datastore.Txn,
+	config *client.BackupConfig,
+	r io.Reader,
+) (err error) {
+	reader, err := newBackupReader(config, r)
 	if err != nil {
 		return err
 	}
-	if t != json.Delim('{') {
-		return ErrExpectedJSONObject
-	}
-	for d.More() {
-		t, err := d.Token()
-		if err != nil {
-			return err
+	defer func() {
+		closeErr := reader.close()
+		if err == nil {
+			err = closeErr
 		}
-		colName := t.(string)
-		col, err := db.getCollectionByName(ctx, txn, colName)
+	}()
+
+	batched := txn == nil
+	if batched {
+		txn, err = db.NewTxn(ctx, false)
 		if err != nil {
-			return NewErrFailedToGetCollection(colName, err)
+			return err
 		}
+		defer func() {
+			txn.Discard(ctx)
+		}()
+	}
+	batchSize := config.BatchSize
+	if batchSize <= 0 {
+		batchSize = client.DefaultImportBatchSize
+	}
 
-		t, err = d.Token()
+	cols := map[string]client.Collection{}
+	imported := 0
+	for {
+		colName, docMap, err := reader.next()
+		if errors.Is(err, io.EOF) {
+			if batched {
+				return txn.Commit(ctx)
+			}
+			return nil
+		}
 		if err != nil {
 			return err
 		}
-		if t != json.Delim('[') {
-			return ErrExpectedJSONArray
-		}
 
-		for d.More() {
-			docMap := map[string]any{}
-			err = d.Decode(&docMap)
+		col, ok := cols[colName]
+		if !ok {
+			col, err = db.getCollectionByName(ctx, txn, colName)
 			if err != nil {
-				return NewErrJSONDecode(err)
+				return NewErrFailedToGetCollection(colName, err)
 			}
+			cols[colName] = col
+		}
+		if docMap == nil {
+			continue
+		}
 
-			// check if self referencing and remove from docMap for key creation
-			resetMap := map[string]any{}
-			for _, field := range col.Schema().Fields {
-				if field.Kind == client.FieldKind_FOREIGN_OBJECT {
-					if val, ok := docMap[field.Name+request.RelatedObjectID]; ok {
-						if docMap["_newKey"] == val {
-							resetMap[field.Name+request.RelatedObjectID] = val
-							delete(docMap, field.Name+request.RelatedObjectID)
-						}
+		// check if self referencing and remove from docMap for key creation
+		resetMap := map[string]any{}
+		for _, field := range col.Schema().Fields {
+			if field.Kind == client.FieldKind_FOREIGN_OBJECT {
+				if val, ok := docMap[field.Name+request.RelatedObjectID]; ok {
+					if docMap["_newKey"] == val {
+						resetMap[field.Name+request.RelatedObjectID] = val
+						delete(docMap, field.Name+request.RelatedObjectID)
 					}
 				}
 			}
+		}
 
-			delete(docMap, "_key")
-			delete(docMap, "_newKey")
+		delete(docMap, "_key")
+		delete(docMap, "_newKey")
 
-			doc, err := client.NewDocFromMap(docMap)
+		doc, err := client.NewDocFromMap(docMap)
+		if err != nil {
+			return NewErrDocFromMap(err)
+		}
+
+		err = col.WithTxn(txn).Create(ctx, doc)
+		if err != nil {
+			return NewErrDocCreate(err)
+		}
+
+		// add back the self referencing fields and update doc.
+		for k, v := range resetMap {
+			err := doc.Set(k, v)
 			if err != nil {
-				return NewErrDocFromMap(err)
+				return NewErrDocUpdate(err)
 			}
-
-			err = col.WithTxn(txn).Create(ctx, doc)
+			err = col.WithTxn(txn).Update(ctx, doc)
 			if err != nil {
-				return NewErrDocCreate(err)
+				return NewErrDocUpdate(err)
 			}
+		}
 
-			// add back the self referencing fields and update doc.
-			for k, v := range resetMap {
-				err := doc.Set(k, v)
-				if err != nil {
-					return NewErrDocUpdate(err)
-				}
-				err = col.WithTxn(txn).Update(ctx, doc)
-				if err != nil {
-					return NewErrDocUpdate(err)
-				}
-			}
+		imported++
+		if !batched || imported%batchSize != 0 {
+			continue
 		}
-		_, err = d.Token()
+		err = txn.Commit(ctx)
 		if err != nil {
 			return err
 		}
+		next, err := db.NewTxn(ctx, false)
+		if err != nil {
+			return err
+		}
+		txn = next
+	}
+}
+
+func (db *db) basicExport(ctx context.Context, txn datastore.Txn, config *client.BackupConfig) (err error) {
+	tempFile := config.Filepath + ".temp"
+	f, err := os.Create(tempFile)
+	if err != nil {
+		return NewErrCreateFile(err, tempFile)
+	}
+	defer func() {
+		closeErr := f.Close()
+		if closeErr != nil {
+			err = NewErrCloseFile(closeErr, err)
+		} else if err != nil {
+			// ensure we cleanup if there was an error
+			removeErr := os.Remove(tempFile)
+			if removeErr != nil {
+				err = NewErrRemoveFile(removeErr, err, tempFile)
+			}
+		} else {
+			_ = os.Rename(tempFile, config.Filepath)
+		}
+	}()
+
+	w := bufio.NewWriter(f)
+	err = db.basicExportTo(ctx, txn, config, w)
+	if err != nil {
+		return err
+	}
+
+	err = w.Flush()
+	if err != nil {
+		return NewErrFailedToWriteString(err)
+	}
+
+	err = f.Sync()
+	if err != nil {
+		return err
 	}
 
 	return nil
 }
 
-func (db *db) basicExport(ctx context.Context, txn datastore.Txn, config *client.BackupConfig) (err error) {
+func (db *db) basicExportTo(
+	ctx context.Context,
+	txn datastore.Txn,
+	config *client.BackupConfig,
+	w io.Writer,
+) (err error) {
 	// old key -> new Key
 	keyChangeCache := map[string]string{}
 
@@ -140,72 +231,20 @@ func (db *db) basicExport(ctx context.Context, txn datastore.Txn, config *client
 		colNameCache[col.Name()] = struct{}{}
 	}
 
-	tempFile := config.Filepath + ".temp"
-	f, err := os.Create(tempFile)
-	if err != nil {
-		return NewErrCreateFile(err, tempFile)
-	}
-	defer func() {
-		closeErr := f.Close()
-		if closeErr != nil {
-			err = NewErrCloseFile(closeErr, err)
-		} else if err != nil {
-			// ensure we cleanup if there was an error
-			removeErr := os.Remove(tempFile)
-			if removeErr != nil {
-				err = NewErrRemoveFile(removeErr, err, tempFile)
-			}
-		} else {
-			_ = os.Rename(tempFile, config.Filepath)
-		}
-	}()
-
-	// open the object
-	err = writeString(f, "{", "{\n", config.Pretty)
+	writer, err := newBackupWriter(config, w)
 	if err != nil {
 		return err
 	}
 
-	firstCol := true
 	for _, col := range cols {
-		if firstCol {
-			firstCol = false
-		} else {
-			// add collection separator
-			err = writeString(f, ",", ",\n", config.Pretty)
-			if err != nil {
-				return err
-			}
-		}
-
-		// set collection
-		err = writeString(
-			f,
-			fmt.Sprintf("\"%s\":[", col.Name()),
-			fmt.Sprintf("  \"%s\": [\n", col.Name()),
-			config.Pretty,
-		)
-		if err != nil {
-			return err
-		}
-		colTxn := col.WithTxn(txn)
-		keysCh, err := colTxn.GetAllDocKeys(ctx)
+		err = writer.startCollection(col.Name())
 		if err != nil {
 			return err
 		}
 
-		firstDoc := true
-		for key := range keysCh {
-			if firstDoc {
-				firstDoc = false
-			} else {
-				// add document separator
-				err = writeString(f, ",", ",\n", config.Pretty)
-				if err != nil {
-					return err
-				}
-			}
-			doc, err := colTxn.Get(ctx, key.Key, false)
+		colTxn := col.WithTxn(txn)
+		err = db.forEachExportDocKey(ctx, txn, colTxn, config, func(key client.DocKey) error {
+			doc, err := colTxn.Get(ctx, key, false)
 			if err != nil {
 				return err
 			}
@@ -308,63 +347,151 @@ func (db *db) basicExport(ctx context.Context, txn datastore.Txn, config *client
 				keyChangeCache[doc.Key().String()] = newDoc.Key().String()
 			}
 
-			var b []byte
-			if config.Pretty {
-				_, err = f.WriteString("    ")
-				if err != nil {
-					return NewErrFailedToWriteString(err)
-				}
-				b, err = json.MarshalIndent(docM, "    ", "  ")
-				if err != nil {
-					return NewErrFailedToWriteString(err)
-				}
-			} else {
-				b, err = json.Marshal(docM)
-				if err != nil {
-					return err
-				}
-			}
+			return writer.writeDoc(docM)
+		})
+		if err != nil {
+			return err
+		}
 
-			// write document
-			_, err = f.Write(b)
-			if err != nil {
-				return err
-			}
+		err = writer.endCollection()
+		if err != nil {
+			return err
+		}
+	}
+
+	return writer.close()
+}
+
+// forEachExportDocKey calls the given function with the key of every document of the given
+// collection selected by the config.
+func (db *db) forEachExportDocKey(
+	ctx context.Context,
+	txn datastore.Txn,
+	col client.Collection,
+	config *client.BackupConfig,
+	fn func(client.DocKey) error,
+) error {
+	var changed map[string]struct{}
+	if !config.ChangedSince.IsZero() {
+		var err error
+		changed, err = changedDocKeys(ctx, txn, col.ID(), config.ChangedSince)
+		if err != nil {
+			return err
+		}
+	}
+	isSelected := func(key string) bool {
+		if changed == nil {
+			return true
 		}
+		_, ok := changed[key]
+		return ok
+	}
 
-		// close collection
-		err = writeString(f, "]", "\n  ]", config.Pretty)
+	if config.Filter == "" {
+		keysCh, err := col.GetAllDocKeys(ctx)
 		if err != nil {
 			return err
 		}
+		for key := range keysCh {
+			if key.Err != nil {
+				return key.Err
+			}
+			if !isSelected(key.Key.String()) {
+				continue
+			}
+			err := fn(key.Key)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
 	}
 
-	// close object
-	err = writeString(f, "}", "\n}", config.Pretty)
+	// Make a selection plan that will scan through only the documents with matching filter.
+	selectionPlan, err := col.(*collection).makeSelectionPlan(ctx, txn, config.Filter)
 	if err != nil {
 		return err
 	}
 
-	err = f.Sync()
+	err = selectionPlan.Init()
 	if err != nil {
 		return err
 	}
 
-	return nil
-}
+	if err := selectionPlan.Start(); err != nil {
+		return err
+	}
+
+	// If the plan isn't properly closed at any exit point log the error.
+	defer func() {
+		if err := selectionPlan.Close(); err != nil {
+			log.ErrorE(ctx, "Failed to close the request plan, after filter export", err)
+		}
+	}()
 
-func writeString(f *os.File, normal, pretty string, isPretty bool) error {
-	if isPretty {
-		_, err := f.WriteString(pretty)
+	for {
+		next, err := selectionPlan.Next()
 		if err != nil {
-			return NewErrFailedToWriteString(err)
+			return err
+		}
+		if !next {
+			return nil
+		}
+
+		doc := selectionPlan.Value()
+		docKey := doc.GetKey()
+		if !isSelected(docKey) {
+			continue
+		}
+		key, err := client.NewDocKeyFromString(docKey)
+		if err != nil {
+			return err
+		}
+		err = fn(key)
+		if err != nil {
+			return err
 		}
-		return nil
 	}
+}
 
-	_, err := f.WriteString(normal)
+// changedDocKeys returns the keys of the documents of the given collection that have had
+// a new version recorded by this node since the given time.
+//
+// The versions are read from the time index starting with the most recent one, so that only
+// the versions recorded since the given time are read.
+func changedDocKeys(
+	ctx context.Context,
+	txn datastore.Txn,
+	collectionID uint32,
+	since time.Time,
+) (map[string]struct{}, error) {
+	results, err := txn.Systemstore().Query(ctx, query.Query{
+		Prefix:   core.NewDocVersionTimeKey(collectionID, 0, "", "").ToString() + "/",
+		Orders:   []query.Order{query.OrderByKeyDescending{}},
+		KeysOnly: true,
+	})
 	if err != nil {
-		return NewErrFailedToWriteString(err)
+		return nil, err
 	}
-	return nil
+	defer func() {
+		if err := results.Close(); err != nil {
+			log.ErrorE(ctx, "Failed to close the document version query", err)
+		}
+	}()
+
+	keys := map[string]struct{}{}
+	for res := range results.Next() {
+		if res.Error != nil {
+			return nil, res.Error
+		}
+		key, err := core.NewDocVersionTimeKeyFromString(res.Key)
+		if err != nil {
+			return nil, err
+		}
+		if key.Time < since.UnixNano() {
+			break
+		}
+		keys[key.DocKey] = struct{}{}
+	}
+	return keys, nil
 }
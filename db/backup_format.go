@@ -0,0 +1,472 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// backupEntry is a single document of an ndjson or cbor backup.
+type backupEntry struct {
+	Collection string         `json:"collection" cbor:"collection"`
+	Doc        map[string]any `json:"doc" cbor:"doc"`
+}
+
+// backupWriter writes the documents of a backup in a given format.
+type backupWriter interface {
+	startCollection(name string) error
+	writeDoc(doc map[string]any) error
+	endCollection() error
+	// close writes anything remaining and flushes the underlying compression, if any.
+	// It does not close the underlying writer.
+	close() error
+}
+
+// backupReader reads the documents of a backup in a given format.
+type backupReader interface {
+	// next returns the collection name and the document of the next entry of the backup,
+	// or io.EOF once all entries have been read.
+	//
+	// A nil document may be returned to mark the start of a collection.
+	next() (string, map[string]any, error)
+	// close releases the underlying decompression, if any.
+	// It does not close the underlying reader.
+	close() error
+}
+
+// backupFormatFromPath returns the backup format matching the extension of the given path,
+// ignoring any compression extension.
+func backupFormatFromPath(path string) string {
+	ext := strings.ToLower(filepath.Ext(path))
+	switch ext {
+	case ".gz", ".zst", ".zstd":
+		ext = strings.ToLower(filepath.Ext(strings.TrimSuffix(path, filepath.Ext(path))))
+	}
+	switch ext {
+	case ".ndjson", ".jsonl":
+		return client.BackupFormatNDJSON
+	case ".cbor":
+		return client.BackupFormatCBOR
+	default:
+		return client.BackupFormatJSON
+	}
+}
+
+// newBackupWriter returns a writer writing documents to w in the format
+// and compression given by the config.
+func newBackupWriter(config *client.BackupConfig, w io.Writer) (backupWriter, error) {
+	var compressor io.WriteCloser
+	switch strings.ToLower(config.Compression) {
+	case client.BackupCompressionNone:
+	case client.BackupCompressionGzip:
+		compressor = gzip.NewWriter(w)
+	case client.BackupCompressionZstd:
+		enc, err := zstd.NewWriter(w)
+		if err != nil {
+			return nil, err
+		}
+		compressor = enc
+	default:
+		return nil, NewErrInvalidBackupCompression(config.Compression)
+	}
+	if compressor != nil {
+		w = compressor
+	}
+
+	var bw backupWriter
+	switch strings.ToLower(config.Format) {
+	case client.BackupFormatJSON, "":
+		bw = &jsonBackupWriter{w: w, pretty: config.Pretty, firstCol: true}
+	case client.BackupFormatNDJSON:
+		bw = &ndjsonBackupWriter{w: w}
+	case client.BackupFormatCBOR:
+		bw = &cborBackupWriter{enc: cbor.NewEncoder(w)}
+	default:
+		return nil, NewErrInvalidBackupFormat(config.Format)
+	}
+
+	if compressor == nil {
+		return bw, nil
+	}
+	return &compressedBackupWriter{backupWriter: bw, compressor: compressor}, nil
+}
+
+// newBackupReader returns a reader reading documents from r in the format and compression
+// given by the config.
+//
+// The compression is detected from the content if none is set.
+func newBackupReader(config *client.BackupConfig, r io.Reader) (backupReader, error) {
+	br := bufio.NewReader(r)
+
+	compression := strings.ToLower(config.Compression)
+	if compression == client.BackupCompressionNone {
+		// Any error here will be returned when reading the content.
+		magic, _ := br.Peek(len(zstdMagic))
+		switch {
+		case bytes.HasPrefix(magic, gzipMagic):
+			compression = client.BackupCompressionGzip
+		case bytes.HasPrefix(magic, zstdMagic):
+			compression = client.BackupCompressionZstd
+		}
+	}
+
+	var decompressor io.ReadCloser
+	switch compression {
+	case client.BackupCompressionNone:
+	case client.BackupCompressionGzip:
+		dec, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		decompressor = dec
+	case client.BackupCompressionZstd:
+		dec, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		decompressor = dec.IOReadCloser()
+	default:
+		return nil, NewErrInvalidBackupCompression(config.Compression)
+	}
+	var content io.Reader = br
+	if decompressor != nil {
+		content = decompressor
+	}
+
+	var reader backupReader
+	switch strings.ToLower(config.Format) {
+	case client.BackupFormatJSON, "":
+		reader = &jsonBackupReader{dec: json.NewDecoder(content)}
+	case client.BackupFormatNDJSON:
+		reader = &ndjsonBackupReader{dec: json.NewDecoder(content)}
+	case client.BackupFormatCBOR:
+		mode, err := cbor.DecOptions{
+			DefaultMapType: reflect.TypeOf(map[string]any{}),
+		}.DecMode()
+		if err != nil {
+			return nil, err
+		}
+		reader = &cborBackupReader{dec: mode.NewDecoder(content)}
+	default:
+		if decompressor != nil {
+			decompressor.Close() //nolint:errcheck
+		}
+		return nil, NewErrInvalidBackupFormat(config.Format)
+	}
+
+	if decompressor == nil {
+		return reader, nil
+	}
+	return &compressedBackupReader{backupReader: reader, decompressor: decompressor}, nil
+}
+
+type compressedBackupWriter struct {
+	backupWriter
+	compressor io.WriteCloser
+}
+
+func (w *compressedBackupWriter) close() error {
+	if err := w.backupWriter.close(); err != nil {
+		return err
+	}
+	return w.compressor.Close()
+}
+
+type compressedBackupReader struct {
+	backupReader
+	decompressor io.ReadCloser
+}
+
+func (r *compressedBackupReader) close() error {
+	return r.decompressor.Close()
+}
+
+// jsonBackupWriter writes a single JSON object holding an array of documents per collection.
+type jsonBackupWriter struct {
+	w        io.Writer
+	pretty   bool
+	firstCol bool
+	firstDoc bool
+}
+
+func (w *jsonBackupWriter) startCollection(name string) error {
+	if w.firstCol {
+		// open the object
+		err := writeString(w.w, "{", "{\n", w.pretty)
+		if err != nil {
+			return err
+		}
+		w.firstCol = false
+	} else {
+		// add collection separator
+		err := writeString(w.w, ",", ",\n", w.pretty)
+		if err != nil {
+			return err
+		}
+	}
+	w.firstDoc = true
+
+	// set collection
+	return writeString(
+		w.w,
+		fmt.Sprintf("\"%s\":[", name),
+		fmt.Sprintf("  \"%s\": [\n", name),
+		w.pretty,
+	)
+}
+
+func (w *jsonBackupWriter) writeDoc(doc map[string]any) error {
+	if w.firstDoc {
+		w.firstDoc = false
+	} else {
+		// add document separator
+		err := writeString(w.w, ",", ",\n", w.pretty)
+		if err != nil {
+			return err
+		}
+	}
+
+	var b []byte
+	var err error
+	if w.pretty {
+		_, err = io.WriteString(w.w, "    ")
+		if err != nil {
+			return NewErrFailedToWriteString(err)
+		}
+		b, err = json.MarshalIndent(doc, "    ", "  ")
+		if err != nil {
+			return NewErrFailedToWriteString(err)
+		}
+	} else {
+		b, err = json.Marshal(doc)
+		if err != nil {
+			return err
+		}
+	}
+
+	// write document
+	_, err = w.w.Write(b)
+	return err
+}
+
+func (w *jsonBackupWriter) endCollection() error {
+	// close collection
+	return writeString(w.w, "]", "\n  ]", w.pretty)
+}
+
+func (w *jsonBackupWriter) close() error {
+	if w.firstCol {
+		// open the object of an export without any collection
+		err := writeString(w.w, "{", "{\n", w.pretty)
+		if err != nil {
+			return err
+		}
+	}
+	// close object
+	return writeString(w.w, "}", "\n}", w.pretty)
+}
+
+// ndjsonBackupWriter writes a backupEntry JSON object per line.
+type ndjsonBackupWriter struct {
+	w          io.Writer
+	collection string
+}
+
+func (w *ndjsonBackupWriter) startCollection(name string) error {
+	w.collection = name
+	return nil
+}
+
+func (w *ndjsonBackupWriter) writeDoc(doc map[string]any) error {
+	b, err := json.Marshal(backupEntry{Collection: w.collection, Doc: doc})
+	if err != nil {
+		return err
+	}
+	_, err = w.w.Write(append(b, '\n'))
+	return err
+}
+
+func (w *ndjsonBackupWriter) endCollection() error {
+	return nil
+}
+
+func (w *ndjsonBackupWriter) close() error {
+	return nil
+}
+
+// cborBackupWriter writes a sequence of backupEntry CBOR items.
+type cborBackupWriter struct {
+	enc        *cbor.Encoder
+	collection string
+}
+
+func (w *cborBackupWriter) startCollection(name string) error {
+	w.collection = name
+	return nil
+}
+
+func (w *cborBackupWriter) writeDoc(doc map[string]any) error {
+	return w.enc.Encode(backupEntry{Collection: w.collection, Doc: doc})
+}
+
+func (w *cborBackupWriter) endCollection() error {
+	return nil
+}
+
+func (w *cborBackupWriter) close() error {
+	return nil
+}
+
+// jsonBackupReader reads a single JSON object holding an array of documents per collection.
+type jsonBackupReader struct {
+	dec          *json.Decoder
+	started      bool
+	collection   string
+	inCollection bool
+}
+
+func (r *jsonBackupReader) next() (string, map[string]any, error) {
+	if !r.started {
+		t, err := r.dec.Token()
+		if err != nil {
+			return "", nil, err
+		}
+		if t != json.Delim('{') {
+			return "", nil, ErrExpectedJSONObject
+		}
+		r.started = true
+	}
+
+	for {
+		if r.collection == "" {
+			if !r.dec.More() {
+				return "", nil, io.EOF
+			}
+			t, err := r.dec.Token()
+			if err != nil {
+				return "", nil, err
+			}
+			r.collection = t.(string)
+			// Mark the start of the collection before reading its documents, so that
+			// unknown collections are reported before the content is validated.
+			return r.collection, nil, nil
+		}
+
+		if !r.inCollection {
+			t, err := r.dec.Token()
+			if err != nil {
+				return "", nil, err
+			}
+			if t != json.Delim('[') {
+				return "", nil, ErrExpectedJSONArray
+			}
+			r.inCollection = true
+		}
+
+		if r.dec.More() {
+			docMap := map[string]any{}
+			err := r.dec.Decode(&docMap)
+			if err != nil {
+				return "", nil, NewErrJSONDecode(err)
+			}
+			return r.collection, docMap, nil
+		}
+
+		// close collection
+		_, err := r.dec.Token()
+		if err != nil {
+			return "", nil, err
+		}
+		r.collection = ""
+		r.inCollection = false
+	}
+}
+
+func (r *jsonBackupReader) close() error {
+	return nil
+}
+
+// ndjsonBackupReader reads a backupEntry JSON object per line.
+type ndjsonBackupReader struct {
+	dec *json.Decoder
+}
+
+func (r *ndjsonBackupReader) next() (string, map[string]any, error) {
+	var entry backupEntry
+	err := r.dec.Decode(&entry)
+	if errors.Is(err, io.EOF) {
+		return "", nil, io.EOF
+	}
+	if err != nil {
+		return "", nil, NewErrJSONDecode(err)
+	}
+	return entry.Collection, entry.Doc, nil
+}
+
+func (r *ndjsonBackupReader) close() error {
+	return nil
+}
+
+// cborBackupReader reads a sequence of backupEntry CBOR items.
+type cborBackupReader struct {
+	dec *cbor.Decoder
+}
+
+func (r *cborBackupReader) next() (string, map[string]any, error) {
+	var entry backupEntry
+	err := r.dec.Decode(&entry)
+	if errors.Is(err, io.EOF) {
+		return "", nil, io.EOF
+	}
+	if err != nil {
+		return "", nil, NewErrCBORDecode(err)
+	}
+	return entry.Collection, entry.Doc, nil
+}
+
+func (r *cborBackupReader) close() error {
+	return nil
+}
+
+func writeString(w io.Writer, normal, pretty string, isPretty bool) error {
+	if isPretty {
+		_, err := io.WriteString(w, pretty)
+		if err != nil {
+			return NewErrFailedToWriteString(err)
+		}
+		return nil
+	}
+
+	_, err := io.WriteString(w, normal)
+	if err != nil {
+		return NewErrFailedToWriteString(err)
+	}
+	return nil
+}
@@ -11,10 +11,14 @@
 package db
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -550,3 +554,138 @@ func TestBasicImport_WithInvalidCollection_ReturnError(t *testing.T) {
 	err = txn.Commit(ctx)
 	require.NoError(t, err)
 }
+
+func newBackupTestDB(ctx context.Context, t *testing.T) *implicitTxnDB {
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	t.Cleanup(db.Close)
+
+	newUserTestCollection(ctx, t, db)
+	return db
+}
+
+func createBackupTestUser(ctx context.Context, t *testing.T, db *implicitTxnDB, data string) {
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	doc, err := client.NewDocFromJSON([]byte(data))
+	require.NoError(t, err)
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+}
+
+func getBackupTestUserNames(ctx context.Context, t *testing.T, db *implicitTxnDB) []string {
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	keysCh, err := col.GetAllDocKeys(ctx)
+	require.NoError(t, err)
+
+	names := []string{}
+	for key := range keysCh {
+		require.NoError(t, key.Err)
+		doc, err := col.Get(ctx, key.Key, false)
+		require.NoError(t, err)
+		name, err := doc.Get("name")
+		require.NoError(t, err)
+		names = append(names, name.(string))
+	}
+	return names
+}
+
+func TestBasicExportTo_WithFormatsAndCompressions_RoundTrips(t *testing.T) {
+	formats := []string{client.BackupFormatJSON, client.BackupFormatNDJSON, client.BackupFormatCBOR}
+	compressions := []string{
+		client.BackupCompressionNone,
+		client.BackupCompressionGzip,
+		client.BackupCompressionZstd,
+	}
+
+	for _, format := range formats {
+		for _, compression := range compressions {
+			t.Run(format+"/"+compression, func(t *testing.T) {
+				ctx := context.Background()
+				source := newBackupTestDB(ctx, t)
+				createBackupTestUser(ctx, t, source, `{"name": "John", "age": 30}`)
+				createBackupTestUser(ctx, t, source, `{"name": "Bob", "age": 40}`)
+
+				var buf bytes.Buffer
+				config := &client.BackupConfig{Format: format, Compression: compression}
+				err := source.BasicExportTo(ctx, config, &buf)
+				require.NoError(t, err)
+
+				target := newBackupTestDB(ctx, t)
+				// The compression is detected from the content.
+				err = target.BasicImportFrom(ctx, &client.BackupConfig{Format: format}, &buf)
+				require.NoError(t, err)
+
+				require.ElementsMatch(t, []string{"John", "Bob"}, getBackupTestUserNames(ctx, t, target))
+			})
+		}
+	}
+}
+
+func TestBasicExportTo_WithChangedSince_ExportsChangedDocuments(t *testing.T) {
+	ctx := context.Background()
+	db := newBackupTestDB(ctx, t)
+	createBackupTestUser(ctx, t, db, `{"name": "John", "age": 30}`)
+
+	since := time.Now()
+	createBackupTestUser(ctx, t, db, `{"name": "Bob", "age": 40}`)
+
+	var buf bytes.Buffer
+	config := &client.BackupConfig{Format: client.BackupFormatNDJSON, ChangedSince: since}
+	err := db.BasicExportTo(ctx, config, &buf)
+	require.NoError(t, err)
+
+	require.Equal(
+		t,
+		`{"collection":"User","doc":{"_key":"bae-b94880d1-e6d2-542f-b9e0-5a369fafd0df",`+
+			`"_newKey":"bae-b94880d1-e6d2-542f-b9e0-5a369fafd0df","age":40,"name":"Bob"}}`+"\n",
+		buf.String(),
+	)
+}
+
+func TestBasicExportTo_WithInvalidFormat_ReturnError(t *testing.T) {
+	ctx := context.Background()
+	db := newBackupTestDB(ctx, t)
+
+	var buf bytes.Buffer
+	err := db.BasicExportTo(ctx, &client.BackupConfig{Format: "xml"}, &buf)
+	require.ErrorIs(t, err, ErrInvalidBackupFormat)
+}
+
+func TestBasicImport_WithCompressedNDJSONFile_NoError(t *testing.T) {
+	ctx := context.Background()
+	db := newBackupTestDB(ctx, t)
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte(`{"collection":"User","doc":{"age":30,"name":"John"}}` + "\n"))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	filepath := t.TempDir() + "/test.ndjson.gz"
+	err = os.WriteFile(filepath, buf.Bytes(), 0664)
+	require.NoError(t, err)
+
+	err = db.BasicImport(ctx, filepath)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"John"}, getBackupTestUserNames(ctx, t, db))
+}
+
+func TestBasicImportFrom_WithBatchSize_CommitsEveryBatch(t *testing.T) {
+	ctx := context.Background()
+	db := newBackupTestDB(ctx, t)
+
+	data := `{"collection":"User","doc":{"age":30,"name":"John"}}` + "\n" +
+		`{"collection":"User","doc":{"age":40,"name":"Bob"}}` + "\n" +
+		`{"collection":"Unknown","doc":{"age":50,"name":"Alice"}}` + "\n"
+	config := &client.BackupConfig{Format: client.BackupFormatNDJSON, BatchSize: 1}
+	err := db.BasicImportFrom(ctx, config, strings.NewReader(data))
+	require.Error(t, err)
+
+	// The batches committed before the failing document are kept.
+	require.ElementsMatch(t, []string{"John", "Bob"}, getBackupTestUserNames(ctx, t, db))
+}
@@ -0,0 +1,68 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package base
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+)
+
+// WritePendingChange records the given change to the change log of the given collection
+// within the given transaction.
+//
+// The change will not be visible in the change log until it has been assigned a sequence
+// number, which may only happen after the transaction has been committed. Keying pending
+// changes by a value unique to the change avoids conflicts between concurrent transactions
+// writing to the same collection.
+func WritePendingChange(
+	ctx context.Context,
+	txn datastore.Txn,
+	collectionID uint32,
+	change client.Change,
+) error {
+	buf, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+
+	id := fmt.Sprintf("%020d-%s", time.Now().UnixNano(), change.Cid)
+	key := core.NewPendingChangeKey(collectionID, id)
+	return txn.Systemstore().Put(ctx, key.ToDS(), buf)
+}
+
+// WriteDocVersion records that the composite block with the given CID has been merged
+// into the given document at the current time, allowing the document to later be read
+// as it was at that time.
+//
+// The version is also indexed by time so that the documents changed since a given time
+// can be found.
+func WriteDocVersion(
+	ctx context.Context,
+	txn datastore.Txn,
+	collectionID uint32,
+	docKey string,
+	cid string,
+) error {
+	now := time.Now().UnixNano()
+	key := core.NewDocVersionKey(collectionID, docKey, now, cid)
+	err := txn.Systemstore().Put(ctx, key.ToDS(), []byte{})
+	if err != nil {
+		return err
+	}
+	timeKey := core.NewDocVersionTimeKey(collectionID, now, docKey, cid)
+	return txn.Systemstore().Put(ctx, timeKey.ToDS(), []byte{})
+}
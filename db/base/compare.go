@@ -12,8 +12,11 @@ package base
 
 import (
 	"bytes"
+	"math/big"
 	"strings"
 	"time"
+
+	"github.com/sourcenetwork/defradb/client"
 )
 
 // Compare compares two values of a Document field, and determines
@@ -47,6 +50,10 @@ func Compare(a, b any) int {
 		return compareString(v, b.(string))
 	case []byte:
 		return compareBytes(v, b.([]byte))
+	case *big.Int:
+		return v.Cmp(b.(*big.Int))
+	case client.Decimal:
+		return v.Cmp(b.(client.Decimal))
 	default:
 		return 0
 	}
@@ -0,0 +1,179 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package base
+
+import (
+	"reflect"
+	"regexp"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// constraintsCache holds the compiled constraints of the fields of each schema version by
+// field name, so that they are decoded and compiled once.
+//
+// The entries of a schema version are evicted with [EvictFieldConstraints] once a patch
+// replaces it.
+var constraintsCache = struct {
+	sync.RWMutex
+	versions map[string]map[string]*compiledConstraints
+}{
+	versions: map[string]map[string]*compiledConstraints{},
+}
+
+// compiledConstraints are the decoded constraints of a field, with their compiled pattern.
+type compiledConstraints struct {
+	client.Constraints
+	encoded client.FieldConstraints
+	pattern *regexp.Regexp
+}
+
+func getCompiledConstraints(
+	schemaVersionID string,
+	field client.FieldDescription,
+) (*compiledConstraints, error) {
+	constraintsCache.RLock()
+	cached, ok := constraintsCache.versions[schemaVersionID][field.Name]
+	constraintsCache.RUnlock()
+	if ok && cached.encoded == field.Constraints {
+		return cached, nil
+	}
+
+	constraints, err := field.Constraints.Decode()
+	if err != nil {
+		return nil, err
+	}
+	compiled := &compiledConstraints{
+		Constraints: constraints,
+		encoded:     field.Constraints,
+	}
+	if constraints.Pattern != "" {
+		compiled.pattern, err = regexp.Compile(constraints.Pattern)
+		if err != nil {
+			return nil, client.NewErrInvalidConstraintPattern(err, constraints.Pattern)
+		}
+	}
+
+	constraintsCache.Lock()
+	defer constraintsCache.Unlock()
+	fields, ok := constraintsCache.versions[schemaVersionID]
+	if !ok {
+		fields = map[string]*compiledConstraints{}
+		constraintsCache.versions[schemaVersionID] = fields
+	}
+	fields[field.Name] = compiled
+	return compiled, nil
+}
+
+// EvictFieldConstraints removes the compiled constraints of the fields of the given schema
+// version from the cache.
+func EvictFieldConstraints(schemaVersionID string) {
+	constraintsCache.Lock()
+	defer constraintsCache.Unlock()
+	delete(constraintsCache.versions, schemaVersionID)
+}
+
+// ValidateFieldConstraints returns an error naming the violated rule if the given value
+// does not satisfy the constraints of the given field of the given schema version.
+//
+// Null values are not validated.
+func ValidateFieldConstraints(schemaVersionID string, field client.FieldDescription, value any) error {
+	if field.Constraints == "" || value == nil {
+		return nil
+	}
+	constraints, err := getCompiledConstraints(schemaVersionID, field)
+	if err != nil {
+		return err
+	}
+	if rule, ok := constraints.check(reflect.ValueOf(value)); !ok {
+		return NewErrConstraintViolation(field.Name, rule)
+	}
+	return nil
+}
+
+// check returns the name of the first rule the given value violates, and false, or true if the
+// value satisfies every rule.
+func (c *compiledConstraints) check(value reflect.Value) (string, bool) {
+	if value.Kind() != reflect.Slice {
+		return c.checkItem(value)
+	}
+	if c.MinItems != nil && value.Len() < *c.MinItems {
+		return client.ConstraintMinItems, false
+	}
+	if c.MaxItems != nil && value.Len() > *c.MaxItems {
+		return client.ConstraintMaxItems, false
+	}
+	for i := 0; i < value.Len(); i++ {
+		if rule, ok := c.checkItem(value.Index(i)); !ok {
+			return rule, false
+		}
+	}
+	return "", true
+}
+
+func (c *compiledConstraints) checkItem(value reflect.Value) (string, bool) {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return "", true
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		s := value.String()
+		length := utf8.RuneCountInString(s)
+		if c.MinLength != nil && length < *c.MinLength {
+			return client.ConstraintMinLength, false
+		}
+		if c.MaxLength != nil && length > *c.MaxLength {
+			return client.ConstraintMaxLength, false
+		}
+		if c.pattern != nil && !c.pattern.MatchString(s) {
+			return client.ConstraintPattern, false
+		}
+		if len(c.OneOf) > 0 && !c.isOneOf(s) {
+			return client.ConstraintOneOf, false
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return c.checkNumber(float64(value.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return c.checkNumber(float64(value.Uint()))
+	case reflect.Float32, reflect.Float64:
+		return c.checkNumber(value.Float())
+	}
+	return "", true
+}
+
+func (c *compiledConstraints) checkNumber(n float64) (string, bool) {
+	if c.Min != nil && n < *c.Min {
+		return client.ConstraintMin, false
+	}
+	if c.Max != nil && n > *c.Max {
+		return client.ConstraintMax, false
+	}
+	if len(c.OneOf) > 0 && !c.isOneOf(n) {
+		return client.ConstraintOneOf, false
+	}
+	return "", true
+}
+
+func (c *compiledConstraints) isOneOf(value any) bool {
+	for _, allowed := range c.OneOf {
+		if allowed == value {
+			return true
+		}
+	}
+	return false
+}
@@ -14,6 +14,17 @@ import (
 	"github.com/sourcenetwork/defradb/errors"
 )
 
+const (
+	errConstraintViolation string = "value violates field constraint"
+)
+
 var (
-	ErrInvalidCrdtType = errors.New("invalid CRDT type")
+	ErrInvalidCrdtType     = errors.New("invalid CRDT type")
+	ErrConstraintViolation = errors.New(errConstraintViolation)
 )
+
+// NewErrConstraintViolation returns a new error indicating a value of the given field violates
+// the given constraint rule.
+func NewErrConstraintViolation(field string, rule string) error {
+	return errors.New(errConstraintViolation, errors.NewKV("Field", field), errors.NewKV("Constraint", rule))
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package base
+
+import (
+	"context"
+	"encoding/json"
+
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+// GetPolicy returns the persisted authorization policy, or an empty policy if none has been set.
+func GetPolicy(ctx context.Context, txn datastore.Txn) (client.Policy, error) {
+	buf, err := txn.Systemstore().Get(ctx, core.NewPolicyKey().ToDS())
+	if errors.Is(err, ds.ErrNotFound) {
+		return client.Policy{}, nil
+	}
+	if err != nil {
+		return client.Policy{}, err
+	}
+
+	var policy client.Policy
+	if err := json.Unmarshal(buf, &policy); err != nil {
+		return client.Policy{}, err
+	}
+	return policy, nil
+}
+
+// GetDocumentOwner returns the identity of the owner of the given document, or an empty
+// string if the document has no owner.
+func GetDocumentOwner(ctx context.Context, txn datastore.Txn, collectionID uint32, docKey string) (string, error) {
+	owner, err := txn.Systemstore().Get(ctx, core.NewDocOwnerKey(collectionID, docKey).ToDS())
+	if errors.Is(err, ds.ErrNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(owner), nil
+}
+
+// WriteDocumentOwner records the given identity as the owner of the given document, unless
+// the document already has an owner.
+//
+// The owner is carried by the composite commit that created the document, so that it is
+// recorded both by the node creating the document and by the nodes it is replicated to.
+func WriteDocumentOwner(
+	ctx context.Context,
+	txn datastore.Txn,
+	collectionID uint32,
+	docKey string,
+	owner string,
+) error {
+	key := core.NewDocOwnerKey(collectionID, docKey).ToDS()
+	exists, err := txn.Systemstore().Has(ctx, key)
+	if err != nil || exists {
+		return err
+	}
+	return txn.Systemstore().Put(ctx, key, []byte(owner))
+}
@@ -0,0 +1,386 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/db/base"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/merkle/clock"
+)
+
+// changeLogSequenceName returns the name of the sequence used to number the
+// change log entries of the given collection.
+func changeLogSequenceName(collectionID uint32) string {
+	return fmt.Sprintf("changelog/%d", collectionID)
+}
+
+// recordChange writes the given change to the pending section of the change log,
+// indexes the new document version by time, and schedules it to be sequenced once
+// the given transaction has been committed.
+func (c *collection) recordChange(ctx context.Context, txn datastore.Txn, change client.Change) error {
+	err := base.WritePendingChange(ctx, txn, c.ID(), change)
+	if err != nil {
+		return err
+	}
+	err = base.WriteDocVersion(ctx, txn, c.ID(), change.DocKey, change.Cid)
+	if err != nil {
+		return err
+	}
+
+	txn.OnSuccess(func() {
+		err := c.db.sequenceChanges(ctx)
+		if err != nil {
+			// The pending changes will be sequenced on the next successful attempt
+			// so there is no need to fail the (already committed) write.
+			log.ErrorE(ctx, "Failed to sequence change log", err)
+		}
+	})
+	return nil
+}
+
+// sequenceChanges assigns sequence numbers to all pending changes, moving them into
+// the change log of their collection, and then removes any changes that fall outside
+// of the configured retention.
+//
+// Sequencing is done in its own transaction whilst holding a lock so that sequence
+// numbers are only ever assigned to committed changes, in commit order.
+func (db *db) sequenceChanges(ctx context.Context) error {
+	db.changeLogMu.Lock()
+	defer db.changeLogMu.Unlock()
+
+	txn, err := db.NewTxn(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+
+	pending, err := queryAll(ctx, txn.Systemstore(), query.Query{
+		Prefix: core.CHANGELOG_PENDING,
+		Orders: []query.Order{query.OrderByKey{}},
+	})
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	firstSeqByCollection := map[uint32]uint64{}
+	lastSeqByCollection := map[uint32]uint64{}
+	for _, res := range pending {
+		key, err := core.NewPendingChangeKeyFromString(res.Key)
+		if err != nil {
+			return err
+		}
+
+		var change client.Change
+		if err := json.Unmarshal(res.Value, &change); err != nil {
+			return err
+		}
+
+		seq, err := db.getSequence(ctx, txn, changeLogSequenceName(key.CollectionID))
+		if err != nil {
+			return err
+		}
+		change.Seq, err = seq.next(ctx, txn)
+		if err != nil {
+			return err
+		}
+
+		buf, err := json.Marshal(change)
+		if err != nil {
+			return err
+		}
+		err = txn.Systemstore().Put(ctx, core.NewChangeLogKey(key.CollectionID, change.Seq).ToDS(), buf)
+		if err != nil {
+			return err
+		}
+		err = txn.Systemstore().Delete(ctx, key.ToDS())
+		if err != nil {
+			return err
+		}
+		if _, ok := firstSeqByCollection[key.CollectionID]; !ok {
+			firstSeqByCollection[key.CollectionID] = change.Seq
+		}
+		lastSeqByCollection[key.CollectionID] = change.Seq
+	}
+
+	if db.changeLogRetention.HasValue() {
+		for collectionID, lastSeq := range lastSeqByCollection {
+			err := db.trimChangeLog(ctx, txn, collectionID, firstSeqByCollection[collectionID], lastSeq)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return txn.Commit(ctx)
+}
+
+// trimChangeLog removes the changes of the given collection that fell outside of the
+// configured retention when the changes from firstSeq to lastSeq were sequenced.
+//
+// As the change log is trimmed every time new changes are sequenced, only the range of
+// sequence numbers pushed out by the new changes needs to be removed.
+func (db *db) trimChangeLog(
+	ctx context.Context,
+	txn datastore.Txn,
+	collectionID uint32,
+	firstSeq uint64,
+	lastSeq uint64,
+) error {
+	retention := db.changeLogRetention.Value()
+	if retention == 0 || lastSeq <= retention {
+		return nil
+	}
+
+	start := uint64(1)
+	if firstSeq > retention {
+		start = firstSeq - retention
+	}
+	end := lastSeq - retention
+	for seq := start; seq <= end; seq++ {
+		err := txn.Systemstore().Delete(ctx, core.NewChangeLogKey(collectionID, seq).ToDS())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// backfillDocVersions records the current heads of all documents as versions at time zero,
+// if this has not already been done.
+//
+// Documents created before versions were recorded by time would otherwise not exist at any
+// point in time, so they are instead treated as having always been in their current state.
+func (db *db) backfillDocVersions(ctx context.Context, txn datastore.Txn) error {
+	exists, err := txn.Systemstore().Has(ctx, ds.NewKey(core.DOC_VERSION_BACKFILLED))
+	if err != nil || exists {
+		return err
+	}
+
+	cols, err := db.getAllCollections(ctx, txn)
+	if err != nil {
+		return err
+	}
+	for _, col := range cols {
+		prefix := core.PrimaryDataStoreKey{CollectionId: fmt.Sprint(col.ID())}
+		results, err := queryAll(ctx, txn.Datastore(), query.Query{
+			Prefix:   prefix.ToString() + "/",
+			KeysOnly: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, res := range results {
+			key, err := core.NewDataStoreKey(res.Key)
+			if err != nil {
+				return err
+			}
+			headKey := core.DataStoreKey{
+				CollectionID: fmt.Sprint(col.ID()),
+				DocKey:       key.DocKey,
+				FieldId:      core.COMPOSITE_NAMESPACE,
+			}
+			heads, _, err := clock.NewHeadSet(txn.Headstore(), headKey.ToHeadStoreKey()).List(ctx)
+			if err != nil {
+				return err
+			}
+			for _, head := range heads {
+				versionKey := core.NewDocVersionKey(col.ID(), key.DocKey, 0, head.String())
+				err = txn.Systemstore().Put(ctx, versionKey.ToDS(), []byte{})
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return txn.Systemstore().Put(ctx, ds.NewKey(core.DOC_VERSION_BACKFILLED), []byte{1})
+}
+
+// backfillDocVersionTimes indexes by time the document versions recorded before they were
+// indexed by time, if this has not already been done.
+//
+// The versions recorded at time zero are not indexed as they predate any given time.
+func (db *db) backfillDocVersionTimes(ctx context.Context, txn datastore.Txn) error {
+	exists, err := txn.Systemstore().Has(ctx, ds.NewKey(core.DOC_VERSION_TIME_BACKFILLED))
+	if err != nil || exists {
+		return err
+	}
+
+	results, err := queryAll(ctx, txn.Systemstore(), query.Query{
+		Prefix:   core.DOC_VERSION + "/",
+		KeysOnly: true,
+	})
+	if err != nil {
+		return err
+	}
+	for _, res := range results {
+		key, err := core.NewDocVersionKeyFromString(res.Key)
+		if err != nil {
+			return err
+		}
+		if key.Time == 0 {
+			continue
+		}
+		timeKey := core.NewDocVersionTimeKey(key.CollectionID, key.Time, key.DocKey, key.Cid)
+		err = txn.Systemstore().Put(ctx, timeKey.ToDS(), []byte{})
+		if err != nil {
+			return err
+		}
+	}
+
+	return txn.Systemstore().Put(ctx, ds.NewKey(core.DOC_VERSION_TIME_BACKFILLED), []byte{1})
+}
+
+// changesSince returns at most limit sequenced changes of the given collection with a
+// sequence number greater than the given cursor, skipping the changes to documents the
+// caller may not read.
+//
+// Sequence numbers are assigned without gaps and the log is only ever trimmed from its
+// oldest end, so the changes are read one by one from the cursor instead of reading the
+// whole log.
+func (db *db) changesSince(
+	ctx context.Context,
+	txn datastore.Txn,
+	collectionName string,
+	cursor uint64,
+	limit uint64,
+) (client.ChangesResult, error) {
+	err := client.CheckPermission(ctx, client.ReadPermission, collectionName)
+	if err != nil {
+		return client.ChangesResult{}, err
+	}
+	c, err := db.getCollectionByName(ctx, txn, collectionName)
+	if err != nil {
+		return client.ChangesResult{}, err
+	}
+	col := c.(*collection)
+
+	lastSeq, err := db.lastChangeSeq(ctx, txn, col.ID())
+	if err != nil {
+		return client.ChangesResult{}, err
+	}
+
+	result := client.ChangesResult{
+		Changes: []client.Change{},
+		Cursor:  cursor,
+	}
+	for seq := cursor + 1; seq <= lastSeq; seq++ {
+		if limit != 0 && uint64(len(result.Changes)) >= limit {
+			break
+		}
+
+		buf, err := txn.Systemstore().Get(ctx, core.NewChangeLogKey(col.ID(), seq).ToDS())
+		if errors.Is(err, ds.ErrNotFound) {
+			// The change has been trimmed, resume from the oldest retained change, or skip the
+			// sequence number if it is newer than that.
+			var first uint64
+			first, err = db.firstChangeSeq(ctx, txn, col.ID())
+			if err != nil {
+				return client.ChangesResult{}, err
+			}
+			if first <= seq {
+				continue
+			}
+			seq = first
+			buf, err = txn.Systemstore().Get(ctx, core.NewChangeLogKey(col.ID(), seq).ToDS())
+		}
+		if err != nil {
+			return client.ChangesResult{}, err
+		}
+
+		var change client.Change
+		if err := json.Unmarshal(buf, &change); err != nil {
+			return client.ChangesResult{}, err
+		}
+		result.Cursor = seq
+
+		err = col.checkDocumentPermission(ctx, txn, client.ReadPermission, change.DocKey)
+		if errors.Is(err, client.ErrPermissionDenied) {
+			continue
+		}
+		if err != nil {
+			return client.ChangesResult{}, err
+		}
+		result.Changes = append(result.Changes, change)
+	}
+
+	return result, nil
+}
+
+// lastChangeSeq returns the sequence number of the last change sequenced within the change
+// log of the given collection, or zero if there is none.
+func (db *db) lastChangeSeq(ctx context.Context, txn datastore.Txn, collectionID uint32) (uint64, error) {
+	seq := &sequence{key: core.NewSequenceKey(changeLogSequenceName(collectionID))}
+	val, err := seq.get(ctx, txn)
+	if errors.Is(err, ds.ErrNotFound) {
+		return 0, nil
+	}
+	return val, err
+}
+
+// firstChangeSeq returns the sequence number of the oldest change retained within the change
+// log of the given collection, or zero if there is none.
+func (db *db) firstChangeSeq(ctx context.Context, txn datastore.Txn, collectionID uint32) (uint64, error) {
+	results, err := queryAll(ctx, txn.Systemstore(), query.Query{
+		Prefix:   core.NewChangeLogKey(collectionID, 0).ToString() + "/",
+		Orders:   []query.Order{query.OrderByKey{}},
+		Limit:    1,
+		KeysOnly: true,
+	})
+	if err != nil || len(results) == 0 {
+		return 0, err
+	}
+	key, err := core.NewChangeLogKeyFromString(results[0].Key)
+	if err != nil {
+		return 0, err
+	}
+	return key.Seq, nil
+}
+
+// queryAll executes the given query against the given store, returning all the results.
+//
+// The results are fully read before returning so that the caller may safely write to the
+// store whilst processing them.
+func queryAll(
+	ctx context.Context,
+	store datastore.DSReaderWriter,
+	q query.Query,
+) ([]query.Entry, error) {
+	results, err := store.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := results.Rest()
+	if err != nil {
+		if closeErr := results.Close(); closeErr != nil {
+			return nil, errors.Wrap(err.Error(), closeErr)
+		}
+		return nil, err
+	}
+
+	return entries, results.Close()
+}
@@ -0,0 +1,307 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+)
+
+func newChangeLogTestCollection(ctx context.Context, t *testing.T, options ...Option) (*implicitTxnDB, client.Collection) {
+	db, err := newMemoryDB(ctx, options...)
+	require.NoError(t, err)
+	return db, newUserTestCollection(ctx, t, db)
+}
+
+func TestChangesSince_WithCreateUpdateAndDelete_ReturnsChangesInOrder(t *testing.T) {
+	ctx := context.Background()
+	db, col := newChangeLogTestCollection(ctx, t)
+	defer db.Close()
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John", "age": 30}`))
+	require.NoError(t, err)
+
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	err = doc.Set("age", 31)
+	require.NoError(t, err)
+
+	err = col.Update(ctx, doc)
+	require.NoError(t, err)
+
+	_, err = col.Delete(ctx, doc.Key())
+	require.NoError(t, err)
+
+	res, err := db.ChangesSince(ctx, "User", 0, 0)
+	require.NoError(t, err)
+	changes := res.Changes
+	require.Len(t, changes, 3)
+
+	for i, change := range changes {
+		require.Equal(t, uint64(i+1), change.Seq)
+		require.Equal(t, uint64(i+1), change.Height)
+		require.Equal(t, doc.Key().String(), change.DocKey)
+		require.Equal(t, col.Schema().VersionID, change.SchemaVersionID)
+		require.NotEmpty(t, change.Cid)
+	}
+	require.False(t, changes[0].Deleted)
+	require.False(t, changes[1].Deleted)
+	require.True(t, changes[2].Deleted)
+}
+
+func TestChangesSince_WithCursor_ReturnsChangesAfterCursor(t *testing.T) {
+	ctx := context.Background()
+	db, col := newChangeLogTestCollection(ctx, t)
+	defer db.Close()
+
+	for _, data := range []string{`{"name": "John"}`, `{"name": "Bob"}`, `{"name": "Fred"}`} {
+		doc, err := client.NewDocFromJSON([]byte(data))
+		require.NoError(t, err)
+
+		err = col.Create(ctx, doc)
+		require.NoError(t, err)
+	}
+
+	res, err := db.ChangesSince(ctx, "User", 2, 0)
+	require.NoError(t, err)
+	changes := res.Changes
+	require.Len(t, changes, 1)
+	require.Equal(t, uint64(3), changes[0].Seq)
+
+	res, err = db.ChangesSince(ctx, "User", 3, 0)
+	require.NoError(t, err)
+	changes = res.Changes
+	require.Len(t, changes, 0)
+}
+
+func TestChangesSince_WithLimit_ReturnsPagesOfChanges(t *testing.T) {
+	ctx := context.Background()
+	db, col := newChangeLogTestCollection(ctx, t)
+	defer db.Close()
+
+	for _, data := range []string{`{"name": "John"}`, `{"name": "Bob"}`, `{"name": "Fred"}`} {
+		doc, err := client.NewDocFromJSON([]byte(data))
+		require.NoError(t, err)
+
+		err = col.Create(ctx, doc)
+		require.NoError(t, err)
+	}
+
+	res, err := db.ChangesSince(ctx, "User", 0, 2)
+	require.NoError(t, err)
+	require.Len(t, res.Changes, 2)
+	require.Equal(t, uint64(1), res.Changes[0].Seq)
+	require.Equal(t, uint64(2), res.Changes[1].Seq)
+	require.Equal(t, uint64(2), res.Cursor)
+
+	res, err = db.ChangesSince(ctx, "User", res.Cursor, 2)
+	require.NoError(t, err)
+	require.Len(t, res.Changes, 1)
+	require.Equal(t, uint64(3), res.Changes[0].Seq)
+	require.Equal(t, uint64(3), res.Cursor)
+
+	res, err = db.ChangesSince(ctx, "User", res.Cursor, 2)
+	require.NoError(t, err)
+	require.Len(t, res.Changes, 0)
+	require.Equal(t, uint64(3), res.Cursor)
+}
+
+func TestChangesSince_WithOwnerReadDocumentPolicy_SkipsChangesOfOtherOwners(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+	newUserTestCollection(ctx, t, db)
+
+	policy := client.Policy{
+		Roles: []client.Role{
+			{Name: "admin", Permissions: []client.Permission{client.AdminPermission}},
+			{Name: "writer", Permissions: []client.Permission{client.WritePermission}},
+		},
+		Members: map[string][]string{
+			"alice": {"admin"},
+			"bob":   {"writer"},
+			"carol": {"writer"},
+		},
+		Documents: []client.DocumentPolicy{{Collection: "User", OwnerRead: true}},
+	}
+	err = db.SetPolicy(ctx, policy)
+	require.NoError(t, err)
+
+	bobCtx := client.WithAuthorization(ctx, "bob", policy)
+	carolCtx := client.WithAuthorization(ctx, "carol", policy)
+
+	col, err := db.GetCollectionByName(bobCtx, "User")
+	require.NoError(t, err)
+
+	bobDoc, err := client.NewDocFromJSON([]byte(`{"name": "Bob"}`))
+	require.NoError(t, err)
+	err = col.Create(bobCtx, bobDoc)
+	require.NoError(t, err)
+
+	carolDoc, err := client.NewDocFromJSON([]byte(`{"name": "Carol"}`))
+	require.NoError(t, err)
+	err = col.Create(carolCtx, carolDoc)
+	require.NoError(t, err)
+
+	res, err := db.ChangesSince(carolCtx, "User", 0, 1)
+	require.NoError(t, err)
+	require.Len(t, res.Changes, 1)
+	require.Equal(t, carolDoc.Key().String(), res.Changes[0].DocKey)
+	require.Equal(t, uint64(2), res.Cursor)
+
+	res, err = db.ChangesSince(bobCtx, "User", 0, 0)
+	require.NoError(t, err)
+	require.Len(t, res.Changes, 1)
+	require.Equal(t, bobDoc.Key().String(), res.Changes[0].DocKey)
+	require.Equal(t, uint64(2), res.Cursor)
+}
+
+func TestChangesSince_WithRetention_RemovesOldestChanges(t *testing.T) {
+	ctx := context.Background()
+	db, col := newChangeLogTestCollection(ctx, t, WithChangeLogRetention(2))
+	defer db.Close()
+
+	for _, data := range []string{`{"name": "John"}`, `{"name": "Bob"}`, `{"name": "Fred"}`} {
+		doc, err := client.NewDocFromJSON([]byte(data))
+		require.NoError(t, err)
+
+		err = col.Create(ctx, doc)
+		require.NoError(t, err)
+	}
+
+	res, err := db.ChangesSince(ctx, "User", 0, 0)
+	require.NoError(t, err)
+	changes := res.Changes
+	require.Len(t, changes, 2)
+	require.Equal(t, uint64(2), changes[0].Seq)
+	require.Equal(t, uint64(3), changes[1].Seq)
+}
+
+func TestChangesSince_WithUncommittedTxn_DoesNotReturnChange(t *testing.T) {
+	ctx := context.Background()
+	db, col := newChangeLogTestCollection(ctx, t)
+	defer db.Close()
+
+	txn, err := db.NewTxn(ctx, false)
+	require.NoError(t, err)
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John"}`))
+	require.NoError(t, err)
+
+	err = col.WithTxn(txn).Create(ctx, doc)
+	require.NoError(t, err)
+
+	res, err := db.ChangesSince(ctx, "User", 0, 0)
+	require.NoError(t, err)
+	changes := res.Changes
+	require.Len(t, changes, 0)
+
+	err = txn.Commit(ctx)
+	require.NoError(t, err)
+
+	res, err = db.ChangesSince(ctx, "User", 0, 0)
+	require.NoError(t, err)
+	changes = res.Changes
+	require.Len(t, changes, 1)
+	require.Equal(t, uint64(1), changes[0].Seq)
+}
+
+func TestChangesSince_WithUnknownCollection_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	db, _ := newChangeLogTestCollection(ctx, t)
+	defer db.Close()
+
+	_, err := db.ChangesSince(ctx, "Unknown", 0, 0)
+	require.Error(t, err)
+}
+
+func TestBackfillDocVersions_WithDocumentPredatingIndex_ReadsDocumentAtAnyTime(t *testing.T) {
+	ctx := context.Background()
+	db, col := newChangeLogTestCollection(ctx, t)
+	defer db.Close()
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John", "age": 30}`))
+	require.NoError(t, err)
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	// Remove the recorded versions to mimic a document created before versions were
+	// recorded by time.
+	txn, err := db.NewTxn(ctx, false)
+	require.NoError(t, err)
+	versions, err := queryAll(ctx, txn.Systemstore(), query.Query{
+		Prefix:   core.DOC_VERSION + "/",
+		KeysOnly: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	err = txn.Systemstore().Delete(ctx, ds.NewKey(versions[0].Key))
+	require.NoError(t, err)
+	err = txn.Systemstore().Delete(ctx, ds.NewKey(core.DOC_VERSION_BACKFILLED))
+	require.NoError(t, err)
+
+	err = db.backfillDocVersions(ctx, txn)
+	require.NoError(t, err)
+	err = txn.Commit(ctx)
+	require.NoError(t, err)
+
+	result := db.ExecRequest(ctx, `query { User(asOf: {time: "2000-01-01T00:00:00Z"}) { name age } }`)
+	require.Empty(t, result.GQL.Errors)
+	require.Equal(t, []map[string]any{{"name": "John", "age": int64(30)}}, result.GQL.Data)
+}
+
+func TestBackfillDocVersionTimes_WithVersionPredatingIndex_FindsChangedDocument(t *testing.T) {
+	ctx := context.Background()
+	db, col := newChangeLogTestCollection(ctx, t)
+	defer db.Close()
+
+	since := time.Now()
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John", "age": 30}`))
+	require.NoError(t, err)
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	// Remove the time index to mimic a version recorded before versions were indexed by time.
+	txn, err := db.NewTxn(ctx, false)
+	require.NoError(t, err)
+	versions, err := queryAll(ctx, txn.Systemstore(), query.Query{
+		Prefix:   core.DOC_VERSION_TIME + "/",
+		KeysOnly: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	err = txn.Systemstore().Delete(ctx, ds.NewKey(versions[0].Key))
+	require.NoError(t, err)
+	err = txn.Systemstore().Delete(ctx, ds.NewKey(core.DOC_VERSION_TIME_BACKFILLED))
+	require.NoError(t, err)
+
+	changed, err := changedDocKeys(ctx, txn, col.ID(), since)
+	require.NoError(t, err)
+	require.Empty(t, changed)
+
+	err = db.backfillDocVersionTimes(ctx, txn)
+	require.NoError(t, err)
+
+	changed, err = changedDocKeys(ctx, txn, col.ID(), since)
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{doc.Key().String(): {}}, changed)
+	txn.Discard(ctx)
+}
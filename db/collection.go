@@ -167,6 +167,11 @@ func (db *db) updateSchema(
 		return nil
 	}
 
+	err = db.validateExistingDocConstraints(ctx, txn, existingSchemaByName[schema.Name], schema)
+	if err != nil {
+		return err
+	}
+
 	for _, field := range schema.Fields {
 		if field.RelationType.IsSet(client.Relation_Type_ONE) {
 			idFieldName := field.Name + "_id"
@@ -176,6 +181,7 @@ func (db *db) updateSchema(
 					Kind:         client.FieldKind_DocKey,
 					RelationType: client.Relation_Type_INTERNAL_ID,
 					RelationName: field.RelationName,
+					IsRequired:   field.IsRequired,
 				})
 			}
 		}
@@ -196,6 +202,11 @@ func (db *db) updateSchema(
 	}
 
 	if setAsDefaultVersion {
+		err = db.validateNewRequiredFields(ctx, txn, existingSchemaByName[schema.Name], schema)
+		if err != nil {
+			return err
+		}
+
 		cols, err := description.GetCollectionsBySchemaVersionID(ctx, txn, previousVersionID)
 		if err != nil {
 			return err
@@ -214,6 +225,11 @@ func (db *db) updateSchema(
 				return err
 			}
 		}
+
+		// The compiled constraints of the replaced version are no longer needed.
+		txn.OnSuccess(func() {
+			base.EvictFieldConstraints(previousVersionID)
+		})
 	}
 
 	return nil
@@ -258,12 +274,17 @@ func (db *db) validateUpdateSchema(
 		return false, ErrCannotSetVersionID
 	}
 
+	hasChangedEnums, err := validateUpdateSchemaEnums(existingDesc, proposedDesc)
+	if err != nil {
+		return false, err
+	}
+
 	hasChangedFields, err := validateUpdateSchemaFields(proposedDescriptionsByName, existingDesc, proposedDesc)
 	if err != nil {
 		return hasChangedFields, err
 	}
 
-	return hasChangedFields, err
+	return hasChangedFields || hasChangedEnums, err
 }
 
 func validateUpdateSchemaFields(
@@ -428,9 +449,13 @@ func validateUpdateSchemaFields(
 			return false, NewErrDuplicateField(proposedField.Name)
 		}
 
-		if fieldAlreadyExists && proposedField != existingField {
+		// Constraints may be changed, the existing documents are then validated against them.
+		comparableField := proposedField
+		comparableField.Constraints = existingField.Constraints
+		if fieldAlreadyExists && comparableField != existingField {
 			return false, NewErrCannotMutateField(proposedField.ID, proposedField.Name)
 		}
+		hasChanged = hasChanged || proposedField.Constraints != existingField.Constraints
 
 		if existingIndex := existingFieldIndexesByName[proposedField.Name]; fieldAlreadyExists &&
 			proposedIndex != existingIndex {
@@ -441,6 +466,28 @@ func validateUpdateSchemaFields(
 			return false, NewErrInvalidCRDTType(proposedField.Name, proposedField.Typ)
 		}
 
+		if proposedField.IsEncrypted && proposedField.RelationType != 0 {
+			return false, NewErrEncryptedRelationField(proposedField.Name)
+		}
+
+		if proposedField.DefaultValue != "" {
+			if _, err := fieldDefaultValue(proposedField); err != nil {
+				return false, err
+			}
+		}
+
+		if err := validateEnumField(proposedDesc, proposedField); err != nil {
+			return false, err
+		}
+
+		constraints, err := proposedField.Constraints.Decode()
+		if err != nil {
+			return false, err
+		}
+		if err := constraints.Validate(proposedField.Kind); err != nil {
+			return false, err
+		}
+
 		newFieldNames[proposedField.Name] = struct{}{}
 		newFieldIds[proposedField.ID] = struct{}{}
 	}
@@ -472,6 +519,20 @@ func (db *db) setDefaultSchemaVersion(
 		return err
 	}
 
+	for _, col := range colDescs {
+		if col.SchemaVersionID == schemaVersionID {
+			continue
+		}
+		previous, err := description.GetSchemaVersion(ctx, txn, col.SchemaVersionID)
+		if err != nil {
+			return err
+		}
+		err = db.validateNewRequiredFields(ctx, txn, previous, schema)
+		if err != nil {
+			return err
+		}
+	}
+
 	for _, col := range colDescs {
 		col.SchemaVersionID = schemaVersionID
 		col, err = description.SaveCollection(ctx, txn, col)
@@ -692,6 +753,17 @@ func (c *collection) getAllDocKeysChan(
 				}
 				return
 			}
+			// Documents that can not be read by the caller are skipped.
+			err = c.checkDocumentPermission(ctx, txn, client.ReadPermission, key.String())
+			if errors.Is(err, client.ErrPermissionDenied) {
+				continue
+			}
+			if err != nil {
+				resCh <- client.DocKeysResult{
+					Err: err,
+				}
+				return
+			}
 			resCh <- client.DocKeysResult{
 				Key: key,
 			}
@@ -802,6 +874,13 @@ func (c *collection) create(ctx context.Context, txn datastore.Txn, doc *client.
 		return err
 	}
 
+	// Defaults are set after dockey verification as the dockey is generated from the values
+	// provided by the client.
+	err = c.setDefaultValues(doc)
+	if err != nil {
+		return err
+	}
+
 	// check if doc already exists
 	exists, isDeleted, err := c.exists(ctx, txn, primaryKey)
 	if err != nil {
@@ -829,6 +908,13 @@ func (c *collection) create(ctx context.Context, txn datastore.Txn, doc *client.
 		return err
 	}
 
+	if owner, ok := client.IdentityFromContext(ctx); ok && owner != "" {
+		err = base.WriteDocumentOwner(ctx, txn, c.ID(), dockey.String(), owner)
+		if err != nil {
+			return err
+		}
+	}
+
 	return c.indexNewDoc(ctx, txn, doc)
 }
 
@@ -913,8 +999,32 @@ func (c *collection) save(
 	doc *client.Document,
 	isCreate bool,
 ) (cid.Cid, error) {
+	return c.saveWithRestore(ctx, txn, doc, isCreate, false)
+}
+
+// saveWithRestore saves the document, undeleting it if isRestore is true.
+func (c *collection) saveWithRestore(
+	ctx context.Context,
+	txn datastore.Txn,
+	doc *client.Document,
+	isCreate bool,
+	isRestore bool,
+) (cid.Cid, error) {
+	err := c.validateRequiredFields(doc, isCreate)
+	if err != nil {
+		return cid.Undef, err
+	}
+	err = c.validateFieldValues(doc)
+	if err != nil {
+		return cid.Undef, err
+	}
+
 	if !isCreate {
-		err := c.updateIndexedDoc(ctx, txn, doc)
+		err := c.checkDocumentPermission(ctx, txn, client.WritePermission, doc.Key().String())
+		if err != nil {
+			return cid.Undef, err
+		}
+		err = c.updateIndexedDoc(ctx, txn, doc, isRestore)
 		if err != nil {
 			return cid.Undef, err
 		}
@@ -973,6 +1083,34 @@ func (c *collection) save(
 				return cid.Undef, err
 			}
 
+			if fieldDescription.Kind == client.FieldKind_JSON && !val.IsDelete() {
+				val, err = canonicalJSONValue(fieldDescription, val)
+				if err != nil {
+					return cid.Undef, err
+				}
+			}
+
+			if isExactNumberKind(fieldDescription.Kind) && !val.IsDelete() {
+				val, err = exactNumberValue(fieldDescription, val)
+				if err != nil {
+					return cid.Undef, err
+				}
+			}
+
+			if fieldDescription.Kind == client.FieldKind_GEOPOINT && !val.IsDelete() {
+				val, err = geoPointValue(fieldDescription, val)
+				if err != nil {
+					return cid.Undef, err
+				}
+			}
+
+			if fieldDescription.IsEncrypted && !val.IsDelete() {
+				val, err = c.encryptFieldValue(ctx, txn, val)
+				if err != nil {
+					return cid.Undef, err
+				}
+			}
+
 			node, _, err := c.saveFieldToMerkleCRDT(ctx, txn, fieldKey, val)
 			if err != nil {
 				return cid.Undef, err
@@ -1007,11 +1145,22 @@ func (c *collection) save(
 		buf,
 		links,
 		client.Active,
+		isRestore,
 	)
 	if err != nil {
 		return cid.Undef, err
 	}
 
+	err = c.recordChange(ctx, txn, client.Change{
+		DocKey:          doc.Key().String(),
+		Cid:             headNode.Cid().String(),
+		SchemaVersionID: c.Schema().VersionID,
+		Height:          priority,
+	})
+	if err != nil {
+		return cid.Undef, err
+	}
+
 	if c.db.events.Updates.HasValue() {
 		txn.OnSuccess(
 			func() {
@@ -1213,6 +1362,7 @@ func (c *collection) saveFieldToMerkleCRDT(
 			return nil, 0, client.NewErrFieldIndexNotExist(fieldID)
 		}
 
+		ctx = c.db.withSigningKey(ctx)
 		merkleCRDT := merklecrdt.NewMerkleLWWRegister(
 			txn,
 			core.NewCollectionSchemaVersionKey(schema.VersionID, c.ID()),
@@ -1233,7 +1383,9 @@ func (c *collection) saveCompositeToMerkleCRDT(
 	buf []byte,
 	links []core.DAGLink,
 	status client.DocumentStatus,
+	isRestore bool,
 ) (ipld.Node, uint64, error) {
+	ctx = c.db.withSigningKey(ctx)
 	key = key.WithFieldId(core.COMPOSITE_NAMESPACE)
 	merkleCRDT := merklecrdt.NewMerkleCompositeDAG(
 		txn,
@@ -1245,6 +1397,9 @@ func (c *collection) saveCompositeToMerkleCRDT(
 	if status.IsDeleted() {
 		return merkleCRDT.Delete(ctx, links)
 	}
+	if isRestore {
+		return merkleCRDT.Restore(ctx, buf, links)
+	}
 
 	return merkleCRDT.Set(ctx, buf, links)
 }
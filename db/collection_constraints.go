@@ -0,0 +1,100 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/db/base"
+	"github.com/sourcenetwork/defradb/db/description"
+)
+
+// validateFieldValues returns an error if a changed value of the given document is not a value
+// of its enum or violates the constraints of its field.
+//
+// The whole document is validated before any of its values is written.
+func (c *collection) validateFieldValues(doc *client.Document) error {
+	for name, field := range doc.Fields() {
+		val, err := doc.GetValueWithField(field)
+		if err != nil {
+			return err
+		}
+		if !val.IsDirty() || val.IsDelete() {
+			continue
+		}
+		fieldDescription, valid := c.Schema().GetField(name)
+		if !valid {
+			return client.NewErrFieldNotExist(name)
+		}
+		err = c.validateEnumValue(fieldDescription, val.Value())
+		if err != nil {
+			return err
+		}
+		err = base.ValidateFieldConstraints(c.Schema().VersionID, fieldDescription, val.Value())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateExistingDocConstraints returns an error if a document of the collections at the
+// previous schema version violates a constraint changed by the next schema version.
+func (db *db) validateExistingDocConstraints(
+	ctx context.Context,
+	txn datastore.Txn,
+	previous client.SchemaDescription,
+	next client.SchemaDescription,
+) error {
+	previousFields := []client.FieldDescription{}
+	nextFields := []client.FieldDescription{}
+	for _, field := range next.Fields {
+		previousField, exists := previous.GetField(field.Name)
+		if !exists || field.Constraints == "" || field.Constraints == previousField.Constraints {
+			continue
+		}
+		previousFields = append(previousFields, previousField)
+		nextFields = append(nextFields, field)
+	}
+	if len(nextFields) == 0 {
+		return nil
+	}
+
+	cols, err := description.GetCollectionsBySchemaVersionID(ctx, txn, previous.VersionID)
+	if err != nil {
+		return err
+	}
+	for _, col := range cols {
+		err := db.newCollection(col, previous).iterateAllDocs(
+			ctx,
+			txn,
+			previousFields,
+			func(doc *client.Document) error {
+				for _, field := range nextFields {
+					val, err := doc.GetValue(field.Name)
+					if err != nil {
+						continue
+					}
+					if err := base.ValidateFieldConstraints(next.VersionID, field, val.Value()); err != nil {
+						return NewErrExistingDocConstraintViolation(err, doc.Key().String())
+					}
+				}
+				return nil
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+
+	"github.com/valyala/fastjson"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+// setDefaultValues sets the default value of every field of the collection that has one
+// and is not held by the given document.
+func (c *collection) setDefaultValues(doc *client.Document) error {
+	for _, field := range c.Schema().Fields {
+		if field.DefaultValue == "" {
+			continue
+		}
+		if _, err := doc.GetValue(field.Name); err == nil {
+			continue
+		}
+		value, err := fieldDefaultValue(field)
+		if err != nil {
+			return err
+		}
+		err = doc.SetAs(field.Name, value, field.Typ)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fieldDefaultValue returns the default value of the given field, converted to the kind of the field.
+func fieldDefaultValue(field client.FieldDescription) (any, error) {
+	if field.IsObject() {
+		return nil, NewErrDefaultRelationField(field.Name)
+	}
+	val, err := fastjson.Parse(string(field.DefaultValue))
+	if err != nil {
+		return nil, NewErrInvalidDefaultValue(err, field.Name)
+	}
+	value, err := validateFieldSchema(val, field)
+	if err != nil {
+		return nil, NewErrInvalidDefaultValue(err, field.Name)
+	}
+	if err := validateDateTimes(field, value); err != nil {
+		return nil, NewErrInvalidDefaultValue(err, field.Name)
+	}
+	return value, nil
+}
+
+// validateRequiredFields returns an error if a required field of the collection has no value
+// within the given document.
+//
+// On create every required field must hold a value, on update only the changed values are
+// validated.
+func (c *collection) validateRequiredFields(doc *client.Document, isCreate bool) error {
+	for _, field := range c.Schema().Fields {
+		if !field.IsRequired || field.IsObject() {
+			continue
+		}
+		val, err := doc.GetValue(field.Name)
+		if err != nil {
+			if !errors.Is(err, client.ErrFieldNotExist) {
+				return err
+			}
+			if isCreate {
+				return NewErrRequiredFieldMissing(field.Name)
+			}
+			continue
+		}
+		if (isCreate || val.IsDirty()) && (val.IsDelete() || val.Value() == nil) {
+			return NewErrRequiredFieldMissing(field.Name)
+		}
+	}
+	return nil
+}
+
+// validateNewRequiredFields returns an error if the next schema version adds a required field
+// without a default value to the previous one, unless a migration between both versions is
+// registered to set the value of the existing documents.
+func (db *db) validateNewRequiredFields(
+	ctx context.Context,
+	txn datastore.Txn,
+	previous client.SchemaDescription,
+	next client.SchemaDescription,
+) error {
+	var missing string
+	for _, field := range next.Fields {
+		if !field.IsRequired || field.DefaultValue != "" {
+			continue
+		}
+		if _, exists := previous.GetField(field.Name); !exists {
+			missing = field.Name
+			break
+		}
+	}
+	if missing == "" {
+		return nil
+	}
+
+	configs, err := db.lensRegistry.WithTxn(txn).Config(ctx)
+	if err != nil {
+		return err
+	}
+	for _, config := range configs {
+		if (config.SourceSchemaVersionID == previous.VersionID &&
+			config.DestinationSchemaVersionID == next.VersionID) ||
+			(config.SourceSchemaVersionID == next.VersionID &&
+				config.DestinationSchemaVersionID == previous.VersionID) {
+			return nil
+		}
+	}
+	return NewErrRequiredFieldWithoutDefault(missing, next.VersionID)
+}
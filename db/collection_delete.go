@@ -241,6 +241,10 @@ func (c *collection) applyDelete(
 	if isDeleted {
 		return NewErrDocumentDeleted(key.DocKey)
 	}
+	err = c.checkDocumentPermission(ctx, txn, client.WritePermission, key.DocKey)
+	if err != nil {
+		return err
+	}
 
 	dsKey := key.ToDataStoreKey()
 
@@ -268,11 +272,23 @@ func (c *collection) applyDelete(
 		[]byte{},
 		dagLinks,
 		client.Deleted,
+		false,
 	)
 	if err != nil {
 		return err
 	}
 
+	err = c.recordChange(ctx, txn, client.Change{
+		DocKey:          key.DocKey,
+		Cid:             headNode.Cid().String(),
+		SchemaVersionID: c.Schema().VersionID,
+		Height:          priority,
+		Deleted:         true,
+	})
+	if err != nil {
+		return err
+	}
+
 	if c.db.events.Updates.HasValue() {
 		txn.OnSuccess(
 			func() {
@@ -0,0 +1,216 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	dag "github.com/ipfs/boxo/ipld/merkledag"
+	"github.com/ipfs/go-cid"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/core/crdt"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/db/fetcher"
+	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/merkle/clock"
+)
+
+func (c *collection) Diff(
+	ctx context.Context,
+	key client.DocKey,
+	fromCID string,
+	toCID string,
+) ([]client.FieldDiff, error) {
+	txn, err := c.getTxn(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer c.discardImplicitTxn(ctx, txn)
+
+	// Documents that can not be read are reported as not found so that their existence
+	// is not disclosed.
+	err = c.checkDocumentPermission(ctx, txn, client.ReadPermission, key.String())
+	if errors.Is(err, client.ErrPermissionDenied) {
+		return nil, client.ErrDocumentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := c.diff(ctx, txn, key.String(), fromCID, toCID)
+	if err != nil {
+		return nil, err
+	}
+	return diff, c.commitImplicitTxn(ctx, txn)
+}
+
+func (c *collection) diff(
+	ctx context.Context,
+	txn datastore.Txn,
+	docKey string,
+	fromCID string,
+	toCID string,
+) ([]client.FieldDiff, error) {
+	before := map[string]any{}
+	if fromCID != "" {
+		var err error
+		before, err = c.getVersionValues(ctx, txn, docKey, fromCID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	after, err := c.getVersionValues(ctx, txn, docKey, toCID)
+	if err != nil {
+		return nil, err
+	}
+	return diffValues(before, after), nil
+}
+
+// diffValues returns the fields whose values differ between the given field values,
+// sorted by field name.
+func diffValues(before map[string]any, after map[string]any) []client.FieldDiff {
+	fieldNames := map[string]struct{}{}
+	for name := range before {
+		fieldNames[name] = struct{}{}
+	}
+	for name := range after {
+		fieldNames[name] = struct{}{}
+	}
+
+	diff := []client.FieldDiff{}
+	for name := range fieldNames {
+		if reflect.DeepEqual(before[name], after[name]) {
+			continue
+		}
+		diff = append(diff, client.FieldDiff{
+			Field:  name,
+			Before: before[name],
+			After:  after[name],
+		})
+	}
+	sort.Slice(diff, func(i, j int) bool {
+		return diff[i].Field < diff[j].Field
+	})
+	return diff
+}
+
+// getVersionValues returns the field values of the given document at the given version
+// mapped by field name.
+//
+// If no version is given the values of the current state, merged from all the current
+// heads of the document, are returned.
+func (c *collection) getVersionValues(
+	ctx context.Context,
+	txn datastore.Txn,
+	docKey string,
+	version string,
+) (map[string]any, error) {
+	var targets []cid.Cid
+	if version == "" {
+		headKey := core.DataStoreKey{
+			CollectionID: fmt.Sprint(c.ID()),
+			DocKey:       docKey,
+			FieldId:      core.COMPOSITE_NAMESPACE,
+		}
+		heads, _, err := clock.NewHeadSet(txn.Headstore(), headKey.ToHeadStoreKey()).List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(heads) == 0 {
+			return nil, client.ErrDocumentNotFound
+		}
+		targets = heads
+	} else {
+		target, err := c.getDocumentVersion(ctx, txn, docKey, version)
+		if err != nil {
+			return nil, err
+		}
+		targets = []cid.Cid{target}
+	}
+
+	vf := new(fetcher.VersionedFetcher)
+	err := vf.Init(ctx, txn, c, nil, nil, nil, false, true)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = vf.Close()
+	}()
+
+	err = vf.Start(ctx, fetcher.NewVersionedSpan(core.DataStoreKey{DocKey: docKey}, targets[0]))
+	if err != nil {
+		return nil, err
+	}
+	for _, target := range targets[1:] {
+		err = vf.SeekTo(ctx, target)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	encodedDoc, _, err := vf.FetchNext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]any{}
+	if encodedDoc == nil {
+		return values, nil
+	}
+
+	properties, err := encodedDoc.Properties(false)
+	if err != nil {
+		return nil, err
+	}
+	for field, value := range properties {
+		values[field.Name] = value
+	}
+	if encodedDoc.Status().IsDeleted() {
+		values[request.DeletedFieldName] = true
+	}
+	return values, nil
+}
+
+// getDocumentVersion decodes the given version, returning an error if it is not the CID
+// of a composite commit of the given document.
+func (c *collection) getDocumentVersion(
+	ctx context.Context,
+	txn datastore.Txn,
+	docKey string,
+	version string,
+) (cid.Cid, error) {
+	target, err := cid.Decode(version)
+	if err != nil {
+		return cid.Undef, NewErrInvalidDocumentVersion(version, docKey)
+	}
+	block, err := txn.DAGstore().Get(ctx, target)
+	if err != nil {
+		return cid.Undef, NewErrInvalidDocumentVersion(version, docKey)
+	}
+	nd, err := dag.DecodeProtobuf(block.RawData())
+	if err != nil {
+		return cid.Undef, err
+	}
+	delta, err := crdt.CompositeDAG{}.DeltaDecode(nd)
+	if err != nil {
+		return cid.Undef, err
+	}
+	compositeDelta, ok := delta.(*crdt.CompositeDAGDelta)
+	if !ok || compositeDelta.FieldName != "" || string(compositeDelta.DocKey) != docKey {
+		return cid.Undef, NewErrInvalidDocumentVersion(version, docKey)
+	}
+	return target, nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// validateEnumValue returns an error if the given value of an enum field is not a value of
+// its enum.
+//
+// Null values are not validated.
+func (c *collection) validateEnumValue(field client.FieldDescription, value any) error {
+	if field.Kind != client.FieldKind_ENUM || value == nil {
+		return nil
+	}
+	enum, exists := c.Schema().GetEnum(field.Schema)
+	if !exists {
+		return NewErrEnumNotFound(field.Name, field.Schema)
+	}
+	s, ok := value.(string)
+	if !ok || enum.IndexOf(s) < 0 {
+		return NewErrInvalidEnumValue(field.Name, enum.Name, value)
+	}
+	return nil
+}
+
+// validateUpdateSchemaEnums validates the enums of the proposed schema description.
+//
+// Enums may not be removed and their existing values may not be removed or reordered, new
+// values may only be appended. Will return true if the enums have changed.
+func validateUpdateSchemaEnums(
+	existingDesc client.SchemaDescription,
+	proposedDesc client.SchemaDescription,
+) (bool, error) {
+	hasChanged := false
+	for _, enum := range proposedDesc.Enums {
+		values := map[string]struct{}{}
+		for _, value := range enum.Values {
+			if _, isDuplicate := values[value]; isDuplicate {
+				return false, NewErrDuplicateEnumValue(enum.Name, value)
+			}
+			values[value] = struct{}{}
+		}
+	}
+
+	for _, existingEnum := range existingDesc.Enums {
+		proposedEnum, exists := proposedDesc.GetEnum(existingEnum.Name)
+		if !exists || len(proposedEnum.Values) < len(existingEnum.Values) {
+			return false, NewErrCannotRemoveEnumValue(existingEnum.Name)
+		}
+		for i, value := range existingEnum.Values {
+			if proposedEnum.Values[i] != value {
+				return false, NewErrCannotRemoveEnumValue(existingEnum.Name)
+			}
+		}
+		hasChanged = hasChanged || len(proposedEnum.Values) != len(existingEnum.Values)
+	}
+	return hasChanged || len(proposedDesc.Enums) != len(existingDesc.Enums), nil
+}
+
+// validateEnumField returns an error if the enum of the given field is not declared by the
+// given schema description, or if its default value is not a value of the enum.
+func validateEnumField(desc client.SchemaDescription, field client.FieldDescription) error {
+	if field.Kind != client.FieldKind_ENUM {
+		return nil
+	}
+	enum, exists := desc.GetEnum(field.Schema)
+	if !exists {
+		return NewErrEnumNotFound(field.Name, field.Schema)
+	}
+	if field.DefaultValue == "" {
+		return nil
+	}
+	value, err := fieldDefaultValue(field)
+	if err != nil {
+		return err
+	}
+	if s, ok := value.(string); !ok || enum.IndexOf(s) < 0 {
+		return NewErrInvalidDefaultValue(NewErrInvalidEnumValue(field.Name, enum.Name, value), field.Name)
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// geoPointValue returns the value to store for the given value of a GeoPoint field, holding
+// the validated geo point.
+//
+// Geo points are given as objects of their latitude and longitude, which documents hold as sub
+// documents. Null values are stored as is.
+func geoPointValue(field client.FieldDescription, val client.Value) (client.Value, error) {
+	if val.Value() == nil {
+		return val, nil
+	}
+	point, err := client.NewGeoPointFromValue(val.Value())
+	if err != nil {
+		return nil, err
+	}
+	return client.NewCBORValue(field.Typ, point), nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/sourcenetwork/defradb/datastore"
 	"github.com/sourcenetwork/defradb/db/base"
 	"github.com/sourcenetwork/defradb/db/fetcher"
+	"github.com/sourcenetwork/defradb/errors"
 )
 
 func (c *collection) Get(ctx context.Context, key client.DocKey, showDeleted bool) (*client.Document, error) {
@@ -36,6 +37,15 @@ func (c *collection) Get(ctx context.Context, key client.DocKey, showDeleted boo
 	if !found || (isDeleted && !showDeleted) {
 		return nil, client.ErrDocumentNotFound
 	}
+	// Documents that can not be read are reported as not found so that their existence
+	// is not disclosed.
+	err = c.checkDocumentPermission(ctx, txn, client.ReadPermission, key.String())
+	if errors.Is(err, client.ErrPermissionDenied) {
+		return nil, client.ErrDocumentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
 
 	doc, err := c.get(ctx, txn, dsKey, nil, showDeleted)
 	if err != nil {
@@ -0,0 +1,583 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/valyala/fastjson"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+func (c *collection) Import(
+	ctx context.Context,
+	config client.ImportConfig,
+	r io.Reader,
+) (result client.ImportResult, err error) {
+	reader, err := newImportReader(config.Format, r)
+	if err != nil {
+		return client.ImportResult{}, err
+	}
+
+	txn, err := c.getTxn(ctx, false)
+	if err != nil {
+		return client.ImportResult{}, err
+	}
+	defer func() {
+		if txn != nil {
+			c.discardImplicitTxn(ctx, txn)
+		}
+	}()
+
+	imp, err := c.newImporter(ctx, txn, config)
+	if err != nil {
+		return client.ImportResult{}, err
+	}
+
+	// Within an explicit transaction all the documents are created in that transaction,
+	// otherwise a new transaction is committed every batch.
+	batchSize := config.BatchSize
+	if batchSize <= 0 {
+		batchSize = client.DefaultImportBatchSize
+	}
+	explicit := c.txn.HasValue()
+
+	result.Rejected = []client.ImportReject{}
+	var batch []importDoc
+	for {
+		row, err := reader.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return client.ImportResult{}, err
+		}
+		if row.err != nil {
+			result.Rejected = append(result.Rejected, row.reject(row.err))
+			continue
+		}
+
+		values, err := imp.fieldValues(ctx, txn, row.values)
+		if err != nil {
+			result.Rejected = append(result.Rejected, row.reject(err))
+			continue
+		}
+
+		doc := importDoc{row: row, values: values}
+		d, err := doc.newDocument()
+		if err != nil {
+			result.Rejected = append(result.Rejected, row.reject(err))
+			continue
+		}
+		err = imp.c.create(ctx, txn, d)
+		if err != nil {
+			if explicit {
+				// The failed create may have left partial writes in the transaction, which
+				// cannot be undone without discarding the caller's transaction.
+				return client.ImportResult{}, NewErrImportRowInTxn(row.number, err)
+			}
+			result.Rejected = append(result.Rejected, row.reject(err))
+			// The failed create may have left partial writes in the transaction, so the batch
+			// is created again in a new transaction.
+			txn, batch, err = imp.recreate(ctx, txn, batch, &result)
+			if err != nil {
+				return client.ImportResult{}, err
+			}
+			continue
+		}
+
+		if explicit {
+			result.Imported++
+			continue
+		}
+		batch = append(batch, doc)
+		if len(batch) < batchSize {
+			continue
+		}
+		err = txn.Commit(ctx)
+		if err != nil {
+			return client.ImportResult{}, err
+		}
+		result.Imported += uint64(len(batch))
+		batch = nil
+
+		txn, err = c.db.NewTxn(ctx, false)
+		if err != nil {
+			return client.ImportResult{}, err
+		}
+	}
+
+	err = c.commitImplicitTxn(ctx, txn)
+	if err != nil {
+		return client.ImportResult{}, err
+	}
+	result.Imported += uint64(len(batch))
+
+	sort.SliceStable(result.Rejected, func(i, j int) bool {
+		return result.Rejected[i].Row < result.Rejected[j].Row
+	})
+	return result, nil
+}
+
+// importer converts the rows of an import to documents of the collection.
+type importer struct {
+	c      *collection
+	config client.ImportConfig
+	// relations holds the relations imported by natural key, by relation field name.
+	relations map[string]*importRelation
+}
+
+type importRelation struct {
+	col        *collection
+	naturalKey client.FieldDescription
+	// keys caches the keys of the related documents by natural key.
+	keys map[string]string
+}
+
+// importDoc is a row converted to the values of the fields of a document.
+type importDoc struct {
+	row    importRow
+	values map[string]any
+}
+
+func (c *collection) newImporter(
+	ctx context.Context,
+	txn datastore.Txn,
+	config client.ImportConfig,
+) (*importer, error) {
+	imp := &importer{
+		c:         c,
+		config:    config,
+		relations: make(map[string]*importRelation),
+	}
+	for fieldName, naturalKey := range config.Relations {
+		field, ok := c.Schema().GetField(fieldName)
+		if !ok || field.Kind != client.FieldKind_FOREIGN_OBJECT {
+			return nil, NewErrInvalidImportRelation(fieldName, naturalKey)
+		}
+		col, err := c.db.getCollectionByName(ctx, txn, field.Schema)
+		if err != nil {
+			return nil, err
+		}
+		keyField, ok := col.Schema().GetField(naturalKey)
+		if !ok || keyField.IsObject() {
+			return nil, NewErrInvalidImportRelation(fieldName, naturalKey)
+		}
+		imp.relations[fieldName] = &importRelation{
+			col:        col.(*collection),
+			naturalKey: keyField,
+			keys:       make(map[string]string),
+		}
+	}
+	return imp, nil
+}
+
+// fieldValues returns the values of the given row by field name, converted to the kind of their field.
+func (imp *importer) fieldValues(
+	ctx context.Context,
+	txn datastore.Txn,
+	row map[string]any,
+) (map[string]any, error) {
+	values := make(map[string]any, len(row))
+	for column, raw := range row {
+		fieldName := column
+		if mapped, ok := imp.config.Mapping[column]; ok {
+			fieldName = mapped
+		}
+		if fieldName == "" {
+			continue
+		}
+
+		if relation, ok := imp.relations[fieldName]; ok {
+			key, err := imp.resolveRelation(ctx, txn, fieldName, relation, raw)
+			if err != nil {
+				return nil, err
+			}
+			if key != "" {
+				values[fieldName+request.RelatedObjectID] = key
+			}
+			continue
+		}
+
+		field, ok := imp.c.Schema().GetField(fieldName)
+		if !ok {
+			return nil, client.NewErrFieldNotExist(fieldName)
+		}
+		if field.IsObject() {
+			return nil, NewErrImportRelationWithoutNaturalKey(fieldName)
+		}
+		value, err := coerceImportValue(raw, field)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			values[fieldName] = value
+		}
+	}
+	return values, nil
+}
+
+// resolveRelation returns the key of the related document whose natural key matches the given value,
+// or an empty key if the value is null or an empty CSV value.
+func (imp *importer) resolveRelation(
+	ctx context.Context,
+	txn datastore.Txn,
+	fieldName string,
+	relation *importRelation,
+	raw any,
+) (string, error) {
+	if raw == "" {
+		return "", nil
+	}
+	value, err := coerceImportValue(raw, relation.naturalKey)
+	if err != nil {
+		return "", err
+	}
+	if value == nil {
+		return "", nil
+	}
+	literal, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	if key, ok := relation.keys[string(literal)]; ok {
+		return key, nil
+	}
+
+	filter := fmt.Sprintf("{%s: {_eq: %s}}", relation.naturalKey.Name, literal)
+	selectionPlan, err := relation.col.makeSelectionPlan(ctx, txn, filter)
+	if err != nil {
+		return "", err
+	}
+	err = selectionPlan.Init()
+	if err != nil {
+		return "", err
+	}
+	if err := selectionPlan.Start(); err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := selectionPlan.Close(); err != nil {
+			log.ErrorE(ctx, "Failed to close the request plan, after natural key lookup", err)
+		}
+	}()
+
+	var key string
+	for {
+		next, err := selectionPlan.Next()
+		if err != nil {
+			return "", err
+		}
+		if !next {
+			break
+		}
+		if key != "" {
+			return "", NewErrNaturalKeyNotUnique(fieldName, string(literal))
+		}
+		doc := selectionPlan.Value()
+		key = doc.GetKey()
+	}
+	if key == "" {
+		return "", NewErrNaturalKeyNotFound(fieldName, string(literal))
+	}
+
+	relation.keys[string(literal)] = key
+	return key, nil
+}
+
+func (imp *importer) create(ctx context.Context, txn datastore.Txn, doc importDoc) error {
+	d, err := doc.newDocument()
+	if err != nil {
+		return err
+	}
+	return imp.c.create(ctx, txn, d)
+}
+
+// newDocument returns a new document holding the values of the imported row.
+func (doc importDoc) newDocument() (*client.Document, error) {
+	// The map is copied as it is modified when creating the document.
+	values := make(map[string]any, len(doc.values))
+	for k, v := range doc.values {
+		values[k] = v
+	}
+	return client.NewDocFromMap(values)
+}
+
+// recreate discards the given transaction and creates the given documents again in a new one.
+//
+// Documents that fail to be created again are rejected and removed from the returned batch.
+func (imp *importer) recreate(
+	ctx context.Context,
+	txn datastore.Txn,
+	batch []importDoc,
+	result *client.ImportResult,
+) (datastore.Txn, []importDoc, error) {
+	for {
+		txn.Discard(ctx)
+		// Related documents created within the discarded transaction no longer exist.
+		for _, relation := range imp.relations {
+			relation.keys = make(map[string]string)
+		}
+
+		var err error
+		txn, err = imp.c.db.NewTxn(ctx, false)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		failed := -1
+		for i, doc := range batch {
+			err := imp.create(ctx, txn, doc)
+			if err != nil {
+				result.Rejected = append(result.Rejected, doc.row.reject(err))
+				failed = i
+				break
+			}
+		}
+		if failed < 0 {
+			return txn, batch, nil
+		}
+		batch = append(batch[:failed:failed], batch[failed+1:]...)
+	}
+}
+
+// coerceImportValue converts the given CSV or NDJSON value to the kind of the given field.
+//
+// Empty CSV values are imported as null, unless the field is a string.
+func coerceImportValue(raw any, field client.FieldDescription) (any, error) {
+	var val *fastjson.Value
+	switch v := raw.(type) {
+	case *fastjson.Value:
+		val = v
+
+	case string:
+		switch field.Kind {
+		case client.FieldKind_STRING, client.FieldKind_DocKey:
+			return v, nil
+		}
+		if v == "" {
+			return nil, nil
+		}
+
+		switch field.Kind {
+		case client.FieldKind_BLOB, client.FieldKind_ENUM:
+			return v, nil
+		case client.FieldKind_DATETIME:
+			var arena fastjson.Arena
+			val = arena.NewString(v)
+		case client.FieldKind_BOOL:
+			return strconv.ParseBool(v)
+		case client.FieldKind_INT:
+			return strconv.ParseInt(v, 10, 64)
+		case client.FieldKind_FLOAT:
+			return strconv.ParseFloat(v, 64)
+		case client.FieldKind_DECIMAL:
+			return client.ParseDecimal(v)
+		case client.FieldKind_BIGINT:
+			return client.ParseBigInt(v)
+		default:
+			// Array, JSON and geo point values are written as JSON.
+			parsed, err := fastjson.Parse(v)
+			if err != nil {
+				return nil, err
+			}
+			val = parsed
+		}
+
+	default:
+		return nil, client.NewErrUnhandledType(field.Name, raw)
+	}
+
+	if val.Type() == fastjson.TypeNull {
+		return nil, nil
+	}
+	value, err := validateFieldSchema(val, field)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateDateTimes(field, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// validateDateTimes returns an error if the given value of a DateTime, or DateTime array, field
+// holds a string which is not an RFC3339 timestamp.
+func validateDateTimes(field client.FieldDescription, value any) error {
+	var values []string
+	switch v := value.(type) {
+	case string:
+		values = []string{v}
+	case []string:
+		values = v
+	case []*string:
+		for _, item := range v {
+			if item != nil {
+				values = append(values, *item)
+			}
+		}
+	}
+
+	switch field.Kind {
+	case client.FieldKind_DATETIME, client.FieldKind_DATETIME_ARRAY, client.FieldKind_NILLABLE_DATETIME_ARRAY:
+		for _, v := range values {
+			if _, err := time.Parse(time.RFC3339, v); err != nil {
+				return NewErrInvalidDateTime(err, field.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// importRow is a row read from the imported data.
+type importRow struct {
+	number uint64
+	data   string
+	// values holds the values of the row by column, either as CSV strings or NDJSON values.
+	values map[string]any
+	// err is set if the row could not be parsed.
+	err error
+}
+
+func (r importRow) reject(err error) client.ImportReject {
+	return client.ImportReject{
+		Row:   r.number,
+		Data:  r.data,
+		Error: err.Error(),
+	}
+}
+
+// importReader reads the rows of the imported data.
+type importReader interface {
+	// next returns the next row of the imported data, or io.EOF once all rows have been read.
+	next() (importRow, error)
+}
+
+func newImportReader(format string, r io.Reader) (importReader, error) {
+	switch strings.ToLower(format) {
+	case client.ImportFormatCSV:
+		return newCSVImportReader(r)
+	case client.ImportFormatNDJSON:
+		return &ndjsonImportReader{r: bufio.NewReader(r)}, nil
+	default:
+		return nil, NewErrInvalidImportFormat(format)
+	}
+}
+
+// csvImportReader reads a CSV record per row, the first record holds the column names.
+type csvImportReader struct {
+	r      *csv.Reader
+	header []string
+	row    uint64
+}
+
+func newCSVImportReader(r io.Reader) (*csvImportReader, error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return &csvImportReader{r: reader, header: header}, nil
+}
+
+func (r *csvImportReader) next() (importRow, error) {
+	if r.header == nil {
+		return importRow{}, io.EOF
+	}
+
+	record, err := r.r.Read()
+	if errors.Is(err, io.EOF) {
+		return importRow{}, io.EOF
+	}
+	r.row++
+	row := importRow{number: r.row, data: encodeCSVRecord(record)}
+
+	if _, ok := err.(*csv.ParseError); ok {
+		row.err = err
+		return row, nil
+	}
+	if err != nil {
+		return importRow{}, err
+	}
+
+	row.values = make(map[string]any, len(record))
+	for i, value := range record {
+		row.values[r.header[i]] = value
+	}
+	return row, nil
+}
+
+func encodeCSVRecord(record []string) string {
+	if record == nil {
+		return ""
+	}
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	// Writing to a buffer cannot fail.
+	w.Write(record) //nolint:errcheck
+	w.Flush()
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// ndjsonImportReader reads a JSON object per line, empty lines are skipped.
+type ndjsonImportReader struct {
+	r   *bufio.Reader
+	row uint64
+}
+
+func (r *ndjsonImportReader) next() (importRow, error) {
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return importRow{}, err
+		}
+		if len(line) == 0 && errors.Is(err, io.EOF) {
+			return importRow{}, io.EOF
+		}
+		r.row++
+
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		row := importRow{number: r.row, data: string(line)}
+
+		val, parseErr := fastjson.ParseBytes(line)
+		if parseErr != nil {
+			row.err = NewErrJSONDecode(parseErr)
+			return row, nil
+		}
+		obj, objErr := val.Object()
+		if objErr != nil {
+			row.err = ErrExpectedJSONObject
+			return row, nil
+		}
+		row.values = make(map[string]any, obj.Len())
+		obj.Visit(func(key []byte, v *fastjson.Value) {
+			row.values[string(key)] = v
+		})
+		return row, nil
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func getAllDocs(t *testing.T, ctx context.Context, col client.Collection) []*client.Document {
+	keysCh, err := col.GetAllDocKeys(ctx)
+	require.NoError(t, err)
+
+	docs := []*client.Document{}
+	for key := range keysCh {
+		require.NoError(t, key.Err)
+		doc, err := col.Get(ctx, key.Key, false)
+		require.NoError(t, err)
+		docs = append(docs, doc)
+	}
+	return docs
+}
+
+func TestCollectionImport_WithCSV_CoercesValuesAndRejectsInvalidRows(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.AddSchema(ctx, `type User {
+		name: String
+		age: Int
+		points: Float
+		verified: Boolean
+		createdAt: DateTime
+		scores: [Int!]
+	}`)
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	data := strings.Join([]string{
+		"full_name,age,points,verified,createdAt,scores,notes",
+		`John,30,1.5,true,2017-07-23T03:46:56Z,"[1,2]",ignored`,
+		"Bob,,,false,,,",
+		"Alice,thirty,,,,,",
+		"Fred,40,,,yesterday,,",
+		"Islam,50",
+	}, "\n")
+	result, err := col.Import(ctx, client.ImportConfig{
+		Format:  client.ImportFormatCSV,
+		Mapping: map[string]string{"full_name": "name", "notes": ""},
+	}, strings.NewReader(data))
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(2), result.Imported)
+	require.Len(t, result.Rejected, 3)
+	require.Equal(t, uint64(3), result.Rejected[0].Row)
+	require.Equal(t, "Alice,thirty,,,,,", result.Rejected[0].Data)
+	require.Equal(t, uint64(4), result.Rejected[1].Row)
+	require.Contains(t, result.Rejected[1].Error, errInvalidDateTime)
+	require.Equal(t, uint64(5), result.Rejected[2].Row)
+
+	docs := getAllDocs(t, ctx, col)
+	require.Len(t, docs, 2)
+	values := map[string]map[string]any{}
+	for _, doc := range docs {
+		m, err := doc.ToMap()
+		require.NoError(t, err)
+		values[m["name"].(string)] = m
+	}
+	require.Equal(t, int64(30), values["John"]["age"])
+	require.Equal(t, 1.5, values["John"]["points"])
+	require.Equal(t, true, values["John"]["verified"])
+	require.Equal(t, "2017-07-23T03:46:56Z", values["John"]["createdAt"])
+	require.Equal(t, []int64{1, 2}, values["John"]["scores"])
+	require.Equal(t, false, values["Bob"]["verified"])
+	require.Nil(t, values["Bob"]["age"])
+}
+
+func TestCollectionImport_WithNDJSONAndBatches_RejectsDuplicatesAndKeepsOtherRows(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.AddSchema(ctx, `type User {
+		name: String
+		age: Int
+	}`)
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	data := strings.Join([]string{
+		`{"name": "John", "age": 30}`,
+		`{"name": "Bob", "age": 40}`,
+		``,
+		`{"name": "John", "age": 30}`,
+		`{"name": "Alice", "age": "forty"}`,
+		`not json`,
+		`{"name": "Fred", "unknown": true}`,
+		`{"name": "Islam", "age": 50}`,
+	}, "\n")
+	result, err := col.Import(ctx, client.ImportConfig{
+		Format:    client.ImportFormatNDJSON,
+		BatchSize: 2,
+	}, strings.NewReader(data))
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(3), result.Imported)
+	rows := []uint64{}
+	for _, reject := range result.Rejected {
+		rows = append(rows, reject.Row)
+	}
+	require.Equal(t, []uint64{4, 5, 6, 7}, rows)
+	require.Contains(t, result.Rejected[0].Error, errDocumentAlreadyExists)
+	require.Equal(t, `{"name": "John", "age": 30}`, result.Rejected[0].Data)
+
+	require.Len(t, getAllDocs(t, ctx, col), 3)
+}
+
+func TestCollectionImport_WithTxnAndFailedCreate_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+	col := newUserTestCollection(ctx, t, db)
+
+	txn, err := db.NewTxn(ctx, false)
+	require.NoError(t, err)
+	defer txn.Discard(ctx)
+
+	// Rows rejected before being written are still skipped within a transaction.
+	data := strings.Join([]string{
+		`{"name": "John", "age": 30}`,
+		`{"name": "Alice", "age": "forty"}`,
+		`{"name": "Bob", "age": 40}`,
+	}, "\n")
+	result, err := col.WithTxn(txn).Import(ctx, client.ImportConfig{
+		Format: client.ImportFormatNDJSON,
+	}, strings.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), result.Imported)
+	require.Len(t, result.Rejected, 1)
+
+	// Rows failing to be created may have been partially written and abort the import.
+	data = `{"name": "John", "age": 30}`
+	_, err = col.WithTxn(txn).Import(ctx, client.ImportConfig{
+		Format: client.ImportFormatNDJSON,
+	}, strings.NewReader(data))
+	require.ErrorIs(t, err, ErrImportRowInTxn)
+}
+
+func TestCollectionImport_WithRelationByNaturalKey_SetsRelatedDocKey(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.AddSchema(ctx, `
+		type Book {
+			title: String
+			author: Author
+		}
+		type Author {
+			name: String
+			books: [Book]
+		}
+	`)
+	require.NoError(t, err)
+
+	authors, err := db.GetCollectionByName(ctx, "Author")
+	require.NoError(t, err)
+	author, err := client.NewDocFromJSON([]byte(`{"name": "John"}`))
+	require.NoError(t, err)
+	err = authors.Create(ctx, author)
+	require.NoError(t, err)
+
+	books, err := db.GetCollectionByName(ctx, "Book")
+	require.NoError(t, err)
+
+	data := "title,author\nPainted House,John\nA Time for Mercy,Unknown\nThe Associate,\n"
+	result, err := books.Import(ctx, client.ImportConfig{
+		Format:    client.ImportFormatCSV,
+		Relations: map[string]string{"author": "name"},
+	}, strings.NewReader(data))
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(2), result.Imported)
+	require.Len(t, result.Rejected, 1)
+	require.Equal(t, uint64(2), result.Rejected[0].Row)
+	require.Contains(t, result.Rejected[0].Error, errNaturalKeyNotFound)
+
+	authorIDs := map[string]any{}
+	for _, doc := range getAllDocs(t, ctx, books) {
+		m, err := doc.ToMap()
+		require.NoError(t, err)
+		authorIDs[m["title"].(string)] = m["author_id"]
+	}
+	require.Equal(t, author.Key().String(), authorIDs["Painted House"])
+	require.Nil(t, authorIDs["The Associate"])
+}
+
+func TestCollectionImport_WithInvalidConfig_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.AddSchema(ctx, `type User {
+		name: String
+	}`)
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	_, err = col.Import(ctx, client.ImportConfig{Format: "xml"}, strings.NewReader(""))
+	require.ErrorIs(t, err, ErrInvalidImportFormat)
+
+	_, err = col.Import(ctx, client.ImportConfig{
+		Format:    client.ImportFormatCSV,
+		Relations: map[string]string{"name": "name"},
+	}, strings.NewReader("name\nJohn\n"))
+	require.ErrorIs(t, err, ErrInvalidImportRelation)
+}
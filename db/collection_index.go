@@ -115,6 +115,7 @@ func (c *collection) updateIndexedDoc(
 	ctx context.Context,
 	txn datastore.Txn,
 	doc *client.Document,
+	isDeleted bool,
 ) error {
 	err := c.loadIndexes(ctx, txn)
 	if err != nil {
@@ -126,7 +127,7 @@ func (c *collection) updateIndexedDoc(
 		ctx,
 		txn,
 		c.getPrimaryKeyFromDocKey(doc.Key()), desc.CollectIndexedFields(&schema),
-		false,
+		isDeleted,
 	)
 	if err != nil {
 		return err
@@ -409,6 +410,15 @@ func (c *collection) checkExistingFields(
 		found := false
 		for _, colField := range collectionFields {
 			if field.Name == colField.Name {
+				if colField.IsEncrypted {
+					return NewErrCannotIndexEncryptedField(field.Name)
+				}
+				if colField.Kind == client.FieldKind_JSON && len(field.Path) == 0 {
+					return NewErrJSONIndexWithoutPath(field.Name)
+				}
+				if colField.Kind != client.FieldKind_JSON && len(field.Path) > 0 {
+					return NewErrIndexPathOnNonJSONField(field.Name)
+				}
 				found = true
 				break
 			}
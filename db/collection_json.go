@@ -0,0 +1,30 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// canonicalJSONValue returns the value to store for the given value of a JSON field, holding
+// the canonical JSON encoding of the value.
+//
+// Null values are stored as is.
+func canonicalJSONValue(field client.FieldDescription, val client.Value) (client.Value, error) {
+	if val.Value() == nil {
+		return val, nil
+	}
+	encoded, err := client.CanonicalJSON(val.Value())
+	if err != nil {
+		return nil, err
+	}
+	return client.NewCBORValue(field.Typ, encoded), nil
+}
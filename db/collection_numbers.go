@@ -0,0 +1,42 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// isExactNumberKind returns true if the given kind holds numbers of arbitrary precision.
+func isExactNumberKind(kind client.FieldKind) bool {
+	return kind == client.FieldKind_DECIMAL || kind == client.FieldKind_BIGINT
+}
+
+// exactNumberValue returns the value to store for the given value of a Decimal or BigInt field,
+// converted to the exact type of the field.
+//
+// Numbers may be given as strings, so that no precision is lost to the JSON float parsing of
+// documents. Null values are stored as is.
+func exactNumberValue(field client.FieldDescription, val client.Value) (client.Value, error) {
+	if val.Value() == nil {
+		return val, nil
+	}
+	var typed any
+	var err error
+	if field.Kind == client.FieldKind_DECIMAL {
+		typed, err = client.NewDecimalFromValue(val.Value())
+	} else {
+		typed, err = client.NewBigIntFromValue(val.Value())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return client.NewCBORValue(field.Typ, typed), nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/datastore"
+)
+
+func (c *collection) Revert(ctx context.Context, key client.DocKey, cid string) error {
+	txn, err := c.getTxn(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer c.discardImplicitTxn(ctx, txn)
+
+	err = c.revert(ctx, txn, key, cid)
+	if err != nil {
+		return err
+	}
+	return c.commitImplicitTxn(ctx, txn)
+}
+
+// revert writes a new update to the given document that sets every field that differs
+// from the given version back to its value at that version.
+//
+// If the document was deleted at the given version it is deleted, and if it has been
+// deleted since then it is restored.
+func (c *collection) revert(
+	ctx context.Context,
+	txn datastore.Txn,
+	key client.DocKey,
+	version string,
+) error {
+	primaryKey := c.getPrimaryKeyFromDocKey(key)
+	exists, isDeleted, err := c.exists(ctx, txn, primaryKey)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return client.ErrDocumentNotFound
+	}
+
+	target, err := c.getVersionValues(ctx, txn, key.String(), version)
+	if err != nil {
+		return err
+	}
+	if target[request.DeletedFieldName] == true {
+		if isDeleted {
+			return nil
+		}
+		return c.applyDelete(ctx, txn, primaryKey)
+	}
+
+	current, err := c.getVersionValues(ctx, txn, key.String(), "")
+	if err != nil {
+		return err
+	}
+	diff := diffValues(current, target)
+
+	doc, err := c.get(ctx, txn, primaryKey, nil, isDeleted)
+	if err != nil {
+		return err
+	}
+	if doc == nil {
+		return client.ErrDocumentNotFound
+	}
+	for _, fieldDiff := range diff {
+		if fieldDiff.Field == request.DeletedFieldName {
+			continue
+		}
+		err = doc.Set(fieldDiff.Field, fieldDiff.After)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !isDeleted {
+		if len(diff) == 0 {
+			// The document is already in the requested state.
+			return nil
+		}
+		_, err = c.save(ctx, txn, doc, false)
+		return err
+	}
+	_, err = c.saveWithRestore(ctx, txn, doc, false, true)
+	return err
+}
@@ -12,9 +12,17 @@ package db
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+	badgerds "github.com/sourcenetwork/defradb/datastore/badger/v4"
+	"github.com/sourcenetwork/defradb/db/base"
+	"github.com/sourcenetwork/defradb/errors"
 )
 
 func TestGetCollectionByNameReturnsErrorGivenNonExistantCollection(t *testing.T) {
@@ -34,3 +42,259 @@ func TestGetCollectionByNameReturnsErrorGivenEmptyString(t *testing.T) {
 	_, err = db.GetCollectionByName(ctx, "")
 	assert.EqualError(t, err, "collection name can't be empty")
 }
+
+func TestCollectionDiff_WithUpdate_ReturnsChangedFields(t *testing.T) {
+	ctx := context.Background()
+	db, col := newChangeLogTestCollection(ctx, t)
+	defer db.Close()
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John", "age": 30}`))
+	require.NoError(t, err)
+
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	err = doc.Set("age", 31)
+	require.NoError(t, err)
+
+	err = col.Update(ctx, doc)
+	require.NoError(t, err)
+
+	res, err := db.ChangesSince(ctx, "User", 0, 0)
+	require.NoError(t, err)
+	changes := res.Changes
+	require.Len(t, changes, 2)
+
+	diff, err := col.Diff(ctx, doc.Key(), changes[0].Cid, changes[1].Cid)
+	require.NoError(t, err)
+	assert.Equal(t, []client.FieldDiff{{Field: "age", Before: int64(30), After: int64(31)}}, diff)
+
+	diff, err = col.Diff(ctx, doc.Key(), "", changes[0].Cid)
+	require.NoError(t, err)
+	assert.Equal(t, []client.FieldDiff{
+		{Field: "age", Before: nil, After: int64(30)},
+		{Field: "name", Before: nil, After: "John"},
+	}, diff)
+
+	diff, err = col.Diff(ctx, doc.Key(), changes[1].Cid, "")
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+}
+
+func TestCollectionDiff_WithVersionOfOtherDocument_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	db, col := newChangeLogTestCollection(ctx, t)
+	defer db.Close()
+
+	doc1, err := client.NewDocFromJSON([]byte(`{"name": "John", "age": 30}`))
+	require.NoError(t, err)
+	err = col.Create(ctx, doc1)
+	require.NoError(t, err)
+
+	doc2, err := client.NewDocFromJSON([]byte(`{"name": "Andy", "age": 40}`))
+	require.NoError(t, err)
+	err = col.Create(ctx, doc2)
+	require.NoError(t, err)
+
+	res, err := db.ChangesSince(ctx, "User", 0, 0)
+	require.NoError(t, err)
+	changes := res.Changes
+	require.Len(t, changes, 2)
+
+	_, err = col.Diff(ctx, doc2.Key(), changes[0].Cid, "")
+	assert.ErrorContains(t, err, errInvalidDocumentVersion)
+}
+
+func TestCollectionRevert_AfterDelete_RestoresDocumentAtVersion(t *testing.T) {
+	ctx := context.Background()
+	db, col := newChangeLogTestCollection(ctx, t)
+	defer db.Close()
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John", "age": 30}`))
+	require.NoError(t, err)
+
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	err = doc.Set("age", 31)
+	require.NoError(t, err)
+
+	err = col.Update(ctx, doc)
+	require.NoError(t, err)
+
+	_, err = col.Delete(ctx, doc.Key())
+	require.NoError(t, err)
+
+	res, err := db.ChangesSince(ctx, "User", 0, 0)
+	require.NoError(t, err)
+	changes := res.Changes
+	require.Len(t, changes, 3)
+
+	err = col.Revert(ctx, doc.Key(), changes[0].Cid)
+	require.NoError(t, err)
+
+	reverted, err := col.Get(ctx, doc.Key(), false)
+	require.NoError(t, err)
+
+	age, err := reverted.Get("age")
+	require.NoError(t, err)
+	assert.Equal(t, int64(30), age)
+
+	res, err = db.ChangesSince(ctx, "User", changes[2].Seq, 0)
+	require.NoError(t, err)
+	changes = res.Changes
+	require.Len(t, changes, 1)
+	assert.False(t, changes[0].Deleted)
+}
+
+func TestCollectionRevert_ToDeletedVersion_DeletesDocument(t *testing.T) {
+	ctx := context.Background()
+	db, col := newChangeLogTestCollection(ctx, t)
+	defer db.Close()
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John", "age": 30}`))
+	require.NoError(t, err)
+
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	_, err = col.Delete(ctx, doc.Key())
+	require.NoError(t, err)
+
+	res, err := db.ChangesSince(ctx, "User", 0, 0)
+	require.NoError(t, err)
+	changes := res.Changes
+	require.Len(t, changes, 2)
+
+	err = col.Revert(ctx, doc.Key(), changes[0].Cid)
+	require.NoError(t, err)
+
+	err = col.Revert(ctx, doc.Key(), changes[1].Cid)
+	require.NoError(t, err)
+
+	_, err = col.Get(ctx, doc.Key(), false)
+	assert.ErrorIs(t, err, client.ErrDocumentNotFound)
+}
+
+func TestCollectionUpdate_WithinTxnAndConstraintViolation_WritesNoValue(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.AddSchema(ctx, `type User {
+		name: String
+		age: Int @constraint(min: 0)
+	}`)
+	require.NoError(t, err)
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John", "age": 30}`))
+	require.NoError(t, err)
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	txn, err := db.NewTxn(ctx, false)
+	require.NoError(t, err)
+	defer txn.Discard(ctx)
+
+	err = doc.Set("name", "Johnny")
+	require.NoError(t, err)
+	err = doc.Set("age", -1)
+	require.NoError(t, err)
+	err = col.WithTxn(txn).Update(ctx, doc)
+	require.ErrorIs(t, err, base.ErrConstraintViolation)
+
+	stored, err := col.WithTxn(txn).Get(ctx, doc.Key(), false)
+	require.NoError(t, err)
+	name, err := stored.Get("name")
+	require.NoError(t, err)
+	assert.Equal(t, "John", name)
+}
+
+func TestCollectionUpsert_WithConcurrentTxnsAndSameFilter_CreatesOneDocument(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+	col := newUserTestCollection(ctx, t, db)
+
+	txn1, err := db.NewTxn(ctx, false)
+	require.NoError(t, err)
+	defer txn1.Discard(ctx)
+	txn2, err := db.NewTxn(ctx, false)
+	require.NoError(t, err)
+	defer txn2.Discard(ctx)
+
+	doc1, err := client.NewDocFromJSON([]byte(`{"name": "John", "age": 1}`))
+	require.NoError(t, err)
+	res, err := col.WithTxn(txn1).Upsert(ctx, `{name: {_eq: "John"}}`, doc1, `{"age": 1}`)
+	require.NoError(t, err)
+	require.True(t, res.Created)
+
+	doc2, err := client.NewDocFromJSON([]byte(`{"name": "John", "age": 2}`))
+	require.NoError(t, err)
+	res, err = col.WithTxn(txn2).Upsert(ctx, `{name: {_eq: "John"}}`, doc2, `{"age": 2}`)
+	require.NoError(t, err)
+	require.True(t, res.Created)
+
+	err = txn1.Commit(ctx)
+	require.NoError(t, err)
+	err = txn2.Commit(ctx)
+	require.ErrorIs(t, err, badgerds.ErrTxnConflict)
+
+	_, err = col.Get(ctx, doc1.Key(), false)
+	require.NoError(t, err)
+	_, err = col.Get(ctx, doc2.Key(), false)
+	require.ErrorIs(t, err, client.ErrDocumentNotFound)
+}
+
+func TestCollectionUpsert_WithConcurrentUpsertsAndSameFilter_CreatesOneDocument(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+	newUserTestCollection(ctx, t, db)
+
+	const upserts = 10
+	var created int
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i := 0; i < upserts; i++ {
+		wg.Add(1)
+		go func(age int) {
+			defer wg.Done()
+			col, err := db.GetCollectionByName(ctx, "User")
+			require.NoError(t, err)
+			doc, err := client.NewDocFromJSON([]byte(fmt.Sprintf(`{"name": "John", "age": %d}`, age)))
+			require.NoError(t, err)
+			for {
+				res, err := col.Upsert(ctx, `{name: {_eq: "John"}}`, doc, fmt.Sprintf(`{"age": %d}`, age))
+				if errors.Is(err, badgerds.ErrTxnConflict) {
+					continue
+				}
+				require.NoError(t, err)
+				if res.Created {
+					mu.Lock()
+					created++
+					mu.Unlock()
+				}
+				return
+			}
+		}(i)
+	}
+	wg.Wait()
+	require.Equal(t, 1, created)
+
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	keysCh, err := col.GetAllDocKeys(ctx)
+	require.NoError(t, err)
+	var keys int
+	for res := range keysCh {
+		require.NoError(t, res.Err)
+		keys++
+	}
+	require.Equal(t, 1, keys)
+}
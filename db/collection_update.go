@@ -12,6 +12,7 @@ package db
 
 import (
 	"context"
+	"math/big"
 	"strings"
 
 	ds "github.com/ipfs/go-datastore"
@@ -424,13 +425,14 @@ func (c *collection) patchPrimaryDoc(
 // the typed value again as an interface.
 func validateFieldSchema(val *fastjson.Value, field client.FieldDescription) (any, error) {
 	switch field.Kind {
-	case client.FieldKind_DocKey, client.FieldKind_STRING:
+	case client.FieldKind_DocKey, client.FieldKind_STRING, client.FieldKind_ENUM:
 		return getString(val)
 
-	case client.FieldKind_STRING_ARRAY:
+	case client.FieldKind_STRING_ARRAY, client.FieldKind_DATETIME_ARRAY, client.FieldKind_BLOB_ARRAY:
 		return getArray(val, getString)
 
-	case client.FieldKind_NILLABLE_STRING_ARRAY:
+	case client.FieldKind_NILLABLE_STRING_ARRAY, client.FieldKind_NILLABLE_DATETIME_ARRAY,
+		client.FieldKind_NILLABLE_BLOB_ARRAY:
 		return getNillableArray(val, getString)
 
 	case client.FieldKind_BOOL:
@@ -473,6 +475,18 @@ func validateFieldSchema(val *fastjson.Value, field client.FieldDescription) (an
 
 	case client.FieldKind_BLOB:
 		return getString(val)
+
+	case client.FieldKind_JSON:
+		return client.DecodeJSONValue(val.String())
+
+	case client.FieldKind_DECIMAL:
+		return getDecimal(val)
+
+	case client.FieldKind_BIGINT:
+		return getBigInt(val)
+
+	case client.FieldKind_GEOPOINT:
+		return getGeoPoint(val)
 	}
 
 	return nil, client.NewErrUnhandledType("FieldKind", field.Kind)
@@ -495,6 +509,51 @@ func getInt64(v *fastjson.Value) (int64, error) {
 	return v.Int64()
 }
 
+// getGeoPoint returns the geo point held by the given JSON object, or array of its latitude and
+// longitude, or nil if the value is null.
+func getGeoPoint(v *fastjson.Value) (any, error) {
+	if v.Type() == fastjson.TypeNull {
+		return nil, nil
+	}
+	value, err := client.DecodeJSONValue(v.String())
+	if err != nil {
+		return nil, err
+	}
+	return client.NewGeoPointFromValue(value)
+}
+
+// getDecimal returns the decimal held by the given JSON string or number, read from its exact
+// text.
+func getDecimal(v *fastjson.Value) (client.Decimal, error) {
+	if v.Type() == fastjson.TypeString {
+		s, err := getString(v)
+		if err != nil {
+			return client.Decimal{}, err
+		}
+		return client.ParseDecimal(s)
+	}
+	if v.Type() != fastjson.TypeNumber {
+		return client.Decimal{}, client.NewErrInvalidDecimal(v.String())
+	}
+	return client.ParseDecimal(v.String())
+}
+
+// getBigInt returns the integer held by the given JSON string or number, read from its exact
+// text.
+func getBigInt(v *fastjson.Value) (*big.Int, error) {
+	if v.Type() == fastjson.TypeString {
+		s, err := getString(v)
+		if err != nil {
+			return nil, err
+		}
+		return client.ParseBigInt(s)
+	}
+	if v.Type() != fastjson.TypeNumber {
+		return nil, client.NewErrInvalidBigInt(v.String())
+	}
+	return client.ParseBigInt(v.String())
+}
+
 func getArray[T any](
 	val *fastjson.Value,
 	typeGetter func(*fastjson.Value) (T, error),
@@ -559,22 +618,9 @@ func (c *collection) makeSelectionPlan(
 	txn datastore.Txn,
 	filter any,
 ) (planner.RequestPlan, error) {
-	var f immutable.Option[request.Filter]
-	var err error
-	switch fval := filter.(type) {
-	case string:
-		if fval == "" {
-			return nil, ErrInvalidFilter
-		}
-
-		f, err = c.db.parser.NewFilterFromString(c.Name(), fval)
-		if err != nil {
-			return nil, err
-		}
-	case immutable.Option[request.Filter]:
-		f = fval
-	default:
-		return nil, ErrInvalidFilter
+	f, err := c.parseFilter(filter)
+	if err != nil {
+		return nil, err
 	}
 
 	slct, err := c.makeSelectLocal(f)
@@ -594,6 +640,21 @@ func (c *collection) makeSelectionPlan(
 	})
 }
 
+// parseFilter returns the given filter, parsing it first if it is a string.
+func (c *collection) parseFilter(filter any) (immutable.Option[request.Filter], error) {
+	switch fval := filter.(type) {
+	case string:
+		if fval == "" {
+			return immutable.None[request.Filter](), ErrInvalidFilter
+		}
+		return c.db.parser.NewFilterFromString(c.Name(), fval)
+	case immutable.Option[request.Filter]:
+		return fval, nil
+	default:
+		return immutable.None[request.Filter](), ErrInvalidFilter
+	}
+}
+
 func (c *collection) makeSelectLocal(filter immutable.Option[request.Filter]) (*request.Select, error) {
 	slct := &request.Select{
 		Field: request.Field{
@@ -0,0 +1,160 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+// Upsert updates the single document matching the given filter using the given updater,
+// or creates the given document if no document matches the filter.
+func (c *collection) Upsert(
+	ctx context.Context,
+	filter any,
+	create *client.Document,
+	updater string,
+) (*client.UpsertResult, error) {
+	txn, err := c.getTxn(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	defer c.discardImplicitTxn(ctx, txn)
+
+	res, err := c.upsert(ctx, txn, filter, create, updater)
+	if err != nil {
+		return nil, err
+	}
+	return res, c.commitImplicitTxn(ctx, txn)
+}
+
+func (c *collection) upsert(
+	ctx context.Context,
+	txn datastore.Txn,
+	filter any,
+	create *client.Document,
+	updater string,
+) (*client.UpsertResult, error) {
+	f, err := c.parseFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	docKeys, err := c.findUpsertMatches(ctx, txn, f)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(docKeys) {
+	case 0:
+		if create == nil {
+			return nil, client.ErrDocumentNotFound
+		}
+		err = c.markUpsertCreate(ctx, txn, f)
+		if err != nil {
+			return nil, err
+		}
+		err = c.create(ctx, txn, create)
+		if err != nil {
+			return nil, err
+		}
+		return &client.UpsertResult{DocKey: create.Key().String(), Created: true}, nil
+
+	case 1:
+		key, err := client.NewDocKeyFromString(docKeys[0])
+		if err != nil {
+			return nil, err
+		}
+		_, err = c.updateWithKey(ctx, txn, key, updater)
+		if err != nil {
+			return nil, err
+		}
+		return &client.UpsertResult{DocKey: docKeys[0]}, nil
+
+	default:
+		return nil, client.NewErrMultipleUpsertMatches(docKeys)
+	}
+}
+
+// markUpsertCreate reads and writes the upsert key of the given filter so that the transaction
+// conflicts with any other transaction creating a document through an upsert with the same filter,
+// as neither would see the document created by the other.
+func (c *collection) markUpsertCreate(
+	ctx context.Context,
+	txn datastore.Txn,
+	filter immutable.Option[request.Filter],
+) error {
+	var conditions map[string]any
+	if filter.HasValue() {
+		conditions = filter.Value().Conditions
+	}
+	data, err := json.Marshal(conditions)
+	if err != nil {
+		return err
+	}
+	hash := sha256.Sum256(data)
+	key := core.NewUpsertKey(c.ID(), hex.EncodeToString(hash[:]))
+
+	_, err = txn.Systemstore().Get(ctx, key.ToDS())
+	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return err
+	}
+	return txn.Systemstore().Put(ctx, key.ToDS(), []byte{})
+}
+
+// findUpsertMatches returns the DocKeys of the documents matching the given filter, it stops
+// looking once a second document is found.
+func (c *collection) findUpsertMatches(
+	ctx context.Context,
+	txn datastore.Txn,
+	filter immutable.Option[request.Filter],
+) ([]string, error) {
+	selectionPlan, err := c.makeSelectionPlan(ctx, txn, filter)
+	if err != nil {
+		return nil, err
+	}
+	err = selectionPlan.Init()
+	if err != nil {
+		return nil, err
+	}
+	if err = selectionPlan.Start(); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := selectionPlan.Close(); err != nil {
+			log.ErrorE(ctx, "Failed to close the selection plan, after filter upsert", err)
+		}
+	}()
+
+	docKeys := []string{}
+	for len(docKeys) < 2 {
+		next, err := selectionPlan.Next()
+		if err != nil {
+			return nil, err
+		}
+		if !next {
+			break
+		}
+		doc := selectionPlan.Value()
+		docKeys = append(docKeys, doc.GetKey())
+	}
+	return docKeys, nil
+}
@@ -18,10 +18,12 @@ import (
 	"context"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	blockstore "github.com/ipfs/boxo/blockstore"
 	ds "github.com/ipfs/go-datastore"
 	dsq "github.com/ipfs/go-datastore/query"
+	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/client"
@@ -31,6 +33,7 @@ import (
 	"github.com/sourcenetwork/defradb/events"
 	"github.com/sourcenetwork/defradb/lens"
 	"github.com/sourcenetwork/defradb/logging"
+	"github.com/sourcenetwork/defradb/merkle/clock"
 	"github.com/sourcenetwork/defradb/request/graphql"
 )
 
@@ -66,6 +69,30 @@ type db struct {
 	// The maximum number of cached migrations instances to preserve per schema version.
 	lensPoolSize immutable.Option[int]
 
+	// The maximum number of changes to retain in the change log of each collection.
+	changeLogRetention immutable.Option[uint64]
+
+	// changeLogMu ensures that only one routine sequences the change log at a time.
+	changeLogMu sync.Mutex
+
+	// The maximum number of attempts made to deliver a payload to a webhook.
+	webhookMaxAttempts immutable.Option[int]
+
+	// The delay before retrying a failed webhook delivery, doubled after each attempt.
+	webhookBackoff immutable.Option[time.Duration]
+
+	// webhooks holds the registered webhooks by ID.
+	webhooks   map[string]*webhook
+	webhookMu  sync.RWMutex
+	webhookWg  sync.WaitGroup
+	webhookCtx context.Context
+
+	// webhookCancel cancels any in-progress webhook deliveries.
+	webhookCancel context.CancelFunc
+
+	// The key used to sign the blocks created by this database, if any.
+	signingKey immutable.Option[crypto.PrivKey]
+
 	// The options used to init the database
 	options any
 
@@ -103,6 +130,37 @@ func WithLensPoolSize(num int) Option {
 	}
 }
 
+// WithChangeLogRetention sets the maximum number of changes to retain in the change log of
+// each collection. Once exceeded, the oldest changes will be removed from the log.
+//
+// Changes will be retained indefinitely if not set, or if set to `0`.
+func WithChangeLogRetention(num uint64) Option {
+	return func(db *db) {
+		db.changeLogRetention = immutable.Some(num)
+	}
+}
+
+// WithWebhookRetries sets the maximum number of attempts made to deliver a payload to a webhook,
+// and the delay before the first retry. The delay is doubled after each failed attempt.
+//
+// Will default to `5` attempts with an initial delay of `1s` if not set.
+func WithWebhookRetries(maxAttempts int, backoff time.Duration) Option {
+	return func(db *db) {
+		db.webhookMaxAttempts = immutable.Some(maxAttempts)
+		db.webhookBackoff = immutable.Some(backoff)
+	}
+}
+
+// WithSigningKey sets the key used to sign the blocks created by this database.
+//
+// This is typically the libp2p key of the node. Blocks without a signature are rejected
+// by the peers of the node.
+func WithSigningKey(key crypto.PrivKey) Option {
+	return func(db *db) {
+		db.signingKey = immutable.Some(key)
+	}
+}
+
 // NewDB creates a new instance of the DB using the given options.
 func NewDB(ctx context.Context, rootstore datastore.RootStore, options ...Option) (client.DB, error) {
 	return newDB(ctx, rootstore, options...)
@@ -123,7 +181,12 @@ func newDB(ctx context.Context, rootstore datastore.RootStore, options ...Option
 
 		parser:  parser,
 		options: options,
+
+		webhooks:           map[string]*webhook{},
+		webhookMaxAttempts: immutable.Some(defaultWebhookMaxAttempts),
+		webhookBackoff:     immutable.Some(defaultWebhookBackoff),
 	}
+	db.webhookCtx, db.webhookCancel = context.WithCancel(context.Background())
 
 	// apply options
 	for _, opt := range options {
@@ -142,6 +205,17 @@ func newDB(ctx context.Context, rootstore datastore.RootStore, options ...Option
 		return nil, err
 	}
 
+	// Changes committed before the last shutdown may not have been sequenced yet.
+	err = db.sequenceChanges(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.startWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &implicitTxnDB{db}, nil
 }
 
@@ -216,6 +290,16 @@ func (db *db) initialize(ctx context.Context) error {
 			return err
 		}
 
+		err = db.backfillDocVersions(ctx, txn)
+		if err != nil {
+			return err
+		}
+
+		err = db.backfillDocVersionTimes(ctx, txn)
+		if err != nil {
+			return err
+		}
+
 		// The query language types are only updated on successful commit
 		// so we must not forget to do so on success regardless of whether
 		// we have written to the datastores.
@@ -236,6 +320,16 @@ func (db *db) initialize(ctx context.Context) error {
 		return err
 	}
 
+	// New databases record all document versions by time so there is nothing to backfill.
+	err = txn.Systemstore().Put(ctx, ds.NewKey(core.DOC_VERSION_BACKFILLED), []byte{1})
+	if err != nil {
+		return err
+	}
+	err = txn.Systemstore().Put(ctx, ds.NewKey(core.DOC_VERSION_TIME_BACKFILLED), []byte{1})
+	if err != nil {
+		return err
+	}
+
 	return txn.Commit(ctx)
 }
 
@@ -253,6 +347,18 @@ func (db *db) MaxTxnRetries() int {
 	return defaultMaxTxnRetries
 }
 
+// withSigningKey returns a copy of the given context with which new blocks are signed using
+// the signing key of the database, unless the context already holds a signing key.
+func (db *db) withSigningKey(ctx context.Context) context.Context {
+	if !db.signingKey.HasValue() {
+		return ctx
+	}
+	if _, ok := clock.SigningKeyFromContext(ctx); ok {
+		return ctx
+	}
+	return clock.WithSigningKey(ctx, db.signingKey.Value())
+}
+
 // PrintDump prints the entire database to console.
 func (db *db) PrintDump(ctx context.Context) error {
 	return printStore(ctx, db.multistore.Rootstore())
@@ -262,9 +368,11 @@ func (db *db) PrintDump(ctx context.Context) error {
 // This is the place for any last minute cleanup or releasing of resources (i.e.: Badger instance).
 func (db *db) Close() {
 	log.Info(context.Background(), "Closing DefraDB process...")
+	db.webhookCancel()
 	if db.events.Updates.HasValue() {
 		db.events.Updates.Value().Close()
 	}
+	db.webhookWg.Wait()
 
 	err := db.rootstore.Close()
 	if err != nil {
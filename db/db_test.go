@@ -14,18 +14,35 @@ import (
 	"context"
 	"testing"
 
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 	badger "github.com/sourcenetwork/badger/v4"
+	"github.com/stretchr/testify/require"
 
+	"github.com/sourcenetwork/defradb/client"
 	badgerds "github.com/sourcenetwork/defradb/datastore/badger/v4"
 )
 
-func newMemoryDB(ctx context.Context) (*implicitTxnDB, error) {
+func newMemoryDB(ctx context.Context, options ...Option) (*implicitTxnDB, error) {
 	opts := badgerds.Options{Options: badger.DefaultOptions("").WithInMemory(true)}
 	rootstore, err := badgerds.NewDatastore("", &opts)
 	if err != nil {
 		return nil, err
 	}
-	return newDB(ctx, rootstore)
+	return newDB(ctx, rootstore, options...)
+}
+
+// newUserTestCollection creates a simple User collection in the given database.
+func newUserTestCollection(ctx context.Context, t *testing.T, db *implicitTxnDB) client.Collection {
+	_, err := db.AddSchema(ctx, `type User {
+		name: String
+		age: Int
+	}`)
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	return col
 }
 
 func TestNewDB(t *testing.T) {
@@ -42,3 +59,38 @@ func TestNewDB(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestNewDB_WithSigningKey_SignsCommits(t *testing.T) {
+	ctx := context.Background()
+	key, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	require.NoError(t, err)
+
+	rootstore, err := badgerds.NewDatastore("", &badgerds.Options{Options: badger.DefaultOptions("").WithInMemory(true)})
+	require.NoError(t, err)
+	db, err := newDB(ctx, rootstore, WithSigningKey(key))
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.AddSchema(ctx, `type User {
+		name: String
+	}`)
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John"}`))
+	require.NoError(t, err)
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	signer, err := peer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+
+	result := db.ExecRequest(ctx, `query { commits { signer } }`)
+	require.Empty(t, result.GQL.Errors)
+	require.Equal(t, []map[string]any{
+		{"signer": signer.String()},
+		{"signer": signer.String()},
+	}, result.GQL.Data)
+}
@@ -0,0 +1,48 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package description
+
+import (
+	"context"
+
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+// SaveEncryptionKey saves the key used to encrypt the values of the encrypted fields
+// of the collection with the given name, overwriting any pre-existing key.
+func SaveEncryptionKey(
+	ctx context.Context,
+	txn datastore.Txn,
+	collectionName string,
+	key []byte,
+) error {
+	return txn.Systemstore().Put(ctx, core.NewCollectionEncryptionKey(collectionName).ToDS(), key)
+}
+
+// GetEncryptionKey returns the key used to encrypt the values of the encrypted fields
+// of the collection with the given name.
+//
+// Nil is returned if no key has been saved.
+func GetEncryptionKey(
+	ctx context.Context,
+	txn datastore.Txn,
+	collectionName string,
+) ([]byte, error) {
+	key, err := txn.Systemstore().Get(ctx, core.NewCollectionEncryptionKey(collectionName).ToDS())
+	if errors.Is(err, ds.ErrNotFound) {
+		return nil, nil
+	}
+	return key, err
+}
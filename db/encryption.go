@@ -0,0 +1,115 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/db/description"
+)
+
+// SetEncryptionKey sets the key used to encrypt the values of the encrypted fields of the
+// collection with the given name.
+//
+// An error is returned if a key has already been set, as the values encrypted with it would
+// no longer be readable.
+func (db *db) SetEncryptionKey(ctx context.Context, collectionName string, key []byte) error {
+	switch len(key) {
+	case 16, 24, 32:
+	default:
+		return ErrInvalidEncryptionKey
+	}
+
+	txn, err := db.NewTxn(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+
+	_, err = db.getCollectionByName(ctx, txn, collectionName)
+	if err != nil {
+		return err
+	}
+	existing, err := description.GetEncryptionKey(ctx, txn, collectionName)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return NewErrEncryptionKeyAlreadySet(collectionName)
+	}
+	err = description.SaveEncryptionKey(ctx, txn, collectionName, key)
+	if err != nil {
+		return err
+	}
+
+	return txn.Commit(ctx)
+}
+
+// GetEncryptionKey returns the key used to encrypt the values of the encrypted fields of the
+// collection with the given name, or nil if none has been set.
+func (db *db) GetEncryptionKey(ctx context.Context, collectionName string) ([]byte, error) {
+	txn, err := db.NewTxn(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Discard(ctx)
+
+	_, err = db.getCollectionByName(ctx, txn, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	return description.GetEncryptionKey(ctx, txn, collectionName)
+}
+
+// getEncryptionKey returns the key used to encrypt the values of the encrypted fields of
+// this collection, returning an error if none has been set.
+func (c *collection) getEncryptionKey(ctx context.Context, txn datastore.Txn) ([]byte, error) {
+	key, err := description.GetEncryptionKey(ctx, txn, c.Name())
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, NewErrMissingEncryptionKey(c.Name())
+	}
+	return key, nil
+}
+
+// encryptFieldValue returns a value holding the encoded given value encrypted with the key of
+// this collection.
+//
+// The returned value is written both to the field DAG and to the composite DAG so that
+// replicated blocks only carry its ciphertext.
+func (c *collection) encryptFieldValue(
+	ctx context.Context,
+	txn datastore.Txn,
+	val client.Value,
+) (client.Value, error) {
+	wval, ok := val.(client.WriteableValue)
+	if !ok {
+		return nil, client.ErrValueTypeMismatch
+	}
+	plaintext, err := wval.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	key, err := c.getEncryptionKey(ctx, txn)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := core.EncryptFieldValue(key, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	return client.NewCBORValue(val.Type(), ciphertext), nil
+}
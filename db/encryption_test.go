@@ -0,0 +1,186 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+)
+
+var testEncryptionKey = []byte("0123456789abcdef0123456789abcdef")
+
+func newEncryptedUserCollection(ctx context.Context, t *testing.T, db *implicitTxnDB) client.Collection {
+	_, err := db.AddSchema(ctx, `type User {
+		name: String
+		ssn: String @encrypted
+	}`)
+	require.NoError(t, err)
+
+	col, err := db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	return col
+}
+
+func TestSetEncryptionKey_WithInvalidKey_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	newEncryptedUserCollection(ctx, t, db)
+
+	err = db.SetEncryptionKey(ctx, "User", []byte("short"))
+	require.ErrorIs(t, err, ErrInvalidEncryptionKey)
+}
+
+func TestSetEncryptionKey_WithUnknownCollection_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+
+	err = db.SetEncryptionKey(ctx, "User", testEncryptionKey)
+	require.Error(t, err)
+}
+
+func TestSetEncryptionKey_WithExistingKey_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	newEncryptedUserCollection(ctx, t, db)
+
+	err = db.SetEncryptionKey(ctx, "User", testEncryptionKey)
+	require.NoError(t, err)
+
+	err = db.SetEncryptionKey(ctx, "User", []byte("fedcba9876543210"))
+	require.ErrorIs(t, err, ErrEncryptionKeyAlreadySet)
+
+	key, err := db.GetEncryptionKey(ctx, "User")
+	require.NoError(t, err)
+	require.Equal(t, testEncryptionKey, key)
+}
+
+func TestGetEncryptionKey_WithoutKey_ReturnsNil(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	newEncryptedUserCollection(ctx, t, db)
+
+	key, err := db.GetEncryptionKey(ctx, "User")
+	require.NoError(t, err)
+	require.Nil(t, key)
+
+	err = db.SetEncryptionKey(ctx, "User", testEncryptionKey)
+	require.NoError(t, err)
+
+	key, err = db.GetEncryptionKey(ctx, "User")
+	require.NoError(t, err)
+	require.Equal(t, testEncryptionKey, key)
+}
+
+func TestCreate_WithEncryptedFieldWithoutKey_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	col := newEncryptedUserCollection(ctx, t, db)
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John", "ssn": "123-45-6789"}`))
+	require.NoError(t, err)
+	err = col.Create(ctx, doc)
+	require.ErrorIs(t, err, ErrMissingEncryptionKey)
+}
+
+func TestCreate_WithEncryptedField_WritesCiphertextToDAG(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	col := newEncryptedUserCollection(ctx, t, db)
+
+	err = db.SetEncryptionKey(ctx, "User", testEncryptionKey)
+	require.NoError(t, err)
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John", "ssn": "123-45-6789"}`))
+	require.NoError(t, err)
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	fetched, err := col.Get(ctx, doc.Key(), false)
+	require.NoError(t, err)
+	ssn, err := fetched.Get("ssn")
+	require.NoError(t, err)
+	require.Equal(t, "123-45-6789", ssn)
+
+	blocks, err := db.Blockstore().AllKeysChan(ctx)
+	require.NoError(t, err)
+	var blockCount int
+	for c := range blocks {
+		block, err := db.Blockstore().Get(ctx, c)
+		require.NoError(t, err)
+		require.False(t, bytes.Contains(block.RawData(), []byte("123-45-6789")))
+		blockCount++
+	}
+	require.Equal(t, 3, blockCount)
+}
+
+func TestGet_WithEncryptedFieldWithoutKey_ReturnsNilValue(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	col := newEncryptedUserCollection(ctx, t, db)
+
+	err = db.SetEncryptionKey(ctx, "User", testEncryptionKey)
+	require.NoError(t, err)
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John", "ssn": "123-45-6789"}`))
+	require.NoError(t, err)
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	// Simulate a node that replicates the collection without holding its key.
+	txn, err := db.NewTxn(ctx, false)
+	require.NoError(t, err)
+	err = txn.Systemstore().Delete(ctx, core.NewCollectionEncryptionKey("User").ToDS())
+	require.NoError(t, err)
+	err = txn.Commit(ctx)
+	require.NoError(t, err)
+
+	result := db.ExecRequest(ctx, `query { User { name ssn } }`)
+	require.Empty(t, result.GQL.Errors)
+	require.Equal(t, []map[string]any{
+		{"name": "John", "ssn": nil},
+	}, result.GQL.Data)
+}
+
+func TestRequest_WithFilterOnEncryptedField_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	newEncryptedUserCollection(ctx, t, db)
+
+	result := db.ExecRequest(ctx, `query { User(filter: {ssn: {_eq: "123-45-6789"}}) { name } }`)
+	require.Len(t, result.GQL.Errors, 1)
+	require.ErrorContains(t, result.GQL.Errors[0], "cannot filter on encrypted field")
+}
+
+func TestCreateIndex_WithEncryptedField_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	col := newEncryptedUserCollection(ctx, t, db)
+
+	_, err = col.CreateIndex(ctx, client.IndexDescription{
+		Fields: []client.IndexedFieldDescription{{Name: "ssn", Direction: client.Ascending}},
+	})
+	require.ErrorIs(t, err, ErrCannotIndexEncryptedField)
+}
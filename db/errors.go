@@ -75,10 +75,16 @@ const (
 	errCreateFile                         string = "failed to create file"
 	errOpenFile                           string = "failed to open file"
 	errCloseFile                          string = "failed to close file"
+	errInvalidSnapshot                    string = "invalid snapshot file"
+	errSnapshotChecksumMismatch           string = "snapshot checksum does not match its contents"
+	errRestoreTargetNotEmpty              string = "snapshots can only be restored to an empty datastore"
 	errRemoveFile                         string = "failed to remove file"
 	errFailedToReadByte                   string = "failed to read byte"
 	errFailedToWriteString                string = "failed to write string"
 	errJSONDecode                         string = "failed to decode JSON"
+	errCBORDecode                         string = "failed to decode CBOR"
+	errInvalidBackupFormat                string = "invalid backup format"
+	errInvalidBackupCompression           string = "invalid backup compression"
 	errDocFromMap                         string = "failed to create a new doc from map"
 	errDocCreate                          string = "failed to save a new doc to collection"
 	errDocUpdate                          string = "failed to update doc to collection"
@@ -86,6 +92,41 @@ const (
 	errExpectedJSONArray                  string = "expected JSON array"
 	errOneOneAlreadyLinked                string = "target document is already linked to another document"
 	errIndexDoesNotMatchName              string = "the index used does not match the given name"
+	errInvalidWebhookURL                  string = "invalid webhook URL, expected an http or https URL"
+	errInvalidWebhookFilter               string = "invalid webhook filter"
+	errWebhookNotFound                    string = "webhook not found"
+	errWebhookResponseStatus              string = "webhook responded with unsuccessful status"
+	errWebhooksRequireUpdateEvents        string = "webhooks require update events to be enabled"
+	errWebhookQueueFull                   string = "webhook queue is full"
+	errInvalidDocumentVersion             string = "version is not a composite commit of the document"
+	errInvalidPolicyRole                  string = "invalid policy role"
+	errUnknownPolicyPermission            string = "unknown policy permission"
+	errUnknownPolicyRole                  string = "unknown policy role"
+	errPolicyWithoutAdmin                 string = "policy must grant the admin permission to at least one identity"
+	errInvalidDocumentPolicy              string = "invalid document policy"
+	errInvalidEncryptionKey               string = "encryption key must be 16, 24 or 32 bytes long"
+	errMissingEncryptionKey               string = "missing encryption key for collection with encrypted fields"
+	errEncryptionKeyAlreadySet            string = "an encryption key has already been set for the collection"
+	errEncryptedRelationField             string = "relation fields cannot be encrypted"
+	errCannotIndexEncryptedField          string = "encrypted fields cannot be indexed"
+	errInvalidImportFormat                string = "invalid import format"
+	errInvalidImportRelation              string = "import relations must be single relation fields"
+	errImportRelationWithoutNaturalKey    string = "relation field imported without a natural key"
+	errImportRowInTxn                     string = "failed to import a row within a transaction"
+	errNaturalKeyNotFound                 string = "no document matches the natural key"
+	errNaturalKeyNotUnique                string = "more than one document matches the natural key"
+	errInvalidDateTime                    string = "invalid DateTime, expected an RFC3339 timestamp"
+	errRequiredFieldMissing               string = "required field is missing a value"
+	errInvalidDefaultValue                string = "invalid default value for field"
+	errDefaultRelationField               string = "relation fields cannot have a default value"
+	errRequiredFieldWithoutDefault        string = "required field added without a default value or a migration"
+	errExistingDocConstraintViolation     string = "existing document violates field constraint"
+	errInvalidEnumValue                   string = "value does not belong to the enum of the field"
+	errEnumNotFound                       string = "enum not found"
+	errCannotRemoveEnumValue              string = "enum values cannot be removed or reordered"
+	errDuplicateEnumValue                 string = "duplicate enum value"
+	errJSONIndexWithoutPath               string = "JSON fields can only be indexed by path"
+	errIndexPathOnNonJSONField            string = "index paths are only supported on JSON fields"
 )
 
 var (
@@ -153,10 +194,16 @@ var (
 	ErrCreateFile                         = errors.New(errCreateFile)
 	ErrOpenFile                           = errors.New(errOpenFile)
 	ErrCloseFile                          = errors.New(errCloseFile)
+	ErrInvalidSnapshot                    = errors.New(errInvalidSnapshot)
+	ErrSnapshotChecksumMismatch           = errors.New(errSnapshotChecksumMismatch)
+	ErrRestoreTargetNotEmpty              = errors.New(errRestoreTargetNotEmpty)
 	ErrRemoveFile                         = errors.New(errRemoveFile)
 	ErrFailedToReadByte                   = errors.New(errFailedToReadByte)
 	ErrFailedToWriteString                = errors.New(errFailedToWriteString)
 	ErrJSONDecode                         = errors.New(errJSONDecode)
+	ErrCBORDecode                         = errors.New(errCBORDecode)
+	ErrInvalidBackupFormat                = errors.New(errInvalidBackupFormat)
+	ErrInvalidBackupCompression           = errors.New(errInvalidBackupCompression)
 	ErrDocFromMap                         = errors.New(errDocFromMap)
 	ErrDocCreate                          = errors.New(errDocCreate)
 	ErrDocUpdate                          = errors.New(errDocUpdate)
@@ -164,6 +211,35 @@ var (
 	ErrExpectedJSONArray                  = errors.New(errExpectedJSONArray)
 	ErrOneOneAlreadyLinked                = errors.New(errOneOneAlreadyLinked)
 	ErrIndexDoesNotMatchName              = errors.New(errIndexDoesNotMatchName)
+	ErrInvalidPolicyRole                  = errors.New(errInvalidPolicyRole)
+	ErrUnknownPolicyPermission            = errors.New(errUnknownPolicyPermission)
+	ErrUnknownPolicyRole                  = errors.New(errUnknownPolicyRole)
+	ErrPolicyWithoutAdmin                 = errors.New(errPolicyWithoutAdmin)
+	ErrInvalidDocumentPolicy              = errors.New(errInvalidDocumentPolicy)
+	ErrInvalidEncryptionKey               = errors.New(errInvalidEncryptionKey)
+	ErrMissingEncryptionKey               = errors.New(errMissingEncryptionKey)
+	ErrEncryptionKeyAlreadySet            = errors.New(errEncryptionKeyAlreadySet)
+	ErrEncryptedRelationField             = errors.New(errEncryptedRelationField)
+	ErrCannotIndexEncryptedField          = errors.New(errCannotIndexEncryptedField)
+	ErrInvalidImportFormat                = errors.New(errInvalidImportFormat)
+	ErrInvalidImportRelation              = errors.New(errInvalidImportRelation)
+	ErrImportRelationWithoutNaturalKey    = errors.New(errImportRelationWithoutNaturalKey)
+	ErrImportRowInTxn                     = errors.New(errImportRowInTxn)
+	ErrNaturalKeyNotFound                 = errors.New(errNaturalKeyNotFound)
+	ErrNaturalKeyNotUnique                = errors.New(errNaturalKeyNotUnique)
+	ErrInvalidDateTime                    = errors.New(errInvalidDateTime)
+	ErrRequiredFieldMissing               = errors.New(errRequiredFieldMissing)
+	ErrInvalidDefaultValue                = errors.New(errInvalidDefaultValue)
+	ErrDefaultRelationField               = errors.New(errDefaultRelationField)
+	ErrRequiredFieldWithoutDefault        = errors.New(errRequiredFieldWithoutDefault)
+	ErrExistingDocConstraintViolation     = errors.New(errExistingDocConstraintViolation)
+	ErrInvalidEnumValue                   = errors.New(errInvalidEnumValue)
+	ErrEnumNotFound                       = errors.New(errEnumNotFound)
+	ErrCannotRemoveEnumValue              = errors.New(errCannotRemoveEnumValue)
+	ErrDuplicateEnumValue                 = errors.New(errDuplicateEnumValue)
+	ErrJSONIndexWithoutPath               = errors.New(errJSONIndexWithoutPath)
+	ErrIndexPathOnNonJSONField            = errors.New(errIndexPathOnNonJSONField)
+	ErrWebhooksRequireUpdateEvents        = errors.New(errWebhooksRequireUpdateEvents)
 )
 
 // NewErrFieldOrAliasToFieldNotExist returns an error indicating that the given field or an alias field does not exist.
@@ -597,6 +673,21 @@ func NewErrJSONDecode(inner error) error {
 	return errors.Wrap(errJSONDecode, inner)
 }
 
+// NewErrCBORDecode returns a new error indicating that the CBOR decoding failed.
+func NewErrCBORDecode(inner error) error {
+	return errors.Wrap(errCBORDecode, inner)
+}
+
+// NewErrInvalidBackupFormat returns a new error indicating the backup format is not supported.
+func NewErrInvalidBackupFormat(format string) error {
+	return errors.New(errInvalidBackupFormat, errors.NewKV("Format", format))
+}
+
+// NewErrInvalidBackupCompression returns a new error indicating the backup compression is not supported.
+func NewErrInvalidBackupCompression(compression string) error {
+	return errors.New(errInvalidBackupCompression, errors.NewKV("Compression", compression))
+}
+
 // NewErrDocFromMap returns a new error indicating there was a failure to create
 // a new doc from a map
 func NewErrDocFromMap(inner error) error {
@@ -631,3 +722,211 @@ func NewErrIndexDoesNotMatchName(index, name string) error {
 		errors.NewKV("Name", name),
 	)
 }
+
+func NewErrInvalidWebhookURL(url string, inner error) error {
+	return errors.Wrap(errInvalidWebhookURL, inner, errors.NewKV("URL", url))
+}
+
+func NewErrInvalidWebhookFilter(filter string, inner error) error {
+	return errors.Wrap(errInvalidWebhookFilter, inner, errors.NewKV("Filter", filter))
+}
+
+func NewErrWebhookNotFound(id string) error {
+	return errors.New(errWebhookNotFound, errors.NewKV("ID", id))
+}
+
+func NewErrWebhookQueueFull(id string) error {
+	return errors.New(errWebhookQueueFull, errors.NewKV("ID", id))
+}
+
+func NewErrWebhookResponseStatus(status int) error {
+	return errors.New(errWebhookResponseStatus, errors.NewKV("Status", status))
+}
+
+func NewErrInvalidDocumentVersion(version string, docKey string) error {
+	return errors.New(
+		errInvalidDocumentVersion,
+		errors.NewKV("Version", version),
+		errors.NewKV("DocKey", docKey),
+	)
+}
+
+func NewErrInvalidPolicyRole(name string) error {
+	return errors.New(errInvalidPolicyRole, errors.NewKV("Name", name))
+}
+
+func NewErrUnknownPolicyPermission(role string, permission client.Permission) error {
+	return errors.New(
+		errUnknownPolicyPermission,
+		errors.NewKV("Role", role),
+		errors.NewKV("Permission", permission),
+	)
+}
+
+func NewErrUnknownPolicyRole(identity string, role string) error {
+	return errors.New(
+		errUnknownPolicyRole,
+		errors.NewKV("Identity", identity),
+		errors.NewKV("Role", role),
+	)
+}
+
+func NewErrUnknownDocumentPolicyRole(collection string, role string) error {
+	return errors.New(
+		errUnknownPolicyRole,
+		errors.NewKV("Collection", collection),
+		errors.NewKV("Role", role),
+	)
+}
+
+func NewErrInvalidDocumentPolicy(collection string) error {
+	return errors.New(errInvalidDocumentPolicy, errors.NewKV("Collection", collection))
+}
+
+func NewErrMissingEncryptionKey(collection string) error {
+	return errors.New(errMissingEncryptionKey, errors.NewKV("Collection", collection))
+}
+
+func NewErrEncryptionKeyAlreadySet(collection string) error {
+	return errors.New(errEncryptionKeyAlreadySet, errors.NewKV("Collection", collection))
+}
+
+func NewErrEncryptedRelationField(name string) error {
+	return errors.New(errEncryptedRelationField, errors.NewKV("Field", name))
+}
+
+func NewErrCannotIndexEncryptedField(name string) error {
+	return errors.New(errCannotIndexEncryptedField, errors.NewKV("Field", name))
+}
+
+// NewErrInvalidSnapshot returns a new error indicating the snapshot file could not be read.
+func NewErrInvalidSnapshot(inner error, filepath string) error {
+	return errors.Wrap(errInvalidSnapshot, inner, errors.NewKV("Filepath", filepath))
+}
+
+// NewErrSnapshotChecksumMismatch returns a new error indicating the snapshot file is corrupted.
+func NewErrSnapshotChecksumMismatch(filepath string, expected, actual string) error {
+	return errors.New(
+		errSnapshotChecksumMismatch,
+		errors.NewKV("Filepath", filepath),
+		errors.NewKV("Expected", expected),
+		errors.NewKV("Actual", actual),
+	)
+}
+
+// NewErrInvalidImportFormat returns a new error indicating the import format is not supported.
+func NewErrInvalidImportFormat(format string) error {
+	return errors.New(errInvalidImportFormat, errors.NewKV("Format", format))
+}
+
+// NewErrInvalidImportRelation returns a new error indicating the given field cannot be
+// imported by natural key, or the natural key is not a field of the related collection.
+func NewErrInvalidImportRelation(field string, naturalKey string) error {
+	return errors.New(
+		errInvalidImportRelation,
+		errors.NewKV("Field", field),
+		errors.NewKV("NaturalKey", naturalKey),
+	)
+}
+
+// NewErrImportRelationWithoutNaturalKey returns a new error indicating a value was imported
+// into a relation field that has no natural key configured.
+func NewErrImportRelationWithoutNaturalKey(field string) error {
+	return errors.New(errImportRelationWithoutNaturalKey, errors.NewKV("Field", field))
+}
+
+// NewErrImportRowInTxn returns a new error indicating the given row failed to be created
+// within an explicit transaction, which may hold its partial writes.
+func NewErrImportRowInTxn(row uint64, inner error) error {
+	return errors.Wrap(errImportRowInTxn, inner, errors.NewKV("Row", row))
+}
+
+// NewErrNaturalKeyNotFound returns a new error indicating no related document has the given natural key.
+func NewErrNaturalKeyNotFound(field string, value string) error {
+	return errors.New(errNaturalKeyNotFound, errors.NewKV("Field", field), errors.NewKV("Value", value))
+}
+
+// NewErrNaturalKeyNotUnique returns a new error indicating several related documents have the
+// given natural key.
+func NewErrNaturalKeyNotUnique(field string, value string) error {
+	return errors.New(errNaturalKeyNotUnique, errors.NewKV("Field", field), errors.NewKV("Value", value))
+}
+
+// NewErrInvalidDateTime returns a new error indicating the given value is not a valid DateTime.
+func NewErrInvalidDateTime(inner error, field string) error {
+	return errors.Wrap(errInvalidDateTime, inner, errors.NewKV("Field", field))
+}
+
+// NewErrRequiredFieldMissing returns a new error indicating a required field has no value.
+func NewErrRequiredFieldMissing(field string) error {
+	return errors.New(errRequiredFieldMissing, errors.NewKV("Field", field))
+}
+
+// NewErrInvalidDefaultValue returns a new error indicating the default value of the given field
+// does not match its kind.
+func NewErrInvalidDefaultValue(inner error, field string) error {
+	return errors.Wrap(errInvalidDefaultValue, inner, errors.NewKV("Field", field))
+}
+
+// NewErrDefaultRelationField returns a new error indicating a default value was given to a
+// relation field.
+func NewErrDefaultRelationField(field string) error {
+	return errors.New(errDefaultRelationField, errors.NewKV("Field", field))
+}
+
+// NewErrRequiredFieldWithoutDefault returns a new error indicating a required field was added to
+// a schema without a default value, or a migration setting the value of the existing documents.
+func NewErrRequiredFieldWithoutDefault(field string, schemaVersionID string) error {
+	return errors.New(
+		errRequiredFieldWithoutDefault,
+		errors.NewKV("Field", field),
+		errors.NewKV("SchemaVersionID", schemaVersionID),
+	)
+}
+
+// NewErrExistingDocConstraintViolation returns a new error indicating an existing document
+// violates a constraint changed by a schema update.
+func NewErrExistingDocConstraintViolation(inner error, docKey string) error {
+	return errors.Wrap(errExistingDocConstraintViolation, inner, errors.NewKV("DocKey", docKey))
+}
+
+// NewErrInvalidEnumValue returns a new error indicating the given value does not belong to
+// the enum of the given field.
+func NewErrInvalidEnumValue(field string, enum string, value any) error {
+	return errors.New(
+		errInvalidEnumValue,
+		errors.NewKV("Field", field),
+		errors.NewKV("Enum", enum),
+		errors.NewKV("Value", value),
+	)
+}
+
+// NewErrEnumNotFound returns a new error indicating the enum of the given field is not
+// declared by the schema.
+func NewErrEnumNotFound(field string, enum string) error {
+	return errors.New(errEnumNotFound, errors.NewKV("Field", field), errors.NewKV("Enum", enum))
+}
+
+// NewErrCannotRemoveEnumValue returns a new error indicating a schema update removed or
+// reordered the existing values of the given enum.
+func NewErrCannotRemoveEnumValue(enum string) error {
+	return errors.New(errCannotRemoveEnumValue, errors.NewKV("Enum", enum))
+}
+
+// NewErrDuplicateEnumValue returns a new error indicating the given enum declares the given
+// value more than once.
+func NewErrDuplicateEnumValue(enum string, value string) error {
+	return errors.New(errDuplicateEnumValue, errors.NewKV("Enum", enum), errors.NewKV("Value", value))
+}
+
+// NewErrJSONIndexWithoutPath returns a new error indicating an index on the given JSON field
+// has no path.
+func NewErrJSONIndexWithoutPath(field string) error {
+	return errors.New(errJSONIndexWithoutPath, errors.NewKV("Field", field))
+}
+
+// NewErrIndexPathOnNonJSONField returns a new error indicating an index on the given field has
+// a path while the field is not a JSON field.
+func NewErrIndexPathOnNonJSONField(field string) error {
+	return errors.New(errIndexPathOnNonJSONField, errors.NewKV("Field", field))
+}
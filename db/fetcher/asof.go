@@ -0,0 +1,313 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package fetcher
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	dag "github.com/ipfs/boxo/ipld/merkledag"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore/query"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/core/crdt"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/merkle/clock"
+	"github.com/sourcenetwork/defradb/planner/mapper"
+)
+
+var (
+	// interface check
+	_ Fetcher = (*AsOfFetcher)(nil)
+)
+
+// AsOfFetcher returns the documents of a collection as they were at a given point
+// in their history.
+//
+// The point may be given either as a commit height, in which case each document is
+// returned at the state composed of all its commits at or below that height, or as
+// a time, in which case each document is returned at the state composed of all the
+// commits recorded by the local node at or before that time.
+//
+// The state of each document is reconstructed from its Merkle DAG by a [VersionedFetcher],
+// so filters are applied to the historical state, not the current one. Documents that
+// did not exist at the given point are not returned.
+type AsOfFetcher struct {
+	asOf request.AsOf
+
+	txn         datastore.Txn
+	col         client.Collection
+	fields      []client.FieldDescription
+	filter      *mapper.Filter
+	docMapper   *core.DocumentMapping
+	reverse     bool
+	showDeleted bool
+
+	docKeys []string
+	current *VersionedFetcher
+}
+
+// NewAsOfFetcher creates a new fetcher that reads documents at the given point in history.
+func NewAsOfFetcher(asOf request.AsOf) *AsOfFetcher {
+	return &AsOfFetcher{asOf: asOf}
+}
+
+func (f *AsOfFetcher) Init(
+	ctx context.Context,
+	txn datastore.Txn,
+	col client.Collection,
+	fields []client.FieldDescription,
+	filter *mapper.Filter,
+	docmapper *core.DocumentMapping,
+	reverse bool,
+	showDeleted bool,
+) error {
+	f.txn = txn
+	f.col = col
+	f.fields = fields
+	f.filter = filter
+	f.docMapper = docmapper
+	f.reverse = reverse
+	f.showDeleted = showDeleted
+	return nil
+}
+
+// Start collects the keys of the documents to read.
+//
+// If the given spans target specific documents only those will be read, otherwise
+// all the documents of the collection will be.
+func (f *AsOfFetcher) Start(ctx context.Context, spans core.Spans) error {
+	f.docKeys = nil
+	for _, span := range spans.Value {
+		if span.Start().DocKey != "" {
+			f.docKeys = append(f.docKeys, span.Start().DocKey)
+		}
+	}
+	if len(f.docKeys) > 0 {
+		return nil
+	}
+
+	prefix := core.PrimaryDataStoreKey{CollectionId: fmt.Sprint(f.col.ID())}
+	results, err := f.txn.Datastore().Query(ctx, query.Query{
+		Prefix:   prefix.ToString() + "/",
+		KeysOnly: true,
+		Orders:   []query.Order{query.OrderByKey{}},
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = results.Close()
+	}()
+
+	for res := range results.Next() {
+		if res.Error != nil {
+			return res.Error
+		}
+		key, err := core.NewDataStoreKey(res.Key)
+		if err != nil {
+			return err
+		}
+		f.docKeys = append(f.docKeys, key.DocKey)
+	}
+
+	if f.reverse {
+		for i, j := 0, len(f.docKeys)-1; i < j; i, j = i+1, j-1 {
+			f.docKeys[i], f.docKeys[j] = f.docKeys[j], f.docKeys[i]
+		}
+	}
+	return nil
+}
+
+func (f *AsOfFetcher) FetchNext(ctx context.Context) (EncodedDocument, ExecInfo, error) {
+	for {
+		if f.current != nil {
+			doc, execInfo, err := f.current.FetchNext(ctx)
+			if err != nil || doc != nil {
+				return doc, execInfo, err
+			}
+			err = f.current.Close()
+			if err != nil {
+				return nil, ExecInfo{}, err
+			}
+			f.current = nil
+		}
+
+		if len(f.docKeys) == 0 {
+			return nil, ExecInfo{}, nil
+		}
+		docKey := f.docKeys[0]
+		f.docKeys = f.docKeys[1:]
+
+		err := f.startDoc(ctx, docKey)
+		if err != nil {
+			return nil, ExecInfo{}, err
+		}
+	}
+}
+
+func (f *AsOfFetcher) Close() error {
+	if f.current != nil {
+		return f.current.Close()
+	}
+	return nil
+}
+
+// startDoc reconstructs the state of the given document at the target point in history,
+// leaving the current fetcher unset if the document did not exist at that point.
+func (f *AsOfFetcher) startDoc(ctx context.Context, docKey string) error {
+	var targets []cid.Cid
+	var err error
+	if f.asOf.Height.HasValue() {
+		targets, err = f.targetsByHeight(ctx, docKey, f.asOf.Height.Value())
+	} else {
+		targets, err = f.targetsByTime(ctx, docKey, f.asOf.Time.Value().UnixNano())
+	}
+	if err != nil || len(targets) == 0 {
+		return err
+	}
+
+	vf := new(VersionedFetcher)
+	err = vf.Init(ctx, f.txn, f.col, f.fields, f.filter, f.docMapper, f.reverse, f.showDeleted)
+	if err != nil {
+		return err
+	}
+	// The fetcher is set as current straight away so that it is released on Close
+	// should seeking fail.
+	f.current = vf
+
+	err = vf.Start(ctx, NewVersionedSpan(core.DataStoreKey{DocKey: docKey}, targets[0]))
+	if err != nil {
+		return err
+	}
+	// Blocks already merged into the transient store are skipped, so seeking to any
+	// remaining targets only merges the commits that were made concurrently.
+	for _, target := range targets[1:] {
+		err = vf.SeekTo(ctx, target)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// targetsByHeight returns the CIDs of the highest composite commits of the given document
+// that are at or below the given height, highest first.
+func (f *AsOfFetcher) targetsByHeight(ctx context.Context, docKey string, height uint64) ([]cid.Cid, error) {
+	headKey := core.DataStoreKey{
+		CollectionID: fmt.Sprint(f.col.ID()),
+		DocKey:       docKey,
+		FieldId:      core.COMPOSITE_NAMESPACE,
+	}
+	heads, _, err := clock.NewHeadSet(f.txn.Headstore(), headKey.ToHeadStoreKey()).List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	type target struct {
+		cid    cid.Cid
+		height uint64
+	}
+	var targets []target
+	visited := map[cid.Cid]struct{}{}
+	queue := heads
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+		if _, ok := visited[c]; ok {
+			continue
+		}
+		visited[c] = struct{}{}
+
+		blk, err := f.txn.DAGstore().Get(ctx, c)
+		if err != nil {
+			return nil, NewErrVFetcherFailedToGetBlock(err)
+		}
+		nd, err := dag.DecodeProtobuf(blk.RawData())
+		if err != nil {
+			return nil, NewErrVFetcherFailedToDecodeNode(err)
+		}
+		delta, err := crdt.CompositeDAG{}.DeltaDecode(nd)
+		if err != nil {
+			return nil, err
+		}
+
+		if delta.GetPriority() <= height {
+			// The ancestors of this commit are all lower, and will be merged
+			// when seeking to it.
+			targets = append(targets, target{cid: c, height: delta.GetPriority()})
+			continue
+		}
+		for _, link := range nd.Links() {
+			if link.Name == core.HEAD {
+				queue = append(queue, link.Cid)
+			}
+		}
+	}
+
+	sort.Slice(targets, func(i, j int) bool {
+		if targets[i].height != targets[j].height {
+			return targets[i].height > targets[j].height
+		}
+		return targets[i].cid.String() < targets[j].cid.String()
+	})
+	cids := make([]cid.Cid, len(targets))
+	for i, t := range targets {
+		cids[i] = t.cid
+	}
+	return cids, nil
+}
+
+// targetsByTime returns the CIDs of the composite commits of the given document that were
+// recorded at or before the given time (in unix nanoseconds), most recent first.
+func (f *AsOfFetcher) targetsByTime(ctx context.Context, docKey string, t int64) ([]cid.Cid, error) {
+	prefix := core.NewDocVersionKey(f.col.ID(), docKey, 0, "")
+	results, err := f.txn.Systemstore().Query(ctx, query.Query{
+		Prefix:   prefix.ToString() + "/",
+		KeysOnly: true,
+		Orders:   []query.Order{query.OrderByKey{}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = results.Close()
+	}()
+
+	var cids []cid.Cid
+	for res := range results.Next() {
+		if res.Error != nil {
+			return nil, res.Error
+		}
+		key, err := core.NewDocVersionKeyFromString(res.Key)
+		if err != nil {
+			return nil, err
+		}
+		if key.Time > t {
+			break
+		}
+		c, err := cid.Decode(key.Cid)
+		if err != nil {
+			return nil, NewErrFailedToDecodeCIDForVFetcher(err)
+		}
+		cids = append(cids, c)
+	}
+
+	// Reverse so that the most recent version is first.
+	for i, j := 0, len(cids)-1; i < j; i, j = i+1, j-1 {
+		cids[i], cids[j] = cids[j], cids[i]
+	}
+	return cids, nil
+}
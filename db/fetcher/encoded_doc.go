@@ -11,6 +11,8 @@
 package fetcher
 
 import (
+	"encoding/json"
+
 	"github.com/bits-and-blooms/bitset"
 	"github.com/fxamacker/cbor/v2"
 
@@ -44,14 +46,49 @@ type encProperty struct {
 	// is needed for eager filter evaluation
 	IsFilter bool
 
+	// The key used to decrypt the value of an encrypted field.
+	encryptionKey []byte
+
+	// IsDefault is true if the field holds no value and the property is the default value
+	// of its field.
+	IsDefault bool
+
 	// // encoding meta data
 	// encoding base.DataEncoding
 }
 
 // Decode returns the decoded value and CRDT type for the given property.
+//
+// The value of an encrypted field is decrypted first, it decodes to nil if the key of its
+// collection is not known.
 func (e encProperty) Decode() (any, error) {
+	if e.IsDefault {
+		var val any
+		err := json.Unmarshal([]byte(e.Desc.DefaultValue), &val)
+		if err != nil {
+			return nil, err
+		}
+		return core.DecodeFieldValue(e.Desc, val)
+	}
+
+	raw := e.Raw
+	if e.Desc.IsEncrypted {
+		if e.encryptionKey == nil {
+			return nil, nil
+		}
+		var ciphertext []byte
+		err := cbor.Unmarshal(e.Raw, &ciphertext)
+		if err != nil {
+			return nil, err
+		}
+		raw, err = core.DecryptFieldValue(e.encryptionKey, ciphertext)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var val any
-	err := cbor.Unmarshal(e.Raw, &val)
+	err := cbor.Unmarshal(raw, &val)
 	if err != nil {
 		return nil, err
 	}
@@ -26,6 +26,8 @@ const (
 	errVFetcherFailedToGetDagLink   string = "(version fetcher) failed to get node link from DAG"
 	errFailedToGetDagNode           string = "failed to get DAG Node"
 	errMissingMapper                string = "missing document mapper"
+	errFilterOnEncryptedField       string = "cannot filter on encrypted field"
+	errJSONPathIndexNotFound        string = "no JSON path index resolves the filter of the field"
 )
 
 var (
@@ -40,7 +42,9 @@ var (
 	ErrVFetcherFailedToGetDagLink   = errors.New(errVFetcherFailedToGetDagLink)
 	ErrFailedToGetDagNode           = errors.New(errFailedToGetDagNode)
 	ErrMissingMapper                = errors.New(errMissingMapper)
+	ErrFilterOnEncryptedField       = errors.New(errFilterOnEncryptedField)
 	ErrSingleSpanOnly               = errors.New("spans must contain only a single entry")
+	ErrJSONPathIndexNotFound        = errors.New(errJSONPathIndexNotFound)
 )
 
 // NewErrFieldIdNotFound returns an error indicating that the given FieldId was not found.
@@ -48,6 +52,12 @@ func NewErrFieldIdNotFound(fieldId uint32) error {
 	return errors.New(errFieldIdNotFound, errors.NewKV("FieldId", fieldId))
 }
 
+// NewErrJSONPathIndexNotFound returns an error indicating that no JSON path index resolves the
+// filter of the given field.
+func NewErrJSONPathIndexNotFound(field string) error {
+	return errors.New(errJSONPathIndexNotFound, errors.NewKV("Field", field))
+}
+
 // NewErrFailedToDecodeCIDForVFetcher returns an error indicating that the given CID could not be decoded.
 func NewErrFailedToDecodeCIDForVFetcher(inner error) error {
 	return errors.Wrap(errFailedToDecodeCIDForVFetcher, inner)
@@ -93,3 +103,9 @@ func NewErrVFetcherFailedToGetDagLink(inner error) error {
 func NewErrFailedToGetDagNode(inner error) error {
 	return errors.Wrap(errFailedToGetDagNode, inner)
 }
+
+// NewErrFilterOnEncryptedField returns an error indicating that a filter targets the given
+// encrypted field, whose values cannot be compared.
+func NewErrFilterOnEncryptedField(name string) error {
+	return errors.New(errFilterOnEncryptedField, errors.NewKV("Field", name))
+}
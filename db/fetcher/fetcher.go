@@ -23,6 +23,7 @@ import (
 	"github.com/sourcenetwork/defradb/datastore"
 	"github.com/sourcenetwork/defradb/datastore/iterable"
 	"github.com/sourcenetwork/defradb/db/base"
+	"github.com/sourcenetwork/defradb/db/description"
 	"github.com/sourcenetwork/defradb/planner/mapper"
 	"github.com/sourcenetwork/defradb/request/graphql/parser"
 )
@@ -125,6 +126,10 @@ type DocumentFetcher struct {
 	kvEnd             bool
 	isReadingDocument bool
 
+	// The key used to decrypt the values of encrypted fields, nil if the collection has none
+	// or if its key is not known to this node.
+	encryptionKey []byte
+
 	// Since deleted documents are stored under a different instance type than active documents,
 	// we use a parallel fetcher to be able to return the documents in the expected order.
 	// That being lexicographically ordered dockeys.
@@ -151,11 +156,17 @@ func (df *DocumentFetcher) Init(
 		return err
 	}
 
+	err = df.initEncryptionKey(ctx, txn)
+	if err != nil {
+		return err
+	}
+
 	if showDeleted {
 		if df.deletedDocFetcher == nil {
 			df.deletedDocFetcher = new(DocumentFetcher)
 			df.deletedDocFetcher.txn = txn
 		}
+		df.deletedDocFetcher.encryptionKey = df.encryptionKey
 		return df.deletedDocFetcher.init(col, fields, filter, docmapper, reverse)
 	}
 
@@ -217,6 +228,9 @@ func (df *DocumentFetcher) init(
 		df.filterFields = make(map[uint32]client.FieldDescription, len(parsedfilterFields))
 		df.filterSet = bitset.New(uint(len(col.Schema().Fields)))
 		for _, field := range parsedfilterFields {
+			if field.IsEncrypted {
+				return NewErrFilterOnEncryptedField(field.Name)
+			}
 			df.filterFields[uint32(field.ID)] = field
 			df.filterSet.Set(uint(field.ID))
 		}
@@ -225,6 +239,24 @@ func (df *DocumentFetcher) init(
 	return nil
 }
 
+// initEncryptionKey reads the key used to decrypt the values of the encrypted fields of the
+// collection from the given transaction.
+func (df *DocumentFetcher) initEncryptionKey(ctx context.Context, txn datastore.Txn) error {
+	df.encryptionKey = nil
+	if !df.col.Schema().HasEncryptedFields() {
+		return nil
+	}
+	key, err := description.GetEncryptionKey(ctx, txn, df.col.Name())
+	if err != nil {
+		return err
+	}
+	df.encryptionKey = key
+	if df.deletedDocFetcher != nil {
+		df.deletedDocFetcher.encryptionKey = key
+	}
+	return nil
+}
+
 func (df *DocumentFetcher) Start(ctx context.Context, spans core.Spans) error {
 	err := df.start(ctx, spans, false)
 	if err != nil {
@@ -512,8 +544,9 @@ func (df *DocumentFetcher) processKV(kv *keyValue) error {
 	ufid := uint(fieldID)
 
 	property := &encProperty{
-		Desc: fieldDesc,
-		Raw:  kv.Value,
+		Desc:          fieldDesc,
+		Raw:           kv.Value,
+		encryptionKey: df.encryptionKey,
 	}
 
 	if df.filterSet != nil && df.filterSet.Test(ufid) {
@@ -528,6 +561,31 @@ func (df *DocumentFetcher) processKV(kv *keyValue) error {
 	return nil
 }
 
+// setDefaultValues sets the default value of every fetched field that has one and is not held
+// by the current document, such as a field added to the schema after the document was written.
+func (df *DocumentFetcher) setDefaultValues() {
+	for _, fields := range []map[uint32]client.FieldDescription{df.selectFields, df.filterFields} {
+		for fieldID, field := range fields {
+			if field.DefaultValue == "" || field.IsObject() {
+				continue
+			}
+			if _, exists := df.doc.properties[field]; exists {
+				continue
+			}
+
+			property := &encProperty{
+				Desc:      field,
+				IsDefault: true,
+			}
+			if df.filterSet != nil && df.filterSet.Test(uint(fieldID)) {
+				df.doc.filterSet.Set(uint(fieldID))
+				property.IsFilter = true
+			}
+			df.doc.properties[field] = property
+		}
+	}
+}
+
 // FetchNext returns a raw binary encoded document. It iterates over all the relevant
 // keypairs from the underlying store and constructs the document.
 func (df *DocumentFetcher) FetchNext(ctx context.Context) (EncodedDocument, ExecInfo, error) {
@@ -616,6 +674,7 @@ func (df *DocumentFetcher) fetchNext(ctx context.Context) (EncodedDocument, Exec
 
 		if docDone {
 			df.execInfo.DocsFetched++
+			df.setDefaultValues()
 			if df.filter != nil {
 				// if we passed, return
 				if df.passedFilter {
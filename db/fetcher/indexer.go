@@ -14,6 +14,7 @@ import (
 	"context"
 
 	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/connor"
 	"github.com/sourcenetwork/defradb/core"
 	"github.com/sourcenetwork/defradb/datastore"
 	"github.com/sourcenetwork/defradb/db/base"
@@ -68,23 +69,39 @@ func (f *IndexFetcher) Init(
 	f.mapping = docMapper
 	f.txn = txn
 
-	for _, index := range col.Description().Indexes {
-		if index.Fields[0].Name == f.indexedField.Name {
-			f.indexDataStoreKey.IndexID = index.ID
-			break
+	if f.indexedField.Kind == client.FieldKind_JSON {
+		// JSON path indexes hold the values found at their path, the field value itself is
+		// fetched with the rest of the document.
+		f.docFields = fields
+		err := f.initJSONPathIndex()
+		if err != nil {
+			return err
+		}
+	} else if f.indexedField.Kind == client.FieldKind_GEOPOINT {
+		// Geo indexes hold the cells of the points, the points themselves are fetched with
+		// the rest of the document to be matched exactly.
+		f.docFields = fields
+		f.indexDataStoreKey.IndexID = findIndexID(col, f.indexedField)
+	} else {
+		f.indexDataStoreKey.IndexID = findIndexID(col, f.indexedField)
+
+		for i := range fields {
+			if fields[i].Name == f.indexedField.Name {
+				f.docFields = append(fields[:i], fields[i+1:]...)
+				break
+			}
 		}
 	}
 
 	f.indexDataStoreKey.CollectionID = f.col.ID()
 
-	for i := range fields {
-		if fields[i].Name == f.indexedField.Name {
-			f.docFields = append(fields[:i], fields[i+1:]...)
-			break
-		}
+	var iter indexIterator
+	var err error
+	if f.indexedField.Kind == client.FieldKind_GEOPOINT {
+		iter, err = createGeoIndexIterator(f.indexDataStoreKey, f.indexFilter, &f.execInfo)
+	} else {
+		iter, err = createIndexIterator(f.indexDataStoreKey, f.indexFilter, &f.execInfo)
 	}
-
-	iter, err := createIndexIterator(f.indexDataStoreKey, f.indexFilter, &f.execInfo)
 	if err != nil {
 		return err
 	}
@@ -121,14 +138,14 @@ func (f *IndexFetcher) FetchNext(ctx context.Context) (EncodedDocument, ExecInfo
 			return nil, f.execInfo, nil
 		}
 
-		property := &encProperty{
-			Desc: f.indexedField,
-			Raw:  indexKey.FieldValues[0],
-		}
-
 		f.doc.key = indexKey.FieldValues[1]
-		f.doc.properties[f.indexedField] = property
-		f.execInfo.FieldsFetched++
+		if f.indexedField.Kind != client.FieldKind_JSON && f.indexedField.Kind != client.FieldKind_GEOPOINT {
+			f.doc.properties[f.indexedField] = &encProperty{
+				Desc: f.indexedField,
+				Raw:  indexKey.FieldValues[0],
+			}
+			f.execInfo.FieldsFetched++
+		}
 
 		if f.docFetcher != nil && len(f.docFields) > 0 {
 			targetKey := base.MakeDocKey(f.col.Description(), string(f.doc.key))
@@ -163,3 +180,107 @@ func (f *IndexFetcher) Close() error {
 	}
 	return nil
 }
+
+// initJSONPathIndex selects the JSON path index resolving the index filter, and replaces the
+// filter by the condition on the value found at the path of the index.
+func (f *IndexFetcher) initJSONPathIndex() error {
+	for key, condition := range f.indexFilter.Conditions {
+		index, found := FindJSONPathIndex(f.col, f.indexedField, condition)
+		if !found {
+			return NewErrJSONPathIndexNotFound(f.indexedField.Name)
+		}
+		f.indexDataStoreKey.IndexID = index.ID
+
+		valueCondition := map[connor.FilterKey]any{}
+		for op, value := range condition.(map[connor.FilterKey]any) {
+			if op.GetOperatorOrDefault("") == opPath {
+				continue
+			}
+			normalized, err := client.NormalizeJSONValue(value)
+			if err != nil {
+				return err
+			}
+			valueCondition[op] = normalized
+		}
+		f.indexFilter = &mapper.Filter{
+			Conditions: map[connor.FilterKey]any{key: valueCondition},
+		}
+		return nil
+	}
+	return NewErrJSONPathIndexNotFound(f.indexedField.Name)
+}
+
+// FindJSONPathIndex returns the index of the given collection resolving the given filter
+// condition on the given JSON field, and true, or false if there is none.
+//
+// JSON path indexes only resolve `_eq` and `_in` conditions on the value found at their path,
+// as the index order of JSON numbers does not match their numeric order.
+func FindJSONPathIndex(
+	col client.Collection,
+	field client.FieldDescription,
+	condition any,
+) (client.IndexDescription, bool) {
+	condMap, ok := condition.(map[connor.FilterKey]any)
+	if !ok || len(condMap) != 2 {
+		return client.IndexDescription{}, false
+	}
+	var path []any
+	hasValueCondition := false
+	for op, value := range condMap {
+		switch op.GetOperatorOrDefault("") {
+		case opPath:
+			path, _ = value.([]any)
+		case opEq, opIn:
+			hasValueCondition = true
+		}
+	}
+	if path == nil || !hasValueCondition {
+		return client.IndexDescription{}, false
+	}
+
+	for _, index := range col.Description().Indexes {
+		if index.Fields[0].Name == field.Name && isSamePath(index.Fields[0].Path, path) {
+			return index, true
+		}
+	}
+	return client.IndexDescription{}, false
+}
+
+func isSamePath(indexPath []string, path []any) bool {
+	if len(indexPath) != len(path) {
+		return false
+	}
+	for i := range indexPath {
+		if key, ok := path[i].(string); !ok || key != indexPath[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func findIndexID(col client.Collection, field client.FieldDescription) uint32 {
+	for _, index := range col.Description().Indexes {
+		if index.Fields[0].Name == field.Name {
+			return index.ID
+		}
+	}
+	return 0
+}
+
+// FindGeoBounds returns the boxes covering the points matching the given filter condition on a
+// GeoPoint field, and true, or false if it has no bounded geo operator condition.
+//
+// Geo indexes only resolve the `_near` conditions with a maximum distance, and the
+// `_withinRadius` and `_withinBox` conditions.
+func FindGeoBounds(condition any) ([]client.GeoBox, bool) {
+	condMap, ok := condition.(map[connor.FilterKey]any)
+	if !ok {
+		return nil, false
+	}
+	for op, value := range condMap {
+		if boxes, ok := connor.GeoBounds(op.GetOperatorOrDefault(""), value); ok {
+			return boxes, true
+		}
+	}
+	return nil, false
+}
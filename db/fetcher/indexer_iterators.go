@@ -38,6 +38,7 @@ const (
 	opNin   = "_nin"
 	opLike  = "_like"
 	opNlike = "_nlike"
+	opPath  = "_path"
 )
 
 // indexIterator is an iterator over index keys.
@@ -462,3 +463,28 @@ func createIndexIterator(
 
 	return nil, errors.New("invalid index filter condition")
 }
+
+// createGeoIndexIterator returns the iterator over the keys of the geo index cells covering the
+// area of the geo operator condition of the given filter.
+//
+// The cells may hold points out of the area, which are discarded once matched against the
+// document filter.
+func createGeoIndexIterator(
+	indexDataStoreKey core.IndexDataStoreKey,
+	indexFilterConditions *mapper.Filter,
+	execInfo *ExecInfo,
+) (indexIterator, error) {
+	for _, indexFilterCond := range indexFilterConditions.Conditions {
+		boxes, ok := FindGeoBounds(indexFilterCond)
+		if !ok {
+			break
+		}
+		cells := core.GeoHashCover(boxes)
+		cellValues := make([][]byte, len(cells))
+		for i, cell := range cells {
+			cellValues[i] = []byte(cell)
+		}
+		return newInIndexIterator(indexDataStoreKey, cellValues, execInfo), nil
+	}
+	return nil, errors.New("invalid geo index filter condition")
+}
@@ -130,7 +130,14 @@ func (vf *VersionedFetcher) Init(
 
 	// run the DF init, VersionedFetchers only supports the Primary (0) index
 	vf.DocumentFetcher = new(DocumentFetcher)
-	return vf.DocumentFetcher.Init(ctx, vf.store, col, fields, filter, docmapper, reverse, showDeleted)
+	err = vf.DocumentFetcher.Init(ctx, vf.store, col, fields, filter, docmapper, reverse, showDeleted)
+	if err != nil {
+		return err
+	}
+
+	// The fetcher store only holds the replayed document, the encryption key is read from
+	// the given transaction instead.
+	return vf.DocumentFetcher.initEncryptionKey(ctx, txn)
 }
 
 // Start serializes the correct state according to the Key and CID.
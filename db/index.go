@@ -44,7 +44,7 @@ func canConvertIndexFieldValue[T any](val any) bool {
 
 func getValidateIndexFieldFunc(kind client.FieldKind) func(any) bool {
 	switch kind {
-	case client.FieldKind_STRING, client.FieldKind_FOREIGN_OBJECT:
+	case client.FieldKind_STRING, client.FieldKind_FOREIGN_OBJECT, client.FieldKind_ENUM:
 		return canConvertIndexFieldValue[string]
 	case client.FieldKind_INT:
 		return canConvertIndexFieldValue[int64]
@@ -95,12 +95,29 @@ func NewCollectionIndex(
 	if !foundField {
 		return nil, NewErrIndexDescHasNonExistingField(desc, desc.Fields[0].Name)
 	}
+	if field.Kind == client.FieldKind_GEOPOINT {
+		return &collectionGeoIndex{collection: collection, desc: desc, fieldDesc: field}, nil
+	}
 	var e error
 	index.fieldDesc = field
+	if field.Kind == client.FieldKind_JSON && len(desc.Fields[0].Path) > 0 {
+		index.validateFieldFunc = isIndexableJSONValue
+		return index, nil
+	}
 	index.validateFieldFunc, e = getFieldValidateFunc(field.Kind)
 	return index, e
 }
 
+// isIndexableJSONValue returns true if the given normalized JSON value is a scalar.
+func isIndexableJSONValue(val any) bool {
+	switch val.(type) {
+	case nil, string, int64, float64, bool:
+		return true
+	default:
+		return false
+	}
+}
+
 // collectionSimpleIndex is an non-unique index that indexes documents by a single field.
 // Single-field indexes store values only in ascending order.
 type collectionSimpleIndex struct {
@@ -139,6 +156,9 @@ func (i *collectionSimpleIndex) getDocFieldValue(doc *client.Document) ([]byte,
 			return nil, err
 		}
 	}
+	if i.fieldDesc.Kind == client.FieldKind_JSON {
+		return i.getJSONPathValue(fieldVal.Value())
+	}
 	writeableVal, ok := fieldVal.(client.WriteableValue)
 	if !ok || !i.validateFieldFunc(fieldVal.Value()) {
 		return nil, NewErrInvalidFieldValue(i.fieldDesc.Kind, writeableVal)
@@ -192,9 +212,13 @@ func (i *collectionSimpleIndex) Update(
 // RemoveAll remove all artifacts of the index from the storage, i.e. all index
 // field values for all documents.
 func (i *collectionSimpleIndex) RemoveAll(ctx context.Context, txn datastore.Txn) error {
+	return removeAllIndexKeys(ctx, txn, i.collection.ID(), i.desc.ID)
+}
+
+func removeAllIndexKeys(ctx context.Context, txn datastore.Txn, collectionID uint32, indexID uint32) error {
 	prefixKey := core.IndexDataStoreKey{}
-	prefixKey.CollectionID = i.collection.ID()
-	prefixKey.IndexID = i.desc.ID
+	prefixKey.CollectionID = collectionID
+	prefixKey.IndexID = indexID
 
 	keys, err := datastore.FetchKeysForPrefix(ctx, prefixKey.ToString(), txn.Datastore())
 	if err != nil {
@@ -220,3 +244,120 @@ func (i *collectionSimpleIndex) Name() string {
 func (i *collectionSimpleIndex) Description() client.IndexDescription {
 	return i.desc
 }
+
+// getJSONPathValue returns the encoded value found at the path of the index within the given
+// JSON value, missing values are indexed as null.
+func (i *collectionSimpleIndex) getJSONPathValue(value any) ([]byte, error) {
+	pathValue, _ := client.JSONPathValue(value, i.desc.Fields[0].Path)
+	normalized, err := client.NormalizeJSONValue(pathValue)
+	if err != nil {
+		return nil, err
+	}
+	writeableVal := client.NewCBORValue(client.LWW_REGISTER, normalized)
+	if !i.validateFieldFunc(normalized) {
+		return nil, NewErrInvalidFieldValue(i.fieldDesc.Kind, writeableVal)
+	}
+	return writeableVal.Bytes()
+}
+
+// collectionGeoIndex is a non-unique index of the points of a GeoPoint field.
+//
+// Points are indexed by the geohash of their cell at every precision up to
+// [core.MaxGeoHashPrecision], so that the points within an area are found by fetching the
+// cells covering it.
+type collectionGeoIndex struct {
+	collection client.Collection
+	desc       client.IndexDescription
+	fieldDesc  client.FieldDescription
+}
+
+var _ CollectionIndex = (*collectionGeoIndex)(nil)
+
+// getDocumentsIndexKeys returns the keys of the cells of the indexed point of the given
+// document, or none if it has no point.
+func (i *collectionGeoIndex) getDocumentsIndexKeys(
+	doc *client.Document,
+) ([]core.IndexDataStoreKey, error) {
+	fieldVal, err := doc.GetValue(i.desc.Fields[0].Name)
+	if err != nil {
+		if errors.Is(err, client.ErrFieldNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if fieldVal.IsDelete() || fieldVal.Value() == nil {
+		return nil, nil
+	}
+	point, err := client.NewGeoPointFromValue(fieldVal.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]core.IndexDataStoreKey, core.MaxGeoHashPrecision)
+	for precision := 1; precision <= core.MaxGeoHashPrecision; precision++ {
+		key := &keys[precision-1]
+		key.CollectionID = i.collection.ID()
+		key.IndexID = i.desc.ID
+		key.FieldValues = [][]byte{
+			[]byte(core.EncodeGeoHash(point, precision)),
+			[]byte(doc.Key().String()),
+		}
+	}
+	return keys, nil
+}
+
+// Save indexes a document by storing the cells of its point.
+func (i *collectionGeoIndex) Save(
+	ctx context.Context,
+	txn datastore.Txn,
+	doc *client.Document,
+) error {
+	keys, err := i.getDocumentsIndexKeys(doc)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		err = txn.Datastore().Put(ctx, key.ToDS(), []byte{})
+		if err != nil {
+			return NewErrFailedToStoreIndexedField(key.ToDS().String(), err)
+		}
+	}
+	return nil
+}
+
+// Update updates the indexed point of an existing document.
+// It removes the cells of the old point from the index and adds the ones of the new point.
+func (i *collectionGeoIndex) Update(
+	ctx context.Context,
+	txn datastore.Txn,
+	oldDoc *client.Document,
+	newDoc *client.Document,
+) error {
+	keys, err := i.getDocumentsIndexKeys(oldDoc)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		err = txn.Datastore().Delete(ctx, key.ToDS())
+		if err != nil {
+			return err
+		}
+	}
+	return i.Save(ctx, txn, newDoc)
+}
+
+// RemoveAll remove all artifacts of the index from the storage, i.e. all the cells of the
+// points of all documents.
+func (i *collectionGeoIndex) RemoveAll(ctx context.Context, txn datastore.Txn) error {
+	return removeAllIndexKeys(ctx, txn, i.collection.ID(), i.desc.ID)
+}
+
+// Name returns the name of the index
+func (i *collectionGeoIndex) Name() string {
+	return i.desc.Name
+}
+
+// Description returns the description of the index
+func (i *collectionGeoIndex) Description() client.IndexDescription {
+	return i.desc
+}
@@ -314,6 +314,7 @@ func TestNonUnique_IfSystemStorageHasInvalidIndexDescription_Error(t *testing.T)
 	systemStoreOn := mockTxn.MockSystemstore.EXPECT()
 	systemStoreOn.Query(mock.Anything, mock.Anything).
 		Return(mocks.NewQueryResultsWithValues(t, []byte("invalid")), nil)
+	systemStoreOn.Put(mock.Anything, mock.Anything, mock.Anything).Maybe().Return(nil)
 
 	err := f.users.WithTxn(mockTxn).Create(f.ctx, doc)
 	assert.ErrorIs(t, err, datastore.NewErrInvalidStoredValue(nil))
@@ -332,6 +333,7 @@ func TestNonUnique_IfSystemStorageFailsToReadIndexDesc_Error(t *testing.T) {
 	systemStoreOn := mockTxn.MockSystemstore.EXPECT()
 	systemStoreOn.Query(mock.Anything, mock.Anything).
 		Return(nil, testErr)
+	systemStoreOn.Put(mock.Anything, mock.Anything, mock.Anything).Maybe().Return(nil)
 
 	err := f.users.WithTxn(mockTxn).Create(f.ctx, doc)
 	require.ErrorIs(t, err, testErr)
@@ -0,0 +1,134 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/db/base"
+)
+
+// SetPolicy replaces the persisted authorization policy with the given one.
+func (db *db) SetPolicy(ctx context.Context, policy client.Policy) error {
+	if err := validatePolicy(policy); err != nil {
+		return err
+	}
+
+	txn, err := db.NewTxn(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+
+	buf, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	err = txn.Systemstore().Put(ctx, core.NewPolicyKey().ToDS(), buf)
+	if err != nil {
+		return err
+	}
+
+	return txn.Commit(ctx)
+}
+
+// GetPolicy returns the persisted authorization policy, or an empty policy if none has been set.
+func (db *db) GetPolicy(ctx context.Context) (client.Policy, error) {
+	txn, err := db.NewTxn(ctx, true)
+	if err != nil {
+		return client.Policy{}, err
+	}
+	defer txn.Discard(ctx)
+
+	return base.GetPolicy(ctx, txn)
+}
+
+// validatePolicy returns an error if the given policy references unknown roles or permissions,
+// restricts the documents of a collection more than once, or if it would leave no identity
+// able to change it.
+func validatePolicy(policy client.Policy) error {
+	if policy.IsEmpty() {
+		return nil
+	}
+
+	roles := make(map[string]client.Role, len(policy.Roles))
+	for _, role := range policy.Roles {
+		if _, exists := roles[role.Name]; exists || role.Name == "" {
+			return NewErrInvalidPolicyRole(role.Name)
+		}
+		for _, permission := range role.Permissions {
+			if !slices.Contains(client.Permissions, permission) {
+				return NewErrUnknownPolicyPermission(role.Name, permission)
+			}
+		}
+		roles[role.Name] = role
+	}
+
+	hasAdmin := false
+	for identity, names := range policy.Members {
+		for _, name := range names {
+			role, ok := roles[name]
+			if !ok {
+				return NewErrUnknownPolicyRole(identity, name)
+			}
+			if slices.Contains(role.Permissions, client.AdminPermission) {
+				hasAdmin = true
+			}
+		}
+	}
+	if !hasAdmin {
+		return ErrPolicyWithoutAdmin
+	}
+
+	collections := make(map[string]struct{}, len(policy.Documents))
+	for _, documents := range policy.Documents {
+		if _, exists := collections[documents.Collection]; exists || documents.Collection == "" {
+			return NewErrInvalidDocumentPolicy(documents.Collection)
+		}
+		collections[documents.Collection] = struct{}{}
+		for _, name := range documents.ReadRoles {
+			if _, ok := roles[name]; !ok {
+				return NewErrUnknownDocumentPolicyRole(documents.Collection, name)
+			}
+		}
+	}
+	return nil
+}
+
+// getDocumentOwner returns the identity of the owner of the given document, or an empty
+// string if the document has no owner.
+func (c *collection) getDocumentOwner(ctx context.Context, txn datastore.Txn, docKey string) (string, error) {
+	return base.GetDocumentOwner(ctx, txn, c.ID(), docKey)
+}
+
+// checkDocumentPermission returns an error if the caller is not allowed to perform the given
+// operation on the given document by the document level restrictions of the current policy.
+func (c *collection) checkDocumentPermission(
+	ctx context.Context,
+	txn datastore.Txn,
+	permission client.Permission,
+	docKey string,
+) error {
+	if !client.HasDocumentPolicy(ctx, c.Name()) {
+		return nil
+	}
+	owner, err := c.getDocumentOwner(ctx, txn, docKey)
+	if err != nil {
+		return err
+	}
+	return client.CheckDocumentPermission(ctx, permission, c.Name(), owner)
+}
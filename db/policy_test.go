@@ -0,0 +1,265 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func TestGetPolicy_WithoutPolicy_ReturnsEmptyPolicy(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+
+	policy, err := db.GetPolicy(ctx)
+	require.NoError(t, err)
+	require.True(t, policy.IsEmpty())
+}
+
+func TestSetPolicy_WithValidPolicy_PersistsPolicy(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+
+	policy := client.Policy{
+		Roles: []client.Role{
+			{Name: "admin", Permissions: []client.Permission{client.AdminPermission}},
+			{Name: "reader", Permissions: []client.Permission{client.ReadPermission}, Collections: []string{"User"}},
+		},
+		Members: map[string][]string{
+			"alice": {"admin"},
+			"bob":   {"reader"},
+		},
+	}
+	err = db.SetPolicy(ctx, policy)
+	require.NoError(t, err)
+
+	result, err := db.GetPolicy(ctx)
+	require.NoError(t, err)
+	require.Equal(t, policy, result)
+}
+
+func TestSetPolicy_WithoutAdmin_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+
+	err = db.SetPolicy(ctx, client.Policy{
+		Roles:   []client.Role{{Name: "reader", Permissions: []client.Permission{client.ReadPermission}}},
+		Members: map[string][]string{"bob": {"reader"}},
+	})
+	require.ErrorIs(t, err, ErrPolicyWithoutAdmin)
+}
+
+func TestSetPolicy_WithUnknownRole_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+
+	err = db.SetPolicy(ctx, client.Policy{
+		Roles:   []client.Role{{Name: "admin", Permissions: []client.Permission{client.AdminPermission}}},
+		Members: map[string][]string{"alice": {"admin"}, "bob": {"writer"}},
+	})
+	require.ErrorIs(t, err, ErrUnknownPolicyRole)
+}
+
+func TestSetPolicy_WithUnknownPermission_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+
+	err = db.SetPolicy(ctx, client.Policy{
+		Roles:   []client.Role{{Name: "admin", Permissions: []client.Permission{"superuser"}}},
+		Members: map[string][]string{"alice": {"admin"}},
+	})
+	require.ErrorIs(t, err, ErrUnknownPolicyPermission)
+}
+
+func TestSetPolicy_WithUnknownDocumentPolicyRole_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+
+	err = db.SetPolicy(ctx, client.Policy{
+		Roles:     []client.Role{{Name: "admin", Permissions: []client.Permission{client.AdminPermission}}},
+		Members:   map[string][]string{"alice": {"admin"}},
+		Documents: []client.DocumentPolicy{{Collection: "User", OwnerRead: true, ReadRoles: []string{"auditor"}}},
+	})
+	require.ErrorIs(t, err, ErrUnknownPolicyRole)
+}
+
+func TestDocumentPolicy_WithOwnerRestrictions_RestrictsOtherIdentities(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+
+	_, err = db.AddSchema(ctx, `type User {
+		name: String
+	}`)
+	require.NoError(t, err)
+
+	policy := client.Policy{
+		Roles: []client.Role{
+			{Name: "admin", Permissions: []client.Permission{client.AdminPermission}},
+			{Name: "writer", Permissions: []client.Permission{client.WritePermission}},
+		},
+		Members: map[string][]string{
+			"alice": {"admin", "writer"},
+			"bob":   {"writer"},
+		},
+		Documents: []client.DocumentPolicy{{
+			Collection: "User",
+			OwnerWrite: true,
+			OwnerRead:  true,
+			ReadRoles:  []string{"admin"},
+		}},
+	}
+	err = db.SetPolicy(ctx, policy)
+	require.NoError(t, err)
+
+	bobCtx := client.WithAuthorization(ctx, "bob", policy)
+	aliceCtx := client.WithAuthorization(ctx, "alice", policy)
+
+	col, err := db.GetCollectionByName(bobCtx, "User")
+	require.NoError(t, err)
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "Bob"}`))
+	require.NoError(t, err)
+	err = col.Create(bobCtx, doc)
+	require.NoError(t, err)
+
+	// the owner may read and update the document
+	_, err = col.Get(bobCtx, doc.Key(), false)
+	require.NoError(t, err)
+	err = doc.Set("name", "Bobby")
+	require.NoError(t, err)
+	err = col.Update(bobCtx, doc)
+	require.NoError(t, err)
+
+	// members of the read roles may read but not update the document
+	_, err = col.Get(aliceCtx, doc.Key(), false)
+	require.NoError(t, err)
+	err = col.Update(aliceCtx, doc)
+	require.ErrorIs(t, err, client.ErrPermissionDenied)
+	_, err = col.Delete(aliceCtx, doc.Key())
+	require.ErrorIs(t, err, client.ErrPermissionDenied)
+
+	// other identities can not see the document
+	_, err = col.Get(client.WithAuthorization(ctx, "carol", policy), doc.Key(), false)
+	require.ErrorIs(t, err, client.ErrDocumentNotFound)
+
+	deleted, err := col.Delete(bobCtx, doc.Key())
+	require.NoError(t, err)
+	require.True(t, deleted)
+}
+
+func TestDocumentPolicy_WithOwnerRead_HidesDocumentFromReadPaths(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+	newUserTestCollection(ctx, t, db)
+
+	policy := client.Policy{
+		Roles: []client.Role{
+			{Name: "admin", Permissions: []client.Permission{client.AdminPermission}},
+			{Name: "writer", Permissions: []client.Permission{client.WritePermission}},
+		},
+		Members: map[string][]string{
+			"alice": {"admin"},
+			"bob":   {"writer"},
+			"carol": {"writer"},
+		},
+		Documents: []client.DocumentPolicy{{Collection: "User", OwnerRead: true}},
+	}
+	err = db.SetPolicy(ctx, policy)
+	require.NoError(t, err)
+
+	bobCtx := client.WithAuthorization(ctx, "bob", policy)
+	carolCtx := client.WithAuthorization(ctx, "carol", policy)
+
+	col, err := db.GetCollectionByName(bobCtx, "User")
+	require.NoError(t, err)
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "Bob"}`))
+	require.NoError(t, err)
+	err = col.Create(bobCtx, doc)
+	require.NoError(t, err)
+
+	_, err = col.Diff(carolCtx, doc.Key(), "", doc.Head().String())
+	require.ErrorIs(t, err, client.ErrDocumentNotFound)
+
+	keysCh, err := col.GetAllDocKeys(carolCtx)
+	require.NoError(t, err)
+	for res := range keysCh {
+		require.NoError(t, res.Err)
+		require.Fail(t, "unexpected document key", res.Key.String())
+	}
+
+	result := db.ExecRequest(carolCtx, `query { commits(dockey: "`+doc.Key().String()+`") { cid } }`)
+	require.Empty(t, result.GQL.Errors)
+	require.Equal(t, []map[string]any{}, result.GQL.Data)
+
+	result = db.ExecRequest(bobCtx, `query { commits(dockey: "`+doc.Key().String()+`", fieldId: "C") { cid } }`)
+	require.Empty(t, result.GQL.Errors)
+	require.Len(t, result.GQL.Data, 1)
+}
+
+func TestPolicy_WithScopedRole_HidesCommitsOfOtherCollections(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+	col := newUserTestCollection(ctx, t, db)
+	_, err = db.AddSchema(ctx, `type Book { title: String }`)
+	require.NoError(t, err)
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John"}`))
+	require.NoError(t, err)
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	policy := client.Policy{
+		Roles: []client.Role{
+			{Name: "admin", Permissions: []client.Permission{client.AdminPermission}},
+			{Name: "book-reader", Permissions: []client.Permission{client.ReadPermission}, Collections: []string{"Book"}},
+			{Name: "user-reader", Permissions: []client.Permission{client.ReadPermission}, Collections: []string{"User"}},
+		},
+		Members: map[string][]string{
+			"alice": {"admin"},
+			"bob":   {"book-reader"},
+			"carol": {"user-reader"},
+		},
+	}
+	err = db.SetPolicy(ctx, policy)
+	require.NoError(t, err)
+
+	bobCtx := client.WithAuthorization(ctx, "bob", policy)
+	for _, request := range []string{
+		`query { commits { cid } }`,
+		`query { commits(dockey: "` + doc.Key().String() + `") { cid } }`,
+		`query { latestCommits(dockey: "` + doc.Key().String() + `") { cid } }`,
+	} {
+		result := db.ExecRequest(bobCtx, request)
+		require.Empty(t, result.GQL.Errors)
+		require.Equal(t, []map[string]any{}, result.GQL.Data, request)
+	}
+
+	carolCtx := client.WithAuthorization(ctx, "carol", policy)
+	result := db.ExecRequest(carolCtx, `query { latestCommits(dockey: "`+doc.Key().String()+`") { cid } }`)
+	require.Empty(t, result.GQL.Errors)
+	require.NotEmpty(t, result.GQL.Data)
+}
@@ -0,0 +1,336 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+// A snapshot file starts with the snapshotMagic header followed by the snapshot format version.
+//
+// The header is followed by the rootstore entries, each written as the uvarint length of its key,
+// its key, the uvarint length of its value and its value. A zero key length marks the end of the
+// entries, it is followed by the big endian uint64 count of entries and the SHA-256 checksum of
+// all the preceding bytes.
+var snapshotMagic = []byte("DEFRADB-SNAPSHOT")
+
+const snapshotVersion uint16 = 1
+
+// restoreBatchSize is the number of entries written per batch when restoring a snapshot.
+const restoreBatchSize = 1000
+
+// CreateSnapshot writes a consistent snapshot of the entire rootstore to the file at the given path.
+func (db *db) CreateSnapshot(ctx context.Context, path string) (client.SnapshotInfo, error) {
+	txn, err := db.NewTxn(ctx, true)
+	if err != nil {
+		return client.SnapshotInfo{}, err
+	}
+	defer txn.Discard(ctx)
+
+	info, err := writeSnapshot(ctx, txn.Rootstore(), path)
+	if err != nil {
+		return client.SnapshotInfo{}, err
+	}
+
+	// Read the snapshot back to make sure it was written to disk intact.
+	verified, err := VerifySnapshot(path)
+	if err != nil {
+		return client.SnapshotInfo{}, err
+	}
+	if verified.Checksum != info.Checksum {
+		return client.SnapshotInfo{}, NewErrSnapshotChecksumMismatch(path, info.Checksum, verified.Checksum)
+	}
+	return info, nil
+}
+
+// VerifySnapshot reads the snapshot at the given path and verifies its checksum.
+func VerifySnapshot(path string) (client.SnapshotInfo, error) {
+	return readSnapshot(path, func(key, value []byte) error { return nil })
+}
+
+// RestoreSnapshot writes the entries of the snapshot at the given path to the given rootstore.
+//
+// The snapshot is verified before anything is written, and the rootstore must be empty.
+// The rootstore must not be in use by a running node.
+func RestoreSnapshot(ctx context.Context, rootstore datastore.RootStore, path string) (client.SnapshotInfo, error) {
+	expected, err := VerifySnapshot(path)
+	if err != nil {
+		return client.SnapshotInfo{}, err
+	}
+
+	results, err := rootstore.Query(ctx, query.Query{KeysOnly: true, Limit: 1})
+	if err != nil {
+		return client.SnapshotInfo{}, err
+	}
+	_, hasEntries := results.NextSync()
+	if err := results.Close(); err != nil {
+		return client.SnapshotInfo{}, err
+	}
+	if hasEntries {
+		return client.SnapshotInfo{}, ErrRestoreTargetNotEmpty
+	}
+
+	batch, err := rootstore.Batch(ctx)
+	if err != nil {
+		return client.SnapshotInfo{}, err
+	}
+	pending := 0
+	info, err := readSnapshot(path, func(key, value []byte) error {
+		if err := batch.Put(ctx, ds.RawKey(string(key)), value); err != nil {
+			return err
+		}
+		pending++
+		if pending < restoreBatchSize {
+			return nil
+		}
+		if err := batch.Commit(ctx); err != nil {
+			return err
+		}
+		pending = 0
+		batch, err = rootstore.Batch(ctx)
+		return err
+	})
+	if err != nil {
+		return client.SnapshotInfo{}, err
+	}
+	if info.Checksum != expected.Checksum {
+		return client.SnapshotInfo{}, NewErrSnapshotChecksumMismatch(path, expected.Checksum, info.Checksum)
+	}
+	if err := batch.Commit(ctx); err != nil {
+		return client.SnapshotInfo{}, err
+	}
+	return info, nil
+}
+
+// writeSnapshot writes all the entries of the given store to a temporary file that is moved
+// to the given path once complete, so that an interrupted snapshot never replaces a valid one.
+func writeSnapshot(ctx context.Context, store datastore.DSReaderWriter, path string) (
+	info client.SnapshotInfo,
+	err error,
+) {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return client.SnapshotInfo{}, NewErrOpenFile(err, path)
+	}
+	defer func() {
+		if err != nil {
+			f.Close()           //nolint:errcheck
+			os.Remove(f.Name()) //nolint:errcheck
+		}
+	}()
+
+	hasher := sha256.New()
+	w := bufio.NewWriter(io.MultiWriter(f, hasher))
+
+	if _, err := w.Write(snapshotMagic); err != nil {
+		return client.SnapshotInfo{}, err
+	}
+	if err := binary.Write(w, binary.BigEndian, snapshotVersion); err != nil {
+		return client.SnapshotInfo{}, err
+	}
+
+	results, err := store.Query(ctx, query.Query{})
+	if err != nil {
+		return client.SnapshotInfo{}, err
+	}
+	defer func() {
+		closeErr := results.Close()
+		if err == nil {
+			err = closeErr
+		}
+	}()
+
+	var entries uint64
+	for res := range results.Next() {
+		if res.Error != nil {
+			return client.SnapshotInfo{}, res.Error
+		}
+		if err := writeSnapshotBytes(w, []byte(res.Key)); err != nil {
+			return client.SnapshotInfo{}, err
+		}
+		if err := writeSnapshotBytes(w, res.Value); err != nil {
+			return client.SnapshotInfo{}, err
+		}
+		entries++
+	}
+
+	if err := writeSnapshotBytes(w, nil); err != nil {
+		return client.SnapshotInfo{}, err
+	}
+	if err := binary.Write(w, binary.BigEndian, entries); err != nil {
+		return client.SnapshotInfo{}, err
+	}
+	if err := w.Flush(); err != nil {
+		return client.SnapshotInfo{}, err
+	}
+	checksum := hasher.Sum(nil)
+	if _, err := f.Write(checksum); err != nil {
+		return client.SnapshotInfo{}, err
+	}
+	if err := f.Sync(); err != nil {
+		return client.SnapshotInfo{}, err
+	}
+	if err := f.Close(); err != nil {
+		return client.SnapshotInfo{}, NewErrCloseFile(err, nil)
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return client.SnapshotInfo{}, err
+	}
+
+	return client.SnapshotInfo{
+		Filepath: path,
+		Entries:  entries,
+		Checksum: hex.EncodeToString(checksum),
+	}, nil
+}
+
+func writeSnapshotBytes(w io.Writer, data []byte) error {
+	if _, err := w.Write(binary.AppendUvarint(nil, uint64(len(data)))); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+// readSnapshot calls the given function with every entry of the snapshot at the given path,
+// and returns an error if the snapshot checksum does not match its contents.
+//
+// As the checksum can only be verified once all entries have been read, the entries given
+// to the function must not be trusted until this function returns successfully.
+func readSnapshot(path string, fn func(key, value []byte) error) (info client.SnapshotInfo, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return client.SnapshotInfo{}, NewErrOpenFile(err, path)
+	}
+	defer func() {
+		closeErr := f.Close()
+		if closeErr != nil {
+			err = NewErrCloseFile(closeErr, err)
+		}
+	}()
+
+	hasher := sha256.New()
+	br := bufio.NewReader(f)
+	r := &snapshotReader{r: br, hasher: hasher}
+
+	magic := make([]byte, len(snapshotMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || !bytes.Equal(magic, snapshotMagic) {
+		return client.SnapshotInfo{}, NewErrInvalidSnapshot(errors.New("missing snapshot header"), path)
+	}
+	var version uint16
+	if err := binary.Read(r, binary.BigEndian, &version); err != nil {
+		return client.SnapshotInfo{}, NewErrInvalidSnapshot(err, path)
+	}
+	if version != snapshotVersion {
+		return client.SnapshotInfo{}, NewErrInvalidSnapshot(errors.New("unsupported snapshot version"), path)
+	}
+
+	var entries uint64
+	for {
+		key, err := readSnapshotBytes(r)
+		if err != nil {
+			return client.SnapshotInfo{}, NewErrInvalidSnapshot(err, path)
+		}
+		if len(key) == 0 {
+			break
+		}
+		value, err := readSnapshotBytes(r)
+		if err != nil {
+			return client.SnapshotInfo{}, NewErrInvalidSnapshot(err, path)
+		}
+		if err := fn(key, value); err != nil {
+			return client.SnapshotInfo{}, err
+		}
+		entries++
+	}
+
+	var count uint64
+	if err := binary.Read(r, binary.BigEndian, &count); err != nil {
+		return client.SnapshotInfo{}, NewErrInvalidSnapshot(err, path)
+	}
+	actual := hasher.Sum(nil)
+
+	expected := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(br, expected); err != nil {
+		return client.SnapshotInfo{}, NewErrInvalidSnapshot(err, path)
+	}
+	if _, err := br.ReadByte(); err != io.EOF {
+		return client.SnapshotInfo{}, NewErrInvalidSnapshot(errors.New("unexpected data after checksum"), path)
+	}
+	if !bytes.Equal(expected, actual) || count != entries {
+		return client.SnapshotInfo{}, NewErrSnapshotChecksumMismatch(
+			path,
+			hex.EncodeToString(expected),
+			hex.EncodeToString(actual),
+		)
+	}
+
+	return client.SnapshotInfo{
+		Filepath: path,
+		Entries:  entries,
+		Checksum: hex.EncodeToString(actual),
+	}, nil
+}
+
+// snapshotMaxEntrySize is the maximum size of a key or value read from a snapshot, it guards
+// against allocating huge buffers when reading a corrupted length.
+const snapshotMaxEntrySize = 1 << 30
+
+func readSnapshotBytes(r *snapshotReader) ([]byte, error) {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if length > snapshotMaxEntrySize {
+		return nil, errors.New("snapshot entry is too large")
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// snapshotReader hashes all the bytes read from the underlying reader.
+type snapshotReader struct {
+	r      *bufio.Reader
+	hasher hash.Hash
+}
+
+func (r *snapshotReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.hasher.Write(p[:n]) //nolint:errcheck
+	return n, err
+}
+
+func (r *snapshotReader) ReadByte() (byte, error) {
+	b, err := r.r.ReadByte()
+	if err == nil {
+		r.hasher.Write([]byte{b}) //nolint:errcheck
+	}
+	return b, err
+}
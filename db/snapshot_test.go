@@ -0,0 +1,116 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	badger "github.com/sourcenetwork/badger/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+	badgerds "github.com/sourcenetwork/defradb/datastore/badger/v4"
+)
+
+func newSnapshotTestDB(ctx context.Context, t *testing.T) (*implicitTxnDB, *client.Document) {
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	col := newUserTestCollection(ctx, t, db)
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John", "age": 21}`))
+	require.NoError(t, err)
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	return db, doc
+}
+
+func newEmptyRootstore(t *testing.T) *badgerds.Datastore {
+	opts := badgerds.Options{Options: badger.DefaultOptions("").WithInMemory(true)}
+	rootstore, err := badgerds.NewDatastore("", &opts)
+	require.NoError(t, err)
+	return rootstore
+}
+
+func TestRestoreSnapshot_ToEmptyDatastore_RestoresNodeState(t *testing.T) {
+	ctx := context.Background()
+	db, doc := newSnapshotTestDB(ctx, t)
+	path := filepath.Join(t.TempDir(), "node.snapshot")
+
+	info, err := db.CreateSnapshot(ctx, path)
+	require.NoError(t, err)
+	require.Equal(t, path, info.Filepath)
+	require.NotZero(t, info.Entries)
+	require.NotEmpty(t, info.Checksum)
+
+	rootstore := newEmptyRootstore(t)
+	restored, err := RestoreSnapshot(ctx, rootstore, path)
+	require.NoError(t, err)
+	require.Equal(t, info, restored)
+
+	restoredDB, err := newDB(ctx, rootstore)
+	require.NoError(t, err)
+
+	col, err := restoredDB.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	fetched, err := col.Get(ctx, doc.Key(), false)
+	require.NoError(t, err)
+	name, err := fetched.Get("name")
+	require.NoError(t, err)
+	require.Equal(t, "John", name)
+}
+
+func TestVerifySnapshot_WithCorruptedFile_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	db, _ := newSnapshotTestDB(ctx, t)
+	path := filepath.Join(t.TempDir(), "node.snapshot")
+
+	_, err := db.CreateSnapshot(ctx, path)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	data[len(data)-1] ^= 0xff
+	err = os.WriteFile(path, data, 0o644)
+	require.NoError(t, err)
+
+	_, err = VerifySnapshot(path)
+	require.ErrorIs(t, err, ErrSnapshotChecksumMismatch)
+
+	rootstore := newEmptyRootstore(t)
+	_, err = RestoreSnapshot(ctx, rootstore, path)
+	require.ErrorIs(t, err, ErrSnapshotChecksumMismatch)
+}
+
+func TestVerifySnapshot_WithInvalidFile_ReturnsError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "node.snapshot")
+	err := os.WriteFile(path, []byte(`{"User": []}`), 0o644)
+	require.NoError(t, err)
+
+	_, err = VerifySnapshot(path)
+	require.ErrorIs(t, err, ErrInvalidSnapshot)
+}
+
+func TestRestoreSnapshot_ToNonEmptyDatastore_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	db, _ := newSnapshotTestDB(ctx, t)
+	path := filepath.Join(t.TempDir(), "node.snapshot")
+
+	_, err := db.CreateSnapshot(ctx, path)
+	require.NoError(t, err)
+
+	_, err = RestoreSnapshot(ctx, db.Root(), path)
+	require.ErrorIs(t, err, ErrRestoreTargetNotEmpty)
+}
@@ -12,6 +12,7 @@ package db
 
 import (
 	"context"
+	"io"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/datastore"
@@ -381,18 +382,8 @@ func (db *explicitTxnDB) SetMigration(ctx context.Context, cfg client.LensConfig
 // BasicImport imports a json dataset.
 // filepath must be accessible to the node.
 func (db *implicitTxnDB) BasicImport(ctx context.Context, filepath string) error {
-	txn, err := db.NewTxn(ctx, false)
-	if err != nil {
-		return err
-	}
-	defer txn.Discard(ctx)
-
-	err = db.basicImport(ctx, txn, filepath)
-	if err != nil {
-		return err
-	}
-
-	return txn.Commit(ctx)
+	// Without a transaction, the documents are imported in a new transaction every batch.
+	return db.basicImport(ctx, nil, filepath)
 }
 
 // BasicImport imports a json dataset.
@@ -422,9 +413,76 @@ func (db *explicitTxnDB) BasicExport(ctx context.Context, config *client.BackupC
 	return db.basicExport(ctx, db.txn, config)
 }
 
+// BasicImportFrom imports a dataset read from the given reader.
+func (db *implicitTxnDB) BasicImportFrom(ctx context.Context, config *client.BackupConfig, r io.Reader) error {
+	// Without a transaction, the documents are imported in a new transaction every batch.
+	return db.basicImportFrom(ctx, nil, config, r)
+}
+
+// BasicImportFrom imports a dataset read from the given reader.
+func (db *explicitTxnDB) BasicImportFrom(ctx context.Context, config *client.BackupConfig, r io.Reader) error {
+	return db.basicImportFrom(ctx, db.txn, config, r)
+}
+
+// BasicExportTo exports the current data or subset of data to the given writer.
+func (db *implicitTxnDB) BasicExportTo(ctx context.Context, config *client.BackupConfig, w io.Writer) error {
+	txn, err := db.NewTxn(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+
+	err = db.basicExportTo(ctx, txn, config, w)
+	if err != nil {
+		return err
+	}
+
+	return txn.Commit(ctx)
+}
+
+// BasicExportTo exports the current data or subset of data to the given writer.
+func (db *explicitTxnDB) BasicExportTo(ctx context.Context, config *client.BackupConfig, w io.Writer) error {
+	return db.basicExportTo(ctx, db.txn, config, w)
+}
+
 // LensRegistry returns the LensRegistry in use by this database instance.
 //
 // It exposes several useful thread-safe migration related functions.
 func (db *explicitTxnDB) LensRegistry() client.LensRegistry {
 	return db.lensRegistry
 }
+
+// ChangesSince returns at most limit changes of the named collection with a sequence
+// number greater than the given cursor.
+func (db *implicitTxnDB) ChangesSince(
+	ctx context.Context,
+	collectionName string,
+	cursor uint64,
+	limit uint64,
+) (client.ChangesResult, error) {
+	err := db.sequenceChanges(ctx)
+	if err != nil {
+		return client.ChangesResult{}, err
+	}
+
+	txn, err := db.NewTxn(ctx, true)
+	if err != nil {
+		return client.ChangesResult{}, err
+	}
+	defer txn.Discard(ctx)
+
+	return db.changesSince(ctx, txn, collectionName, cursor, limit)
+}
+
+// ChangesSince returns at most limit changes of the named collection with a sequence
+// number greater than the given cursor.
+//
+// Changes sequenced after the transaction was created will not be visible.
+func (db *explicitTxnDB) ChangesSince(
+	ctx context.Context,
+	collectionName string,
+	cursor uint64,
+	limit uint64,
+) (client.ChangesResult, error) {
+	return db.changesSince(ctx, db.txn, collectionName, cursor, limit)
+}
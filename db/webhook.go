@@ -0,0 +1,502 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	dsq "github.com/ipfs/go-datastore/query"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/events"
+	"github.com/sourcenetwork/defradb/logging"
+	"github.com/sourcenetwork/defradb/planner"
+)
+
+const (
+	defaultWebhookMaxAttempts = 5
+	defaultWebhookBackoff     = time.Second
+	webhookRequestTimeout     = 10 * time.Second
+	webhookQueueSize          = 100
+)
+
+// webhook is a registered webhook along with the schema root of its collection.
+type webhook struct {
+	client.Webhook
+	schemaRoot string
+
+	// queue holds the updates waiting to be delivered to the webhook, in the order
+	// that they were received.
+	queue chan events.Update
+	// stop is closed once the webhook is deleted, or the update events are closed.
+	stop chan struct{}
+}
+
+// AddWebhook adds the given webhook to the persisted list, returning it with its assigned ID.
+//
+// Webhooks are posted update events, so an error is returned if update events are not enabled.
+func (db *db) AddWebhook(ctx context.Context, hook client.Webhook) (client.Webhook, error) {
+	if !db.events.Updates.HasValue() {
+		return client.Webhook{}, ErrWebhooksRequireUpdateEvents
+	}
+
+	txn, err := db.NewTxn(ctx, false)
+	if err != nil {
+		return client.Webhook{}, err
+	}
+	defer txn.Discard(ctx)
+
+	target, err := url.Parse(hook.URL)
+	if err != nil {
+		return client.Webhook{}, NewErrInvalidWebhookURL(hook.URL, err)
+	}
+	if target.Scheme != "http" && target.Scheme != "https" {
+		return client.Webhook{}, NewErrInvalidWebhookURL(hook.URL, nil)
+	}
+
+	col, err := db.getCollectionByName(ctx, txn, hook.Collection)
+	if err != nil {
+		return client.Webhook{}, err
+	}
+
+	if hook.Filter != "" {
+		// Parse the filter now so that invalid webhooks are rejected instead of failing
+		// on every change.
+		if _, err := db.parseWebhookFilter(hook); err != nil {
+			return client.Webhook{}, err
+		}
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return client.Webhook{}, err
+	}
+	hook.ID = id.String()
+
+	buf, err := json.Marshal(hook)
+	if err != nil {
+		return client.Webhook{}, err
+	}
+	err = txn.Systemstore().Put(ctx, core.NewWebhookKey(hook.ID).ToDS(), buf)
+	if err != nil {
+		return client.Webhook{}, err
+	}
+
+	txn.OnSuccess(func() {
+		db.webhookMu.Lock()
+		defer db.webhookMu.Unlock()
+		if db.webhookCtx.Err() != nil {
+			// The database is closing, the webhook will be started on the next startup.
+			return
+		}
+		db.webhooks[hook.ID] = db.startWebhookWorker(hook, col.SchemaRoot())
+	})
+
+	err = txn.Commit(ctx)
+	if err != nil {
+		return client.Webhook{}, err
+	}
+
+	hook.Secret = ""
+	return hook, nil
+}
+
+// DeleteWebhook deletes the webhook with the given ID from the persisted list.
+func (db *db) DeleteWebhook(ctx context.Context, id string) error {
+	txn, err := db.NewTxn(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+
+	key := core.NewWebhookKey(id)
+	exists, err := txn.Systemstore().Has(ctx, key.ToDS())
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return NewErrWebhookNotFound(id)
+	}
+
+	err = txn.Systemstore().Delete(ctx, key.ToDS())
+	if err != nil {
+		return err
+	}
+
+	txn.OnSuccess(func() {
+		db.webhookMu.Lock()
+		defer db.webhookMu.Unlock()
+		if hook, ok := db.webhooks[id]; ok {
+			close(hook.stop)
+			delete(db.webhooks, id)
+		}
+	})
+
+	return txn.Commit(ctx)
+}
+
+// GetAllWebhooks returns the full list of webhooks, without their secrets.
+func (db *db) GetAllWebhooks(ctx context.Context) ([]client.Webhook, error) {
+	txn, err := db.NewTxn(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Discard(ctx)
+
+	results, err := queryAll(ctx, txn.Systemstore(), dsq.Query{
+		Prefix: core.NewWebhookKey("").ToString(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var hooks []client.Webhook
+	for _, result := range results {
+		var hook client.Webhook
+		if err = json.Unmarshal(result.Value, &hook); err != nil {
+			return nil, err
+		}
+		hook.Secret = ""
+		hooks = append(hooks, hook)
+	}
+	return hooks, nil
+}
+
+// GetAllWebhookDeadLetters returns the full list of payloads that could not be delivered,
+// oldest first.
+func (db *db) GetAllWebhookDeadLetters(ctx context.Context) ([]client.WebhookDeadLetter, error) {
+	txn, err := db.NewTxn(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Discard(ctx)
+
+	results, err := queryAll(ctx, txn.Systemstore(), dsq.Query{
+		Prefix: core.NewWebhookDeadLetterKey("").ToString(),
+		Orders: []dsq.Order{dsq.OrderByKey{}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var letters []client.WebhookDeadLetter
+	for _, result := range results {
+		var letter client.WebhookDeadLetter
+		if err = json.Unmarshal(result.Value, &letter); err != nil {
+			return nil, err
+		}
+		letters = append(letters, letter)
+	}
+	return letters, nil
+}
+
+// startWebhooks loads the persisted webhooks and starts posting update events to them.
+//
+// Webhooks are only loaded if update events are enabled.
+func (db *db) startWebhooks(ctx context.Context) error {
+	if !db.events.Updates.HasValue() {
+		return nil
+	}
+
+	txn, err := db.NewTxn(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+
+	results, err := queryAll(ctx, txn.Systemstore(), dsq.Query{
+		Prefix: core.NewWebhookKey("").ToString(),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		var hook client.Webhook
+		if err = json.Unmarshal(result.Value, &hook); err != nil {
+			return err
+		}
+		col, err := db.getCollectionByName(ctx, txn, hook.Collection)
+		if err != nil {
+			return err
+		}
+		db.webhooks[hook.ID] = db.startWebhookWorker(hook, col.SchemaRoot())
+	}
+
+	updates, err := db.events.Updates.Value().Subscribe()
+	if err != nil {
+		return err
+	}
+
+	db.webhookWg.Add(1)
+	go db.handleWebhookLoop(updates)
+	return nil
+}
+
+// startWebhookWorker starts delivering the updates queued for the given webhook.
+//
+// Each webhook has a single worker so that its updates are delivered in the order
+// they were received.
+func (db *db) startWebhookWorker(hook client.Webhook, schemaRoot string) *webhook {
+	w := &webhook{
+		Webhook:    hook,
+		schemaRoot: schemaRoot,
+		queue:      make(chan events.Update, webhookQueueSize),
+		stop:       make(chan struct{}),
+	}
+
+	db.webhookWg.Add(1)
+	go func() {
+		defer db.webhookWg.Done()
+		for {
+			select {
+			case <-w.stop:
+				return
+			case update := <-w.queue:
+				db.deliverWebhook(db.webhookCtx, w, update)
+			}
+		}
+	}()
+	return w
+}
+
+// handleWebhookLoop queues the received update events on all matching webhooks
+// until the update event channel is closed.
+//
+// Queuing never blocks, so that a slow webhook cannot hold back the update events and the
+// writes publishing them. Updates that do not fit in the queue of a webhook are added to the
+// dead-letter list instead, whether or not they match its filter.
+func (db *db) handleWebhookLoop(updates events.Subscription[events.Update]) {
+	defer db.webhookWg.Done()
+
+	for update := range updates {
+		db.webhookMu.RLock()
+		hooks := make([]*webhook, 0, len(db.webhooks))
+		for _, hook := range db.webhooks {
+			if hook.schemaRoot == update.SchemaRoot {
+				hooks = append(hooks, hook)
+			}
+		}
+		db.webhookMu.RUnlock()
+
+		for _, hook := range hooks {
+			select {
+			case hook.queue <- update:
+			default:
+				err := NewErrWebhookQueueFull(hook.ID)
+				log.ErrorE(db.webhookCtx, "Failed to queue webhook payload", err)
+				letter := client.WebhookDeadLetter{
+					Payload: newWebhookPayload(hook, update),
+					URL:     hook.URL,
+					Error:   err.Error(),
+					Time:    time.Now(),
+				}
+				if err := db.addWebhookDeadLetter(db.webhookCtx, letter); err != nil {
+					log.ErrorE(db.webhookCtx, "Failed to store webhook dead letter", err)
+				}
+			}
+		}
+	}
+
+	db.webhookMu.Lock()
+	defer db.webhookMu.Unlock()
+	for id, hook := range db.webhooks {
+		close(hook.stop)
+		delete(db.webhooks, id)
+	}
+}
+
+// deliverWebhook posts the given update to the given webhook if it matches the webhook's filter,
+// retrying with exponential backoff on failure.
+//
+// Should all attempts fail, the payload will be added to the dead-letter list.
+func (db *db) deliverWebhook(ctx context.Context, hook *webhook, update events.Update) {
+	if hook.Filter != "" {
+		matches, err := db.webhookFilterMatches(ctx, hook, update)
+		if err != nil {
+			log.ErrorE(ctx, "Failed to apply webhook filter", err, logging.NewKV("WebhookID", hook.ID))
+			return
+		}
+		if !matches {
+			return
+		}
+	}
+
+	payload := newWebhookPayload(hook, update)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		log.ErrorE(ctx, "Failed to encode webhook payload", err, logging.NewKV("WebhookID", hook.ID))
+		return
+	}
+
+	maxAttempts := db.webhookMaxAttempts.Value()
+	backoff := db.webhookBackoff.Value()
+
+	var attempts int
+	for {
+		attempts++
+		err = postWebhook(ctx, hook.Webhook, body)
+		if err == nil {
+			return
+		}
+		if attempts >= maxAttempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+
+	if ctx.Err() != nil {
+		// The database is closing, the payload is dropped rather than dead-lettered
+		// as the failure was not caused by the webhook.
+		return
+	}
+
+	letter := client.WebhookDeadLetter{
+		Payload:  payload,
+		URL:      hook.URL,
+		Attempts: attempts,
+		Error:    err.Error(),
+		Time:     time.Now(),
+	}
+	if err := db.addWebhookDeadLetter(ctx, letter); err != nil {
+		log.ErrorE(ctx, "Failed to store webhook dead letter", err, logging.NewKV("WebhookID", hook.ID))
+	}
+}
+
+// newWebhookPayload returns the payload posted to the given webhook for the given update.
+func newWebhookPayload(hook *webhook, update events.Update) client.WebhookPayload {
+	return client.WebhookPayload{
+		WebhookID:  hook.ID,
+		Collection: hook.Collection,
+		DocKey:     update.DocKey,
+		Cid:        update.Cid.String(),
+		SchemaRoot: update.SchemaRoot,
+		Height:     update.Priority,
+	}
+}
+
+// webhookFilterMatches returns true if the document version of the given update matches
+// the filter of the given webhook.
+func (db *db) webhookFilterMatches(ctx context.Context, hook *webhook, update events.Update) (bool, error) {
+	subRequest, err := db.parseWebhookFilter(hook.Webhook)
+	if err != nil {
+		return false, err
+	}
+
+	txn, err := db.NewTxn(ctx, true)
+	if err != nil {
+		return false, err
+	}
+	defer txn.Discard(ctx)
+
+	p := planner.New(ctx, db.WithTxn(txn), txn)
+	result, err := p.RunSubscriptionRequest(ctx, subRequest.ToSelect(update.DocKey, update.Cid.String()))
+	if err != nil {
+		return false, err
+	}
+	return len(result) > 0, nil
+}
+
+// parseWebhookFilter parses the filter of the given webhook into a subscription request
+// on the webhook's collection.
+func (db *db) parseWebhookFilter(hook client.Webhook) (*request.ObjectSubscription, error) {
+	ast, err := db.parser.BuildRequestAST(
+		fmt.Sprintf("subscription { %s(filter: %s) { _key } }", hook.Collection, hook.Filter),
+	)
+	if err != nil {
+		return nil, NewErrInvalidWebhookFilter(hook.Filter, err)
+	}
+	parsed, errs := db.parser.Parse(ast)
+	if len(errs) > 0 {
+		return nil, NewErrInvalidWebhookFilter(hook.Filter, errs[0])
+	}
+	if len(parsed.Subscription) == 0 || len(parsed.Subscription[0].Selections) == 0 {
+		return nil, NewErrInvalidWebhookFilter(hook.Filter, nil)
+	}
+	subRequest, ok := parsed.Subscription[0].Selections[0].(*request.ObjectSubscription)
+	if !ok {
+		return nil, NewErrInvalidWebhookFilter(hook.Filter, nil)
+	}
+	return subRequest, nil
+}
+
+// addWebhookDeadLetter adds the given letter to the persisted dead-letter list.
+func (db *db) addWebhookDeadLetter(ctx context.Context, letter client.WebhookDeadLetter) error {
+	// Version 7 UUIDs are time ordered, so the dead-letter list is kept in the order that
+	// the deliveries failed.
+	id, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+	letter.ID = id.String()
+
+	buf, err := json.Marshal(letter)
+	if err != nil {
+		return err
+	}
+
+	txn, err := db.NewTxn(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard(ctx)
+
+	err = txn.Systemstore().Put(ctx, core.NewWebhookDeadLetterKey(letter.ID).ToDS(), buf)
+	if err != nil {
+		return err
+	}
+	return txn.Commit(ctx)
+}
+
+// postWebhook posts the given body to the given webhook, signing it if the webhook has a secret.
+func postWebhook(ctx context.Context, hook client.Webhook, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, webhookRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if hook.Secret != "" {
+		mac := hmac.New(sha256.New, []byte(hook.Secret))
+		mac.Write(body)
+		req.Header.Set(client.WebhookSignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close() //nolint:errcheck
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return NewErrWebhookResponseStatus(res.StatusCode)
+	}
+	return nil
+}
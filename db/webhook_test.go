@@ -0,0 +1,325 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+type webhookRequest struct {
+	payload   client.WebhookPayload
+	signature string
+	body      []byte
+}
+
+func newWebhookTestDB(ctx context.Context, t *testing.T) (*implicitTxnDB, client.Collection) {
+	db, err := newMemoryDB(ctx, WithUpdateEvents(), WithWebhookRetries(2, time.Millisecond))
+	require.NoError(t, err)
+	return db, newUserTestCollection(ctx, t, db)
+}
+
+func newWebhookTestServer(t *testing.T, status int) (*httptest.Server, chan webhookRequest) {
+	requests := make(chan webhookRequest, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+
+		var payload client.WebhookPayload
+		require.NoError(t, json.Unmarshal(body, &payload))
+
+		requests <- webhookRequest{
+			payload:   payload,
+			signature: req.Header.Get(client.WebhookSignatureHeader),
+			body:      body,
+		}
+		rw.WriteHeader(status)
+	}))
+	return server, requests
+}
+
+func waitForWebhookRequest(t *testing.T, requests chan webhookRequest) webhookRequest {
+	select {
+	case req := <-requests:
+		return req
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timeout waiting for webhook request")
+		return webhookRequest{}
+	}
+}
+
+func TestWebhook_WithCreate_PostsSignedPayload(t *testing.T) {
+	ctx := context.Background()
+	db, col := newWebhookTestDB(ctx, t)
+	defer db.Close()
+
+	server, requests := newWebhookTestServer(t, http.StatusOK)
+	defer server.Close()
+
+	hook, err := db.AddWebhook(ctx, client.Webhook{
+		Collection: "User",
+		URL:        server.URL,
+		Secret:     "secret",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, hook.ID)
+	require.Empty(t, hook.Secret)
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John", "age": 30}`))
+	require.NoError(t, err)
+
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	req := waitForWebhookRequest(t, requests)
+	require.Equal(t, hook.ID, req.payload.WebhookID)
+	require.Equal(t, "User", req.payload.Collection)
+	require.Equal(t, doc.Key().String(), req.payload.DocKey)
+	require.Equal(t, col.SchemaRoot(), req.payload.SchemaRoot)
+	require.Equal(t, uint64(1), req.payload.Height)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(req.body)
+	require.Equal(t, hex.EncodeToString(mac.Sum(nil)), req.signature)
+}
+
+func TestWebhook_WithFilter_OnlyPostsMatchingChanges(t *testing.T) {
+	ctx := context.Background()
+	db, col := newWebhookTestDB(ctx, t)
+	defer db.Close()
+
+	server, requests := newWebhookTestServer(t, http.StatusOK)
+	defer server.Close()
+
+	_, err := db.AddWebhook(ctx, client.Webhook{
+		Collection: "User",
+		Filter:     `{age: {_gt: 30}}`,
+		URL:        server.URL,
+	})
+	require.NoError(t, err)
+
+	young, err := client.NewDocFromJSON([]byte(`{"name": "John", "age": 21}`))
+	require.NoError(t, err)
+	err = col.Create(ctx, young)
+	require.NoError(t, err)
+
+	old, err := client.NewDocFromJSON([]byte(`{"name": "Bob", "age": 40}`))
+	require.NoError(t, err)
+	err = col.Create(ctx, old)
+	require.NoError(t, err)
+
+	req := waitForWebhookRequest(t, requests)
+	require.Equal(t, old.Key().String(), req.payload.DocKey)
+
+	select {
+	case req := <-requests:
+		require.Fail(t, "unexpected webhook request", req.payload.DocKey)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestWebhook_WithManyUpdates_PostsPayloadsInOrder(t *testing.T) {
+	ctx := context.Background()
+	db, col := newWebhookTestDB(ctx, t)
+	defer db.Close()
+
+	server, requests := newWebhookTestServer(t, http.StatusOK)
+	defer server.Close()
+
+	_, err := db.AddWebhook(ctx, client.Webhook{Collection: "User", URL: server.URL})
+	require.NoError(t, err)
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John", "age": 0}`))
+	require.NoError(t, err)
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	for i := 1; i < 5; i++ {
+		err = doc.Set("age", i)
+		require.NoError(t, err)
+		err = col.Update(ctx, doc)
+		require.NoError(t, err)
+	}
+
+	for i := 1; i <= 5; i++ {
+		req := waitForWebhookRequest(t, requests)
+		require.Equal(t, uint64(i), req.payload.Height)
+	}
+}
+
+func TestWebhook_WithoutUpdateEvents_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	db, err := newMemoryDB(ctx)
+	require.NoError(t, err)
+	defer db.Close()
+	newUserTestCollection(ctx, t, db)
+
+	_, err = db.AddWebhook(ctx, client.Webhook{Collection: "User", URL: "http://localhost/hook"})
+	require.ErrorIs(t, err, ErrWebhooksRequireUpdateEvents)
+}
+
+func TestWebhook_WithFailingEndpoint_AddsDeadLetter(t *testing.T) {
+	ctx := context.Background()
+	db, col := newWebhookTestDB(ctx, t)
+	defer db.Close()
+
+	server, requests := newWebhookTestServer(t, http.StatusInternalServerError)
+	defer server.Close()
+
+	hook, err := db.AddWebhook(ctx, client.Webhook{
+		Collection: "User",
+		URL:        server.URL,
+	})
+	require.NoError(t, err)
+
+	doc, err := client.NewDocFromJSON([]byte(`{"name": "John", "age": 30}`))
+	require.NoError(t, err)
+
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
+
+	waitForWebhookRequest(t, requests)
+	waitForWebhookRequest(t, requests)
+
+	var letters []client.WebhookDeadLetter
+	require.Eventually(t, func() bool {
+		letters, err = db.GetAllWebhookDeadLetters(ctx)
+		require.NoError(t, err)
+		return len(letters) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NotEmpty(t, letters[0].ID)
+	require.Equal(t, hook.ID, letters[0].Payload.WebhookID)
+	require.Equal(t, doc.Key().String(), letters[0].Payload.DocKey)
+	require.Equal(t, server.URL, letters[0].URL)
+	require.Equal(t, 2, letters[0].Attempts)
+	require.NotEmpty(t, letters[0].Error)
+}
+
+func TestWebhook_GetAllAndDelete(t *testing.T) {
+	ctx := context.Background()
+	db, _ := newWebhookTestDB(ctx, t)
+	defer db.Close()
+
+	hook, err := db.AddWebhook(ctx, client.Webhook{
+		Collection: "User",
+		URL:        "http://localhost:9999/hook",
+		Secret:     "secret",
+	})
+	require.NoError(t, err)
+
+	hooks, err := db.GetAllWebhooks(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []client.Webhook{hook}, hooks)
+
+	err = db.DeleteWebhook(ctx, hook.ID)
+	require.NoError(t, err)
+
+	hooks, err = db.GetAllWebhooks(ctx)
+	require.NoError(t, err)
+	require.Len(t, hooks, 0)
+
+	err = db.DeleteWebhook(ctx, hook.ID)
+	require.ErrorIs(t, err, NewErrWebhookNotFound(hook.ID))
+}
+
+func TestWebhook_WithInvalidWebhook_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	db, _ := newWebhookTestDB(ctx, t)
+	defer db.Close()
+
+	_, err := db.AddWebhook(ctx, client.Webhook{Collection: "User", URL: "ftp://localhost/hook"})
+	require.ErrorIs(t, err, NewErrInvalidWebhookURL("ftp://localhost/hook", nil))
+
+	_, err = db.AddWebhook(ctx, client.Webhook{Collection: "Unknown", URL: "http://localhost/hook"})
+	require.Error(t, err)
+
+	_, err = db.AddWebhook(ctx, client.Webhook{
+		Collection: "User",
+		Filter:     `{age: {_eq: 1}`,
+		URL:        "http://localhost/hook",
+	})
+	require.ErrorIs(t, err, NewErrInvalidWebhookFilter(`{age: {_eq: 1}`, nil))
+
+	hooks, err := db.GetAllWebhooks(ctx)
+	require.NoError(t, err)
+	require.Len(t, hooks, 0)
+}
+
+func TestWebhook_WithHangingEndpoint_KeepsCommittingWrites(t *testing.T) {
+	ctx := context.Background()
+	db, col := newWebhookTestDB(ctx, t)
+	defer db.Close()
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		select {
+		case <-release:
+		case <-req.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	hook, err := db.AddWebhook(ctx, client.Webhook{
+		Collection: "User",
+		URL:        server.URL,
+	})
+	require.NoError(t, err)
+
+	// More writes than the queue of the webhook and the update subscription can hold.
+	writes := 3 * webhookQueueSize
+	done := make(chan error)
+	go func() {
+		for i := 0; i < writes; i++ {
+			doc, err := client.NewDocFromJSON([]byte(fmt.Sprintf(`{"name": "John", "age": %d}`, i)))
+			if err != nil {
+				done <- err
+				return
+			}
+			if err := col.Create(ctx, doc); err != nil {
+				done <- err
+				return
+			}
+		}
+		done <- nil
+	}()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "timeout waiting for writes to commit")
+	}
+
+	require.Eventually(t, func() bool {
+		letters, err := db.GetAllWebhookDeadLetters(ctx)
+		require.NoError(t, err)
+		return len(letters) >= writes-webhookQueueSize-1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The webhook can still be deleted while its delivery hangs.
+	err = db.DeleteWebhook(ctx, hook.ID)
+	require.NoError(t, err)
+}
@@ -0,0 +1,379 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package http
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+const (
+	// API_KEY_HEADER_NAME is the header used to send static API keys.
+	API_KEY_HEADER_NAME = "x-defradb-api-key"
+	// AUTHORIZATION_HEADER_NAME is the header used to send JWT bearer tokens.
+	AUTHORIZATION_HEADER_NAME = "Authorization"
+
+	bearerPrefix = "Bearer "
+	// jwtLeeway is the clock skew tolerated when validating token times.
+	jwtLeeway = time.Minute
+)
+
+// Authenticator identifies the caller of an HTTP API request.
+type Authenticator interface {
+	// Authenticate returns the identity of the caller of the given request.
+	//
+	// ErrMissingCredentials is returned if the request carries no credentials
+	// handled by this authenticator, so that other authenticators may be tried.
+	Authenticate(req *http.Request) (string, error)
+}
+
+type apiKeyAuthenticator struct {
+	// identities maps the hash of each key to the identity of its holder.
+	identities map[[sha256.Size]byte]string
+}
+
+// NewAPIKeyAuthenticator returns an authenticator that accepts the given static API keys,
+// mapped to the identity of their holder, from the `x-defradb-api-key` header.
+func NewAPIKeyAuthenticator(keys map[string]string) Authenticator {
+	identities := make(map[[sha256.Size]byte]string, len(keys))
+	for key, identity := range keys {
+		identities[sha256.Sum256([]byte(key))] = identity
+	}
+	return &apiKeyAuthenticator{identities}
+}
+
+func (a *apiKeyAuthenticator) Authenticate(req *http.Request) (string, error) {
+	key := req.Header.Get(API_KEY_HEADER_NAME)
+	if key == "" {
+		return "", ErrMissingCredentials
+	}
+	// Keys are compared by hash so that lookups do not leak their contents through timing.
+	identity, ok := a.identities[sha256.Sum256([]byte(key))]
+	if !ok {
+		return "", ErrInvalidAPIKey
+	}
+	return identity, nil
+}
+
+// JWTOptions holds the claims that JWT bearer tokens are required to match.
+type JWTOptions struct {
+	// Issuer is the required `iss` claim, if set.
+	Issuer string
+	// Audience is the required `aud` claim, if set.
+	Audience string
+}
+
+type jwtAuthenticator struct {
+	keys    map[string]crypto.PublicKey
+	options JWTOptions
+	now     func() time.Time
+}
+
+// NewJWTAuthenticator returns an authenticator that accepts JWT bearer tokens signed by
+// one of the keys of the given JWKS document.
+//
+// The identity of the caller is the `sub` claim of the token.
+func NewJWTAuthenticator(jwks []byte, options JWTOptions) (Authenticator, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(jwks, &set); err != nil {
+		return nil, NewErrFailedToParseJWKS(err)
+	}
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, NewErrFailedToParseJWKS(err)
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, NewErrFailedToParseJWKS(ErrNoSigningKeys)
+	}
+	return &jwtAuthenticator{
+		keys:    keys,
+		options: options,
+		now:     time.Now,
+	}, nil
+}
+
+func (a *jwtAuthenticator) Authenticate(req *http.Request) (string, error) {
+	header := req.Header.Get(AUTHORIZATION_HEADER_NAME)
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return "", ErrMissingCredentials
+	}
+	claims, err := a.verify(strings.TrimPrefix(header, bearerPrefix))
+	if err != nil {
+		return "", err
+	}
+	if claims.Subject == "" {
+		return "", NewErrInvalidToken("missing sub claim")
+	}
+	return claims.Subject, nil
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type jwtClaims struct {
+	Subject   string          `json:"sub"`
+	Issuer    string          `json:"iss"`
+	Audience  json.RawMessage `json:"aud"`
+	ExpiresAt *float64        `json:"exp"`
+	NotBefore *float64        `json:"nbf"`
+}
+
+// verify checks the signature and registered claims of the given compact serialized token.
+func (a *jwtAuthenticator) verify(token string) (jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return jwtClaims{}, NewErrInvalidToken("malformed token")
+	}
+
+	var header jwtHeader
+	if err := decodeJWTSegment(parts[0], &header); err != nil {
+		return jwtClaims{}, NewErrInvalidToken("malformed header")
+	}
+	key, ok := a.keys[header.Kid]
+	if !ok {
+		return jwtClaims{}, NewErrInvalidToken("unknown signing key")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return jwtClaims{}, NewErrInvalidToken("malformed signature")
+	}
+	err = verifyJWTSignature(header.Alg, key, []byte(parts[0]+"."+parts[1]), signature)
+	if err != nil {
+		return jwtClaims{}, err
+	}
+
+	var claims jwtClaims
+	if err := decodeJWTSegment(parts[1], &claims); err != nil {
+		return jwtClaims{}, NewErrInvalidToken("malformed claims")
+	}
+	now := a.now()
+	if claims.ExpiresAt != nil && now.After(unixTime(*claims.ExpiresAt).Add(jwtLeeway)) {
+		return jwtClaims{}, NewErrInvalidToken("token has expired")
+	}
+	if claims.NotBefore != nil && now.Add(jwtLeeway).Before(unixTime(*claims.NotBefore)) {
+		return jwtClaims{}, NewErrInvalidToken("token is not yet valid")
+	}
+	if a.options.Issuer != "" && claims.Issuer != a.options.Issuer {
+		return jwtClaims{}, NewErrInvalidToken("invalid issuer")
+	}
+	if a.options.Audience != "" && !claims.hasAudience(a.options.Audience) {
+		return jwtClaims{}, NewErrInvalidToken("invalid audience")
+	}
+	return claims, nil
+}
+
+// hasAudience returns true if the aud claim, which may be either a single value
+// or a list of values, contains the given audience.
+func (c jwtClaims) hasAudience(audience string) bool {
+	var single string
+	if err := json.Unmarshal(c.Audience, &single); err == nil {
+		return single == audience
+	}
+	var list []string
+	if err := json.Unmarshal(c.Audience, &list); err != nil {
+		return false
+	}
+	for _, value := range list {
+		if value == audience {
+			return true
+		}
+	}
+	return false
+}
+
+// jwtECDSACurves maps the ECDSA signing algorithms to the name of the curve they use.
+var jwtECDSACurves = map[string]string{
+	"ES256": "P-256",
+	"ES384": "P-384",
+	"ES512": "P-521",
+}
+
+func verifyJWTSignature(alg string, key crypto.PublicKey, input []byte, signature []byte) error {
+	var hash crypto.Hash
+	switch alg {
+	case "RS256", "PS256", "ES256":
+		hash = crypto.SHA256
+	case "RS384", "PS384", "ES384":
+		hash = crypto.SHA384
+	case "RS512", "PS512", "ES512":
+		hash = crypto.SHA512
+	case "EdDSA":
+	default:
+		// This notably rejects unsigned tokens using the `none` algorithm.
+		return NewErrInvalidToken("unsupported signing algorithm")
+	}
+
+	var valid bool
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(alg, "RS") && !strings.HasPrefix(alg, "PS") {
+			return NewErrInvalidToken("signing algorithm does not match key")
+		}
+		digest := hashJWTInput(hash, input)
+		if strings.HasPrefix(alg, "PS") {
+			valid = rsa.VerifyPSS(k, hash, digest, signature, nil) == nil
+		} else {
+			valid = rsa.VerifyPKCS1v15(k, hash, digest, signature) == nil
+		}
+
+	case *ecdsa.PublicKey:
+		// Each ECDSA algorithm is bound to a single curve.
+		if jwtECDSACurves[alg] != k.Curve.Params().Name {
+			return NewErrInvalidToken("signing algorithm does not match key")
+		}
+		// ECDSA signatures are the concatenation of the fixed size r and s values.
+		size := (k.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return NewErrInvalidToken("invalid signature")
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		valid = ecdsa.Verify(k, hashJWTInput(hash, input), r, s)
+
+	case ed25519.PublicKey:
+		if alg != "EdDSA" {
+			return NewErrInvalidToken("signing algorithm does not match key")
+		}
+		valid = ed25519.Verify(k, input, signature)
+	}
+
+	if !valid {
+		return NewErrInvalidToken("invalid signature")
+	}
+	return nil
+}
+
+func hashJWTInput(hash crypto.Hash, input []byte) []byte {
+	h := hash.New()
+	_, _ = h.Write(input)
+	return h.Sum(nil)
+}
+
+func decodeJWTSegment(segment string, out any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+func unixTime(seconds float64) time.Time {
+	return time.Unix(0, int64(seconds*float64(time.Second)))
+}
+
+// jsonWebKey is a public key of a JWKS document as defined in RFC 7517.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() < 2 || exponent.Int64() > 1<<31-1 {
+			return nil, NewErrUnsupportedJWK(k.Kid, "invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, NewErrUnsupportedJWK(k.Kid, "unsupported curve "+k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !curve.IsOnCurve(key.X, key.Y) {
+			return nil, NewErrUnsupportedJWK(k.Kid, "point is not on curve")
+		}
+		return key, nil
+
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, NewErrUnsupportedJWK(k.Kid, "unsupported curve "+k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, NewErrUnsupportedJWK(k.Kid, "invalid key size")
+		}
+		return ed25519.PublicKey(x), nil
+
+	default:
+		return nil, NewErrUnsupportedJWK(k.Kid, "unsupported key type "+k.Kty)
+	}
+}
+
+// authenticate returns the identity of the caller of the given request as established by
+// the first of the given authenticators that recognises its credentials.
+func authenticate(req *http.Request, authenticators []Authenticator) (string, error) {
+	for _, authenticator := range authenticators {
+		identity, err := authenticator.Authenticate(req)
+		if errors.Is(err, ErrMissingCredentials) {
+			continue
+		}
+		return identity, err
+	}
+	return "", ErrMissingCredentials
+}
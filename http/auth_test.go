@@ -0,0 +1,261 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package http
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeSegment(t *testing.T, value any) string {
+	data, err := json.Marshal(value)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func signES256Token(t *testing.T, key *ecdsa.PrivateKey, kid string, claims map[string]any) string {
+	input := encodeSegment(t, map[string]any{"alg": "ES256", "kid": kid}) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(input))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+
+	size := (key.Curve.Params().BitSize + 7) / 8
+	signature := make([]byte, 2*size)
+	r.FillBytes(signature[:size])
+	s.FillBytes(signature[size:])
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func signEdDSAToken(t *testing.T, key ed25519.PrivateKey, claims map[string]any) string {
+	input := encodeSegment(t, map[string]any{"alg": "EdDSA"}) + "." + encodeSegment(t, claims)
+	signature, err := key.Sign(rand.Reader, []byte(input), crypto.Hash(0))
+	require.NoError(t, err)
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func newECJWKS(t *testing.T, kid string, key *ecdsa.PrivateKey) []byte {
+	size := (key.Curve.Params().BitSize + 7) / 8
+	jwks, err := json.Marshal(map[string]any{
+		"keys": []map[string]any{{
+			"kty": "EC",
+			"kid": kid,
+			"crv": key.Curve.Params().Name,
+			"x":   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, size))),
+			"y":   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, size))),
+		}},
+	})
+	require.NoError(t, err)
+	return jwks
+}
+
+func newBearerRequest(token string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "http://localhost:9181/api/v0/collections", nil)
+	req.Header.Set(AUTHORIZATION_HEADER_NAME, bearerPrefix+token)
+	return req
+}
+
+func TestAPIKeyAuthenticator_WithValidKey_ReturnsIdentity(t *testing.T) {
+	auth := NewAPIKeyAuthenticator(map[string]string{"secret": "alice"})
+
+	req := httptest.NewRequest(http.MethodGet, "http://localhost:9181/api/v0/collections", nil)
+	req.Header.Set(API_KEY_HEADER_NAME, "secret")
+
+	identity, err := auth.Authenticate(req)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", identity)
+}
+
+func TestAPIKeyAuthenticator_WithInvalidKey_ReturnsError(t *testing.T) {
+	auth := NewAPIKeyAuthenticator(map[string]string{"secret": "alice"})
+
+	req := httptest.NewRequest(http.MethodGet, "http://localhost:9181/api/v0/collections", nil)
+	req.Header.Set(API_KEY_HEADER_NAME, "other")
+
+	_, err := auth.Authenticate(req)
+	require.ErrorIs(t, err, ErrInvalidAPIKey)
+}
+
+func TestAPIKeyAuthenticator_WithoutKey_ReturnsMissingCredentials(t *testing.T) {
+	auth := NewAPIKeyAuthenticator(map[string]string{"secret": "alice"})
+
+	req := httptest.NewRequest(http.MethodGet, "http://localhost:9181/api/v0/collections", nil)
+
+	_, err := auth.Authenticate(req)
+	require.ErrorIs(t, err, ErrMissingCredentials)
+}
+
+func TestJWTAuthenticator_WithValidToken_ReturnsSubject(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	auth, err := NewJWTAuthenticator(newECJWKS(t, "key1", key), JWTOptions{
+		Issuer:   "https://issuer.example",
+		Audience: "defradb",
+	})
+	require.NoError(t, err)
+
+	token := signES256Token(t, key, "key1", map[string]any{
+		"sub": "alice",
+		"iss": "https://issuer.example",
+		"aud": []string{"other", "defradb"},
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+
+	identity, err := auth.Authenticate(newBearerRequest(token))
+	require.NoError(t, err)
+	assert.Equal(t, "alice", identity)
+}
+
+func TestJWTAuthenticator_WithEdDSAToken_ReturnsSubject(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	jwks, err := json.Marshal(map[string]any{
+		"keys": []map[string]any{{
+			"kty": "OKP",
+			"crv": "Ed25519",
+			"x":   base64.RawURLEncoding.EncodeToString(pub),
+		}},
+	})
+	require.NoError(t, err)
+
+	auth, err := NewJWTAuthenticator(jwks, JWTOptions{})
+	require.NoError(t, err)
+
+	token := signEdDSAToken(t, priv, map[string]any{"sub": "bob"})
+
+	identity, err := auth.Authenticate(newBearerRequest(token))
+	require.NoError(t, err)
+	assert.Equal(t, "bob", identity)
+}
+
+func TestJWTAuthenticator_WithExpiredToken_ReturnsError(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	auth, err := NewJWTAuthenticator(newECJWKS(t, "key1", key), JWTOptions{})
+	require.NoError(t, err)
+
+	token := signES256Token(t, key, "key1", map[string]any{
+		"sub": "alice",
+		"exp": time.Now().Add(-time.Hour).Unix(),
+	})
+
+	_, err = auth.Authenticate(newBearerRequest(token))
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestJWTAuthenticator_WithWrongIssuer_ReturnsError(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	auth, err := NewJWTAuthenticator(newECJWKS(t, "key1", key), JWTOptions{Issuer: "https://issuer.example"})
+	require.NoError(t, err)
+
+	token := signES256Token(t, key, "key1", map[string]any{
+		"sub": "alice",
+		"iss": "https://other.example",
+	})
+
+	_, err = auth.Authenticate(newBearerRequest(token))
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestJWTAuthenticator_WithUnknownSigningKey_ReturnsError(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	auth, err := NewJWTAuthenticator(newECJWKS(t, "key1", key), JWTOptions{})
+	require.NoError(t, err)
+
+	token := signES256Token(t, otherKey, "key1", map[string]any{"sub": "alice"})
+
+	_, err = auth.Authenticate(newBearerRequest(token))
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestJWTAuthenticator_WithAlgorithmOfOtherCurve_ReturnsError(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+
+	auth, err := NewJWTAuthenticator(newECJWKS(t, "key1", key), JWTOptions{})
+	require.NoError(t, err)
+
+	token := signES256Token(t, key, "key1", map[string]any{"sub": "alice"})
+
+	_, err = auth.Authenticate(newBearerRequest(token))
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestJWTAuthenticator_WithUnsignedToken_ReturnsError(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	auth, err := NewJWTAuthenticator(newECJWKS(t, "key1", key), JWTOptions{})
+	require.NoError(t, err)
+
+	token := encodeSegment(t, map[string]any{"alg": "none", "kid": "key1"}) + "." +
+		encodeSegment(t, map[string]any{"sub": "alice"}) + "."
+
+	_, err = auth.Authenticate(newBearerRequest(token))
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestNewJWTAuthenticator_WithInvalidJWKS_ReturnsError(t *testing.T) {
+	_, err := NewJWTAuthenticator([]byte(`{"keys": []}`), JWTOptions{})
+	require.ErrorIs(t, err, ErrNoSigningKeys)
+}
+
+func TestAuthMiddleware_WithoutCredentials_ReturnsUnauthorized(t *testing.T) {
+	cdb := setupDatabase(t)
+
+	handler, err := NewHandler(cdb, ServerOptions{
+		Authenticators: []Authenticator{NewAPIKeyAuthenticator(map[string]string{"secret": "alice"})},
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "http://localhost:9181/api/v0/collections", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Result().StatusCode)
+}
+
+func TestAuthMiddleware_WithValidCredentials_ReturnsOK(t *testing.T) {
+	cdb := setupDatabase(t)
+
+	handler, err := NewHandler(cdb, ServerOptions{
+		Authenticators: []Authenticator{NewAPIKeyAuthenticator(map[string]string{"secret": "alice"})},
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "http://localhost:9181/api/v0/collections", nil)
+	req.Header.Set(API_KEY_HEADER_NAME, "secret")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Result().StatusCode)
+}
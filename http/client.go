@@ -17,6 +17,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 
 	blockstore "github.com/ipfs/boxo/blockstore"
@@ -34,8 +35,8 @@ type Client struct {
 	http *httpClient
 }
 
-func NewClient(rawURL string) (*Client, error) {
-	httpClient, err := newHttpClient(rawURL)
+func NewClient(rawURL string, opts ...ClientOption) (*Client, error) {
+	httpClient, err := newHttpClient(rawURL, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -117,6 +118,47 @@ func (c *Client) BasicExport(ctx context.Context, config *client.BackupConfig) e
 	return err
 }
 
+func (c *Client) BasicImportFrom(ctx context.Context, config *client.BackupConfig, r io.Reader) error {
+	methodURL := c.http.baseURL.JoinPath("backup", "import", "stream")
+
+	query := url.Values{}
+	if config.Format != "" {
+		query.Set("format", config.Format)
+	}
+	if config.Compression != "" {
+		query.Set("compression", config.Compression)
+	}
+	methodURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), r)
+	if err != nil {
+		return err
+	}
+	_, err = c.http.request(req)
+	return err
+}
+
+func (c *Client) BasicExportTo(ctx context.Context, config *client.BackupConfig, w io.Writer) error {
+	methodURL := c.http.baseURL.JoinPath("backup", "export", "stream")
+
+	body, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	res, err := c.http.requestStream(req)
+	if err != nil {
+		return err
+	}
+	defer res.Close() //nolint:errcheck
+
+	_, err = io.Copy(w, res)
+	return err
+}
+
 func (c *Client) AddSchema(ctx context.Context, schema string) ([]client.CollectionDescription, error) {
 	methodURL := c.http.baseURL.JoinPath("schema")
 
@@ -360,6 +402,30 @@ func (c *Client) ExecRequest(ctx context.Context, query string) *client.RequestR
 	return result
 }
 
+func (c *Client) ChangesSince(
+	ctx context.Context,
+	collectionName string,
+	cursor uint64,
+	limit uint64,
+) (client.ChangesResult, error) {
+	query := url.Values{}
+	query.Add("cursor", strconv.FormatUint(cursor, 10))
+	query.Add("limit", strconv.FormatUint(limit, 10))
+
+	methodURL := c.http.baseURL.JoinPath("collections", collectionName, "changes")
+	methodURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, methodURL.String(), nil)
+	if err != nil {
+		return client.ChangesResult{}, err
+	}
+	var result client.ChangesResult
+	if err := c.http.requestJson(req, &result); err != nil {
+		return client.ChangesResult{}, err
+	}
+	return result, nil
+}
+
 func (c *Client) execRequestSubscription(ctx context.Context, r io.ReadCloser) *events.Publisher[events.Update] {
 	pubCh := events.New[events.Update](0, 0)
 	pub, err := events.NewPublisher[events.Update](pubCh, 0)
@@ -16,8 +16,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 
 	sse "github.com/vito/go-sse/sse"
@@ -244,6 +246,46 @@ func (c *Collection) UpdateWithKeys(
 	})
 }
 
+func (c *Collection) Upsert(
+	ctx context.Context,
+	filter any,
+	create *client.Document,
+	updater string,
+) (*client.UpsertResult, error) {
+	methodURL := c.http.baseURL.JoinPath("collections", c.Description().Name, "upsert")
+
+	// We must call this here, else the doc key on the given object will not match
+	// that of the document saved in the database
+	err := create.RemapAliasFieldsAndDockey(c.Schema().Fields)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := create.String()
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(CollectionUpsertRequest{
+		Filter:  filter,
+		Create:  doc,
+		Updater: updater,
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	var result client.UpsertResult
+	if err := c.http.requestJson(req, &result); err != nil {
+		return nil, err
+	}
+	if result.Created {
+		create.Clean()
+	}
+	return &result, nil
+}
+
 func (c *Collection) DeleteWith(ctx context.Context, target any) (*client.DeleteResult, error) {
 	switch t := target.(type) {
 	case string, map[string]any, *request.Filter:
@@ -325,6 +367,80 @@ func (c *Collection) Get(ctx context.Context, key client.DocKey, showDeleted boo
 	return doc, nil
 }
 
+func (c *Collection) Diff(
+	ctx context.Context,
+	key client.DocKey,
+	fromCID string,
+	toCID string,
+) ([]client.FieldDiff, error) {
+	query := url.Values{}
+	if fromCID != "" {
+		query.Add("from", fromCID)
+	}
+	if toCID != "" {
+		query.Add("to", toCID)
+	}
+
+	methodURL := c.http.baseURL.JoinPath("collections", c.Description().Name, key.String(), "diff")
+	methodURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, methodURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	var diff []client.FieldDiff
+	if err := c.http.requestJson(req, &diff); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+func (c *Collection) Revert(ctx context.Context, key client.DocKey, cid string) error {
+	methodURL := c.http.baseURL.JoinPath("collections", c.Description().Name, key.String(), "revert")
+
+	body, err := json.Marshal(CollectionRevertRequest{Cid: cid})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	_, err = c.http.request(req)
+	return err
+}
+
+func (c *Collection) Import(
+	ctx context.Context,
+	config client.ImportConfig,
+	r io.Reader,
+) (client.ImportResult, error) {
+	methodURL := c.http.baseURL.JoinPath("collections", c.Description().Name, "import")
+
+	query := url.Values{}
+	query.Set("format", config.Format)
+	if config.BatchSize > 0 {
+		query.Set("batch_size", strconv.Itoa(config.BatchSize))
+	}
+	for column, field := range config.Mapping {
+		query.Add("map", column+":"+field)
+	}
+	for field, naturalKey := range config.Relations {
+		query.Add("relation", field+":"+naturalKey)
+	}
+	methodURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), r)
+	if err != nil {
+		return client.ImportResult{}, err
+	}
+	var result client.ImportResult
+	if err := c.http.requestJson(req, &result); err != nil {
+		return client.ImportResult{}, err
+	}
+	return result, nil
+}
+
 func (c *Collection) WithTxn(tx datastore.Txn) client.Collection {
 	return &Collection{
 		http: c.http.withTxn(tx.ID()),
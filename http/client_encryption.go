@@ -0,0 +1,47 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+func (c *Client) SetEncryptionKey(ctx context.Context, collectionName string, key []byte) error {
+	methodURL := c.http.baseURL.JoinPath("encryption-keys", collectionName)
+
+	body, err := json.Marshal(EncryptionKey{Key: key})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	_, err = c.http.request(req)
+	return err
+}
+
+func (c *Client) GetEncryptionKey(ctx context.Context, collectionName string) ([]byte, error) {
+	methodURL := c.http.baseURL.JoinPath("encryption-keys", collectionName)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, methodURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	var key EncryptionKey
+	if err := c.http.requestJson(req, &key); err != nil {
+		return nil, err
+	}
+	return key.Key, nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func (c *Client) SetPolicy(ctx context.Context, policy client.Policy) error {
+	methodURL := c.http.baseURL.JoinPath("policy")
+
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	_, err = c.http.request(req)
+	return err
+}
+
+func (c *Client) GetPolicy(ctx context.Context) (client.Policy, error) {
+	methodURL := c.http.baseURL.JoinPath("policy")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, methodURL.String(), nil)
+	if err != nil {
+		return client.Policy{}, err
+	}
+	var policy client.Policy
+	if err := c.http.requestJson(req, &policy); err != nil {
+		return client.Policy{}, err
+	}
+	return policy, nil
+}
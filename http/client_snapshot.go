@@ -0,0 +1,38 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func (c *Client) CreateSnapshot(ctx context.Context, filepath string) (client.SnapshotInfo, error) {
+	methodURL := c.http.baseURL.JoinPath("backup", "snapshot")
+
+	body, err := json.Marshal(SnapshotRequest{Filepath: filepath})
+	if err != nil {
+		return client.SnapshotInfo{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return client.SnapshotInfo{}, err
+	}
+	var info client.SnapshotInfo
+	if err := c.http.requestJson(req, &info); err != nil {
+		return client.SnapshotInfo{}, err
+	}
+	return info, nil
+}
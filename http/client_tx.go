@@ -26,8 +26,8 @@ type Transaction struct {
 	http *httpClient
 }
 
-func NewTransaction(rawURL string, id uint64) (*Transaction, error) {
-	httpClient, err := newHttpClient(rawURL)
+func NewTransaction(rawURL string, id uint64, opts ...ClientOption) (*Transaction, error) {
+	httpClient, err := newHttpClient(rawURL, opts...)
 	if err != nil {
 		return nil, err
 	}
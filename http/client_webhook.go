@@ -0,0 +1,77 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func (c *Client) AddWebhook(ctx context.Context, hook client.Webhook) (client.Webhook, error) {
+	methodURL := c.http.baseURL.JoinPath("webhooks")
+
+	body, err := json.Marshal(hook)
+	if err != nil {
+		return client.Webhook{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, methodURL.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return client.Webhook{}, err
+	}
+	var res client.Webhook
+	if err := c.http.requestJson(req, &res); err != nil {
+		return client.Webhook{}, err
+	}
+	return res, nil
+}
+
+func (c *Client) DeleteWebhook(ctx context.Context, id string) error {
+	methodURL := c.http.baseURL.JoinPath("webhooks", id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, methodURL.String(), nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.http.request(req)
+	return err
+}
+
+func (c *Client) GetAllWebhooks(ctx context.Context) ([]client.Webhook, error) {
+	methodURL := c.http.baseURL.JoinPath("webhooks")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, methodURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	var hooks []client.Webhook
+	if err := c.http.requestJson(req, &hooks); err != nil {
+		return nil, err
+	}
+	return hooks, nil
+}
+
+func (c *Client) GetAllWebhookDeadLetters(ctx context.Context) ([]client.WebhookDeadLetter, error) {
+	methodURL := c.http.baseURL.JoinPath("webhooks", "deadletters")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, methodURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	var letters []client.WebhookDeadLetter
+	if err := c.http.requestJson(req, &letters); err != nil {
+		return nil, err
+	}
+	return letters, nil
+}
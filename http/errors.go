@@ -17,7 +17,11 @@ import (
 )
 
 const (
-	errFailedToLoadKeys string = "failed to load given keys"
+	errFailedToLoadKeys  string = "failed to load given keys"
+	errFailedToParseJWKS string = "failed to parse JWKS"
+	errInvalidToken      string = "invalid token"
+	errUnsupportedJWK    string = "unsupported JSON web key"
+	errInvalidQueryParam string = "invalid query parameter"
 )
 
 // Errors returnable from this package.
@@ -43,6 +47,10 @@ var (
 	ErrMissingRequest        = errors.New("missing request")
 	ErrInvalidTransactionId  = errors.New("invalid transaction id")
 	ErrP2PDisabled           = errors.New("p2p network is disabled")
+	ErrMissingCredentials    = errors.New("missing credentials")
+	ErrInvalidAPIKey         = errors.New("invalid api key")
+	ErrInvalidToken          = errors.New(errInvalidToken)
+	ErrNoSigningKeys         = errors.New("no signing keys found")
 )
 
 type errorResponse struct {
@@ -62,6 +70,10 @@ func (e *errorResponse) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func NewErrInvalidQueryParam(name, value string) error {
+	return errors.New(errInvalidQueryParam, errors.NewKV("Name", name), errors.NewKV("Value", value))
+}
+
 func NewErrFailedToLoadKeys(inner error, publicKeyPath, privateKeyPath string) error {
 	return errors.Wrap(
 		errFailedToLoadKeys,
@@ -70,3 +82,15 @@ func NewErrFailedToLoadKeys(inner error, publicKeyPath, privateKeyPath string) e
 		errors.NewKV("PrivateKeyPath", privateKeyPath),
 	)
 }
+
+func NewErrFailedToParseJWKS(inner error) error {
+	return errors.Wrap(errFailedToParseJWKS, inner)
+}
+
+func NewErrInvalidToken(reason string) error {
+	return errors.New(errInvalidToken, errors.NewKV("Reason", reason))
+}
+
+func NewErrUnsupportedJWK(kid string, reason string) error {
+	return errors.New(errUnsupportedJWK, errors.NewKV("Kid", kid), errors.NewKV("Reason", reason))
+}
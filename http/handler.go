@@ -42,6 +42,10 @@ func NewHandler(db client.DB, opts ServerOptions) (*Handler, error) {
 	store_handler := &storeHandler{}
 	collection_handler := &collectionHandler{}
 	p2p_handler := &p2pHandler{}
+	webhook_handler := &webhookHandler{}
+	policy_handler := &policyHandler{}
+	encryption_handler := &encryptionHandler{}
+	snapshot_handler := &snapshotHandler{}
 	lens_handler := &lensHandler{}
 	ccip_handler := &ccipHandler{}
 
@@ -52,6 +56,7 @@ func NewHandler(db client.DB, opts ServerOptions) (*Handler, error) {
 
 	router.AddMiddleware(
 		ApiMiddleware(db, txs, opts),
+		AuthMiddleware(opts),
 		TransactionMiddleware,
 		StoreMiddleware,
 	)
@@ -59,6 +64,10 @@ func NewHandler(db client.DB, opts ServerOptions) (*Handler, error) {
 	tx_handler.bindRoutes(router)
 	store_handler.bindRoutes(router)
 	p2p_handler.bindRoutes(router)
+	webhook_handler.bindRoutes(router)
+	policy_handler.bindRoutes(router)
+	encryption_handler.bindRoutes(router)
+	snapshot_handler.bindRoutes(router)
 	ccip_handler.bindRoutes(router)
 
 	router.AddRouteGroup(func(r *Router) {
@@ -16,6 +16,7 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
@@ -31,6 +32,16 @@ type CollectionDeleteRequest struct {
 	Filter any      `json:"filter"`
 }
 
+type CollectionRevertRequest struct {
+	Cid string `json:"cid"`
+}
+
+type CollectionUpsertRequest struct {
+	Filter  any    `json:"filter"`
+	Create  string `json:"create"`
+	Updater string `json:"updater"`
+}
+
 type CollectionUpdateRequest struct {
 	Key     string   `json:"key"`
 	Keys    []string `json:"keys"`
@@ -42,7 +53,7 @@ func (s *collectionHandler) Create(rw http.ResponseWriter, req *http.Request) {
 	col := req.Context().Value(colContextKey).(client.Collection)
 
 	var body any
-	if err := requestJSON(req, &body); err != nil {
+	if err := requestJSONWithNumbers(req, &body); err != nil {
 		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
 		return
 	}
@@ -184,6 +195,27 @@ func (s *collectionHandler) UpdateWith(rw http.ResponseWriter, req *http.Request
 	}
 }
 
+func (s *collectionHandler) Upsert(rw http.ResponseWriter, req *http.Request) {
+	col := req.Context().Value(colContextKey).(client.Collection)
+
+	var request CollectionUpsertRequest
+	if err := requestJSON(req, &request); err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	doc, err := client.NewDocFromJSON([]byte(request.Create))
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	result, err := col.Upsert(req.Context(), request.Filter, doc, request.Updater)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, result)
+}
+
 func (s *collectionHandler) Update(rw http.ResponseWriter, req *http.Request) {
 	col := req.Context().Value(colContextKey).(client.Collection)
 
@@ -252,6 +284,84 @@ func (s *collectionHandler) Get(rw http.ResponseWriter, req *http.Request) {
 	responseJSON(rw, http.StatusOK, docMap)
 }
 
+func (s *collectionHandler) Diff(rw http.ResponseWriter, req *http.Request) {
+	col := req.Context().Value(colContextKey).(client.Collection)
+
+	docKey, err := client.NewDocKeyFromString(chi.URLParam(req, "key"))
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	diff, err := col.Diff(req.Context(), docKey, req.URL.Query().Get("from"), req.URL.Query().Get("to"))
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, diff)
+}
+
+func (s *collectionHandler) Revert(rw http.ResponseWriter, req *http.Request) {
+	col := req.Context().Value(colContextKey).(client.Collection)
+
+	docKey, err := client.NewDocKeyFromString(chi.URLParam(req, "key"))
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	var request CollectionRevertRequest
+	if err := requestJSON(req, &request); err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	err = col.Revert(req.Context(), docKey, request.Cid)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	rw.WriteHeader(http.StatusOK)
+}
+
+func (s *collectionHandler) Import(rw http.ResponseWriter, req *http.Request) {
+	col := req.Context().Value(colContextKey).(client.Collection)
+
+	config := client.ImportConfig{
+		Format:    req.URL.Query().Get("format"),
+		Mapping:   make(map[string]string),
+		Relations: make(map[string]string),
+	}
+	if value := req.URL.Query().Get("batch_size"); value != "" {
+		batchSize, err := strconv.Atoi(value)
+		if err != nil {
+			responseJSON(rw, http.StatusBadRequest, errorResponse{NewErrInvalidQueryParam("batch_size", value)})
+			return
+		}
+		config.BatchSize = batchSize
+	}
+	for _, value := range req.URL.Query()["map"] {
+		column, field, ok := strings.Cut(value, ":")
+		if !ok {
+			responseJSON(rw, http.StatusBadRequest, errorResponse{NewErrInvalidQueryParam("map", value)})
+			return
+		}
+		config.Mapping[column] = field
+	}
+	for _, value := range req.URL.Query()["relation"] {
+		field, naturalKey, ok := strings.Cut(value, ":")
+		if !ok {
+			responseJSON(rw, http.StatusBadRequest, errorResponse{NewErrInvalidQueryParam("relation", value)})
+			return
+		}
+		config.Relations[field] = naturalKey
+	}
+
+	result, err := col.Import(req.Context(), config, req.Body)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, result)
+}
+
 type DocKeyResult struct {
 	Key   string `json:"key"`
 	Error string `json:"error"`
@@ -333,6 +443,38 @@ func (s *collectionHandler) DropIndex(rw http.ResponseWriter, req *http.Request)
 	rw.WriteHeader(http.StatusOK)
 }
 
+func (s *collectionHandler) ChangesSince(rw http.ResponseWriter, req *http.Request) {
+	store := req.Context().Value(storeContextKey).(client.Store)
+	col := req.Context().Value(colContextKey).(client.Collection)
+
+	var cursor uint64
+	if req.URL.Query().Has("cursor") {
+		var err error
+		cursor, err = strconv.ParseUint(req.URL.Query().Get("cursor"), 10, 64)
+		if err != nil {
+			responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+			return
+		}
+	}
+
+	var limit uint64
+	if req.URL.Query().Has("limit") {
+		var err error
+		limit, err = strconv.ParseUint(req.URL.Query().Get("limit"), 10, 64)
+		if err != nil {
+			responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+			return
+		}
+	}
+
+	result, err := store.ChangesSince(req.Context(), col.Name(), cursor, limit)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, result)
+}
+
 func (h *collectionHandler) bindRoutes(router *Router) {
 	errorResponse := &openapi3.ResponseRef{
 		Ref: "#/components/responses/error",
@@ -358,6 +500,24 @@ func (h *collectionHandler) bindRoutes(router *Router) {
 	indexSchema := &openapi3.SchemaRef{
 		Ref: "#/components/schemas/index",
 	}
+	changesResultSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/changes_result",
+	}
+	fieldDiffSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/field_diff",
+	}
+	importResultSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/import_result",
+	}
+	collectionRevertSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/collection_revert",
+	}
+	collectionUpsertSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/collection_upsert",
+	}
+	upsertResultSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/upsert_result",
+	}
 
 	collectionNamePathParam := openapi3.NewPathParameter("name").
 		WithDescription("Collection name").
@@ -408,6 +568,25 @@ func (h *collectionHandler) bindRoutes(router *Router) {
 	collectionUpdateWith.AddResponse(200, collectionUpdateWithResponse)
 	collectionUpdateWith.Responses["400"] = errorResponse
 
+	collectionUpsertRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithContent(openapi3.NewContentWithJSONSchemaRef(collectionUpsertSchema))
+
+	collectionUpsertResponse := openapi3.NewResponse().
+		WithDescription("Upsert result").
+		WithJSONSchemaRef(upsertResultSchema)
+
+	collectionUpsert := openapi3.NewOperation()
+	collectionUpsert.OperationID = "collection_upsert"
+	collectionUpsert.Description = "Update the document matching a filter, or create it"
+	collectionUpsert.Tags = []string{"collection"}
+	collectionUpsert.AddParameter(collectionNamePathParam)
+	collectionUpsert.RequestBody = &openapi3.RequestBodyRef{
+		Value: collectionUpsertRequest,
+	}
+	collectionUpsert.AddResponse(200, collectionUpsertResponse)
+	collectionUpsert.Responses["400"] = errorResponse
+
 	collectionDeleteWithRequest := openapi3.NewRequestBody().
 		WithRequired(true).
 		WithContent(openapi3.NewContentWithJSONSchemaRef(collectionDeleteSchema))
@@ -511,6 +690,85 @@ func (h *collectionHandler) bindRoutes(router *Router) {
 	collectionDelete.Responses["200"] = successResponse
 	collectionDelete.Responses["400"] = errorResponse
 
+	diffFromQueryParam := openapi3.NewQueryParameter("from").
+		WithDescription("CID of the composite commit of the earlier version, defaults to before creation").
+		WithSchema(openapi3.NewStringSchema())
+	diffToQueryParam := openapi3.NewQueryParameter("to").
+		WithDescription("CID of the composite commit of the later version, defaults to the current version").
+		WithSchema(openapi3.NewStringSchema())
+
+	fieldDiffArraySchema := openapi3.NewArraySchema()
+	fieldDiffArraySchema.Items = fieldDiffSchema
+
+	collectionDiffResponse := openapi3.NewResponse().
+		WithDescription("Fields that differ between the two versions").
+		WithJSONSchema(fieldDiffArraySchema)
+
+	collectionDiff := openapi3.NewOperation()
+	collectionDiff.Description = "Get the differences between two versions of a document"
+	collectionDiff.OperationID = "collection_diff"
+	collectionDiff.Tags = []string{"collection"}
+	collectionDiff.AddParameter(collectionNamePathParam)
+	collectionDiff.AddParameter(documentKeyPathParam)
+	collectionDiff.AddParameter(diffFromQueryParam)
+	collectionDiff.AddParameter(diffToQueryParam)
+	collectionDiff.AddResponse(200, collectionDiffResponse)
+	collectionDiff.Responses["400"] = errorResponse
+
+	collectionRevertRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithContent(openapi3.NewContentWithJSONSchemaRef(collectionRevertSchema))
+
+	collectionRevert := openapi3.NewOperation()
+	collectionRevert.Description = "Revert a document to a previous version"
+	collectionRevert.OperationID = "collection_revert"
+	collectionRevert.Tags = []string{"collection"}
+	collectionRevert.AddParameter(collectionNamePathParam)
+	collectionRevert.AddParameter(documentKeyPathParam)
+	collectionRevert.RequestBody = &openapi3.RequestBodyRef{
+		Value: collectionRevertRequest,
+	}
+	collectionRevert.Responses = make(openapi3.Responses)
+	collectionRevert.Responses["200"] = successResponse
+	collectionRevert.Responses["400"] = errorResponse
+
+	importFormatQueryParam := openapi3.NewQueryParameter("format").
+		WithDescription("Format of the imported data, one of csv or ndjson").
+		WithRequired(true).
+		WithSchema(openapi3.NewStringSchema())
+	importBatchSizeQueryParam := openapi3.NewQueryParameter("batch_size").
+		WithDescription("Number of documents created per transaction").
+		WithSchema(openapi3.NewIntegerSchema())
+	importMapQueryParam := openapi3.NewQueryParameter("map").
+		WithDescription("Column imported into a field, as column:field").
+		WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewStringSchema()))
+	importRelationQueryParam := openapi3.NewQueryParameter("relation").
+		WithDescription("Relation field imported by the natural key of the related collection, as field:key").
+		WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewStringSchema()))
+
+	collectionImportRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithContent(openapi3.NewContentWithSchema(openapi3.NewBytesSchema(), []string{"application/octet-stream"}))
+
+	collectionImportResponse := openapi3.NewResponse().
+		WithDescription("Number of imported documents and rejected rows").
+		WithJSONSchemaRef(importResultSchema)
+
+	collectionImport := openapi3.NewOperation()
+	collectionImport.Description = "Import documents from CSV or NDJSON data"
+	collectionImport.OperationID = "collection_import"
+	collectionImport.Tags = []string{"collection"}
+	collectionImport.AddParameter(collectionNamePathParam)
+	collectionImport.AddParameter(importFormatQueryParam)
+	collectionImport.AddParameter(importBatchSizeQueryParam)
+	collectionImport.AddParameter(importMapQueryParam)
+	collectionImport.AddParameter(importRelationQueryParam)
+	collectionImport.RequestBody = &openapi3.RequestBodyRef{
+		Value: collectionImportRequest,
+	}
+	collectionImport.AddResponse(200, collectionImportResponse)
+	collectionImport.Responses["400"] = errorResponse
+
 	collectionKeys := openapi3.NewOperation()
 	collectionKeys.AddParameter(collectionNamePathParam)
 	collectionKeys.Description = "Get all document keys"
@@ -520,14 +778,41 @@ func (h *collectionHandler) bindRoutes(router *Router) {
 	collectionKeys.Responses["200"] = successResponse
 	collectionKeys.Responses["400"] = errorResponse
 
+	changeCursorQueryParam := openapi3.NewQueryParameter("cursor").
+		WithDescription("Sequence number of the last change seen by the caller").
+		WithSchema(openapi3.NewInt64Schema())
+
+	changeLimitQueryParam := openapi3.NewQueryParameter("limit").
+		WithDescription("Maximum number of changes to return").
+		WithSchema(openapi3.NewInt64Schema())
+
+	collectionChangesResponse := openapi3.NewResponse().
+		WithDescription("Changes since the given cursor and the cursor of the next call").
+		WithContent(openapi3.NewContentWithJSONSchemaRef(changesResultSchema))
+
+	collectionChanges := openapi3.NewOperation()
+	collectionChanges.Description = "Get the changes made to a collection since the given cursor"
+	collectionChanges.OperationID = "collection_changes"
+	collectionChanges.Tags = []string{"collection"}
+	collectionChanges.AddParameter(collectionNamePathParam)
+	collectionChanges.AddParameter(changeCursorQueryParam)
+	collectionChanges.AddParameter(changeLimitQueryParam)
+	collectionChanges.AddResponse(200, collectionChangesResponse)
+	collectionChanges.Responses["400"] = errorResponse
+
 	router.AddRoute("/collections/{name}", http.MethodGet, collectionKeys, h.GetAllDocKeys)
 	router.AddRoute("/collections/{name}", http.MethodPost, collectionCreate, h.Create)
 	router.AddRoute("/collections/{name}", http.MethodPatch, collectionUpdateWith, h.UpdateWith)
 	router.AddRoute("/collections/{name}", http.MethodDelete, collectionDeleteWith, h.DeleteWith)
+	router.AddRoute("/collections/{name}/changes", http.MethodGet, collectionChanges, h.ChangesSince)
+	router.AddRoute("/collections/{name}/import", http.MethodPost, collectionImport, h.Import)
+	router.AddRoute("/collections/{name}/upsert", http.MethodPost, collectionUpsert, h.Upsert)
 	router.AddRoute("/collections/{name}/indexes", http.MethodPost, createIndex, h.CreateIndex)
 	router.AddRoute("/collections/{name}/indexes", http.MethodGet, getIndexes, h.GetIndexes)
 	router.AddRoute("/collections/{name}/indexes/{index}", http.MethodDelete, dropIndex, h.DropIndex)
 	router.AddRoute("/collections/{name}/{key}", http.MethodGet, collectionGet, h.Get)
 	router.AddRoute("/collections/{name}/{key}", http.MethodPatch, collectionUpdate, h.Update)
 	router.AddRoute("/collections/{name}/{key}", http.MethodDelete, collectionDelete, h.Delete)
+	router.AddRoute("/collections/{name}/{key}/diff", http.MethodGet, collectionDiff, h.Diff)
+	router.AddRoute("/collections/{name}/{key}/revert", http.MethodPost, collectionRevert, h.Revert)
 }
@@ -0,0 +1,68 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package http
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore/memory"
+	"github.com/sourcenetwork/defradb/db"
+)
+
+func TestCollectionImport_WithMappingAndBatchSize_ReturnsResult(t *testing.T) {
+	ctx := context.Background()
+	cdb, err := db.NewDB(ctx, memory.NewDatastore(ctx))
+	require.NoError(t, err)
+	_, err = cdb.AddSchema(ctx, `type User {
+		name: String
+		age: Int
+	}`)
+	require.NoError(t, err)
+	c := newTestClient(t, cdb)
+
+	col, err := c.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	data := "full_name,age,notes\nJohn,30,a\nBob,forty,b\nAlice,50,c\n"
+	result, err := col.Import(ctx, client.ImportConfig{
+		Format:    client.ImportFormatCSV,
+		Mapping:   map[string]string{"full_name": "name", "notes": ""},
+		BatchSize: 1,
+	}, strings.NewReader(data))
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(2), result.Imported)
+	require.Len(t, result.Rejected, 1)
+	require.Equal(t, uint64(2), result.Rejected[0].Row)
+	require.Equal(t, "Bob,forty,b", result.Rejected[0].Data)
+}
+
+func TestCollectionImport_WithInvalidFormat_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	cdb, err := db.NewDB(ctx, memory.NewDatastore(ctx))
+	require.NoError(t, err)
+	_, err = cdb.AddSchema(ctx, `type User {
+		name: String
+	}`)
+	require.NoError(t, err)
+	c := newTestClient(t, cdb)
+
+	col, err := c.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	_, err = col.Import(ctx, client.ImportConfig{Format: "xml"}, strings.NewReader(""))
+	require.ErrorContains(t, err, "invalid import format")
+}
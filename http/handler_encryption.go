@@ -0,0 +1,102 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+type encryptionHandler struct{}
+
+// EncryptionKey is the key used to encrypt the encrypted fields of a collection.
+type EncryptionKey struct {
+	Key []byte `json:"key"`
+}
+
+func (s *encryptionHandler) SetEncryptionKey(rw http.ResponseWriter, req *http.Request) {
+	db := req.Context().Value(dbContextKey).(client.DB)
+
+	var key EncryptionKey
+	if err := requestJSON(req, &key); err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	err := db.SetEncryptionKey(req.Context(), chi.URLParam(req, "name"), key.Key)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	rw.WriteHeader(http.StatusOK)
+}
+
+func (s *encryptionHandler) GetEncryptionKey(rw http.ResponseWriter, req *http.Request) {
+	db := req.Context().Value(dbContextKey).(client.DB)
+
+	key, err := db.GetEncryptionKey(req.Context(), chi.URLParam(req, "name"))
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, EncryptionKey{Key: key})
+}
+
+func (h *encryptionHandler) bindRoutes(router *Router) {
+	successResponse := &openapi3.ResponseRef{
+		Ref: "#/components/responses/success",
+	}
+	errorResponse := &openapi3.ResponseRef{
+		Ref: "#/components/responses/error",
+	}
+	encryptionKeySchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/encryption_key",
+	}
+
+	collectionNamePathParam := openapi3.NewPathParameter("name").
+		WithDescription("Collection name").
+		WithRequired(true).
+		WithSchema(openapi3.NewStringSchema())
+
+	encryptionKeyRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithContent(openapi3.NewContentWithJSONSchemaRef(encryptionKeySchema))
+
+	encryptionKeyResponse := openapi3.NewResponse().
+		WithDescription("Encryption key").
+		WithContent(openapi3.NewContentWithJSONSchemaRef(encryptionKeySchema))
+
+	setEncryptionKey := openapi3.NewOperation()
+	setEncryptionKey.Description = "Set the key used to encrypt the encrypted fields of a collection"
+	setEncryptionKey.OperationID = "encryption_key_set"
+	setEncryptionKey.Tags = []string{"encryption"}
+	setEncryptionKey.AddParameter(collectionNamePathParam)
+	setEncryptionKey.RequestBody = &openapi3.RequestBodyRef{
+		Value: encryptionKeyRequest,
+	}
+	setEncryptionKey.Responses = make(openapi3.Responses)
+	setEncryptionKey.Responses["200"] = successResponse
+	setEncryptionKey.Responses["400"] = errorResponse
+
+	getEncryptionKey := openapi3.NewOperation()
+	getEncryptionKey.Description = "Get the key used to encrypt the encrypted fields of a collection"
+	getEncryptionKey.OperationID = "encryption_key_get"
+	getEncryptionKey.Tags = []string{"encryption"}
+	getEncryptionKey.AddParameter(collectionNamePathParam)
+	getEncryptionKey.AddResponse(200, encryptionKeyResponse)
+	getEncryptionKey.Responses["400"] = errorResponse
+
+	router.AddRoute("/encryption-keys/{name}", http.MethodGet, getEncryptionKey, h.GetEncryptionKey)
+	router.AddRoute("/encryption-keys/{name}", http.MethodPost, setEncryptionKey, h.SetEncryptionKey)
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+type policyHandler struct{}
+
+func (s *policyHandler) SetPolicy(rw http.ResponseWriter, req *http.Request) {
+	db := req.Context().Value(dbContextKey).(client.DB)
+
+	var policy client.Policy
+	if err := requestJSON(req, &policy); err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	err := db.SetPolicy(req.Context(), policy)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	rw.WriteHeader(http.StatusOK)
+}
+
+func (s *policyHandler) GetPolicy(rw http.ResponseWriter, req *http.Request) {
+	db := req.Context().Value(dbContextKey).(client.DB)
+
+	policy, err := db.GetPolicy(req.Context())
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, policy)
+}
+
+func (h *policyHandler) bindRoutes(router *Router) {
+	successResponse := &openapi3.ResponseRef{
+		Ref: "#/components/responses/success",
+	}
+	errorResponse := &openapi3.ResponseRef{
+		Ref: "#/components/responses/error",
+	}
+	policySchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/policy",
+	}
+
+	policyRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithContent(openapi3.NewContentWithJSONSchemaRef(policySchema))
+
+	policyResponse := openapi3.NewResponse().
+		WithDescription("Policy").
+		WithContent(openapi3.NewContentWithJSONSchemaRef(policySchema))
+
+	setPolicy := openapi3.NewOperation()
+	setPolicy.Description = "Replace the authorization policy"
+	setPolicy.OperationID = "policy_set"
+	setPolicy.Tags = []string{"policy"}
+	setPolicy.RequestBody = &openapi3.RequestBodyRef{
+		Value: policyRequest,
+	}
+	setPolicy.Responses = make(openapi3.Responses)
+	setPolicy.Responses["200"] = successResponse
+	setPolicy.Responses["400"] = errorResponse
+
+	getPolicy := openapi3.NewOperation()
+	getPolicy.Description = "Get the authorization policy"
+	getPolicy.OperationID = "policy_get"
+	getPolicy.Tags = []string{"policy"}
+	getPolicy.AddResponse(200, policyResponse)
+	getPolicy.Responses["400"] = errorResponse
+
+	router.AddRoute("/policy", http.MethodGet, getPolicy, h.GetPolicy)
+	router.AddRoute("/policy", http.MethodPost, setPolicy, h.SetPolicy)
+}
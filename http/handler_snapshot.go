@@ -0,0 +1,74 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+type snapshotHandler struct{}
+
+// SnapshotRequest is the location on the node to write a snapshot to.
+type SnapshotRequest struct {
+	Filepath string `json:"filepath"`
+}
+
+func (s *snapshotHandler) CreateSnapshot(rw http.ResponseWriter, req *http.Request) {
+	db := req.Context().Value(dbContextKey).(client.DB)
+
+	var request SnapshotRequest
+	if err := requestJSON(req, &request); err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	info, err := db.CreateSnapshot(req.Context(), request.Filepath)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, info)
+}
+
+func (h *snapshotHandler) bindRoutes(router *Router) {
+	errorResponse := &openapi3.ResponseRef{
+		Ref: "#/components/responses/error",
+	}
+	snapshotRequestSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/snapshot_request",
+	}
+	snapshotInfoSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/snapshot_info",
+	}
+
+	snapshotRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithContent(openapi3.NewContentWithJSONSchemaRef(snapshotRequestSchema))
+
+	snapshotResponse := openapi3.NewResponse().
+		WithDescription("Snapshot info").
+		WithContent(openapi3.NewContentWithJSONSchemaRef(snapshotInfoSchema))
+
+	createSnapshot := openapi3.NewOperation()
+	createSnapshot.Description = "Write a snapshot of the entire node state to a file on the node"
+	createSnapshot.OperationID = "backup_snapshot"
+	createSnapshot.Tags = []string{"backup"}
+	createSnapshot.RequestBody = &openapi3.RequestBodyRef{
+		Value: snapshotRequest,
+	}
+	createSnapshot.AddResponse(200, snapshotResponse)
+	createSnapshot.Responses["400"] = errorResponse
+
+	router.AddRoute("/backup/snapshot", http.MethodPost, createSnapshot, h.CreateSnapshot)
+}
@@ -56,6 +56,57 @@ func (s *storeHandler) BasicExport(rw http.ResponseWriter, req *http.Request) {
 	rw.WriteHeader(http.StatusOK)
 }
 
+func (s *storeHandler) BasicImportStream(rw http.ResponseWriter, req *http.Request) {
+	store := req.Context().Value(storeContextKey).(client.Store)
+
+	config := client.BackupConfig{
+		Format:      req.URL.Query().Get("format"),
+		Compression: req.URL.Query().Get("compression"),
+	}
+	err := store.BasicImportFrom(req.Context(), &config, req.Body)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	rw.WriteHeader(http.StatusOK)
+}
+
+func (s *storeHandler) BasicExportStream(rw http.ResponseWriter, req *http.Request) {
+	store := req.Context().Value(storeContextKey).(client.Store)
+
+	var config client.BackupConfig
+	if err := requestJSON(req, &config); err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	w := &streamResponseWriter{rw: rw}
+	err := store.BasicExportTo(req.Context(), &config, w)
+	if err != nil && !w.started {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	if err != nil {
+		// The status has already been sent, abort the response
+		// so that the client does not receive a truncated export.
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// streamResponseWriter writes a successful octet stream response once the first bytes are written.
+type streamResponseWriter struct {
+	rw      http.ResponseWriter
+	started bool
+}
+
+func (w *streamResponseWriter) Write(data []byte) (int, error) {
+	if !w.started {
+		w.rw.Header().Set("Content-Type", "application/octet-stream")
+		w.rw.WriteHeader(http.StatusOK)
+		w.started = true
+	}
+	return w.rw.Write(data)
+}
+
 func (s *storeHandler) AddSchema(rw http.ResponseWriter, req *http.Request) {
 	store := req.Context().Value(storeContextKey).(client.Store)
 
@@ -416,6 +467,44 @@ func (h *storeHandler) bindRoutes(router *Router) {
 		Value: backupRequest,
 	}
 
+	backupStreamResponse := openapi3.NewResponse().
+		WithDescription("Backup content").
+		WithContent(openapi3.NewContentWithSchema(openapi3.NewBytesSchema(), []string{"application/octet-stream"}))
+
+	backupExportStream := openapi3.NewOperation()
+	backupExportStream.OperationID = "backup_export_stream"
+	backupExportStream.Description = "Export a database backup in the response body"
+	backupExportStream.Tags = []string{"backup"}
+	backupExportStream.AddResponse(200, backupStreamResponse)
+	backupExportStream.Responses["400"] = errorResponse
+	backupExportStream.RequestBody = &openapi3.RequestBodyRef{
+		Value: backupRequest,
+	}
+
+	backupFormatQueryParam := openapi3.NewQueryParameter("format").
+		WithDescription("Backup format, one of json, ndjson or cbor").
+		WithSchema(openapi3.NewStringSchema())
+	backupCompressionQueryParam := openapi3.NewQueryParameter("compression").
+		WithDescription("Backup compression, one of gzip or zstd. Detected from the content if not set").
+		WithSchema(openapi3.NewStringSchema())
+
+	backupImportStreamRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithContent(openapi3.NewContentWithSchema(openapi3.NewBytesSchema(), []string{"application/octet-stream"}))
+
+	backupImportStream := openapi3.NewOperation()
+	backupImportStream.OperationID = "backup_import_stream"
+	backupImportStream.Description = "Import a database backup from the request body"
+	backupImportStream.Tags = []string{"backup"}
+	backupImportStream.AddParameter(backupFormatQueryParam)
+	backupImportStream.AddParameter(backupCompressionQueryParam)
+	backupImportStream.Responses = make(openapi3.Responses)
+	backupImportStream.Responses["200"] = successResponse
+	backupImportStream.Responses["400"] = errorResponse
+	backupImportStream.RequestBody = &openapi3.RequestBodyRef{
+		Value: backupImportStreamRequest,
+	}
+
 	collectionNameQueryParam := openapi3.NewQueryParameter("name").
 		WithDescription("Collection name").
 		WithSchema(openapi3.NewStringSchema())
@@ -520,6 +609,8 @@ func (h *storeHandler) bindRoutes(router *Router) {
 
 	router.AddRoute("/backup/export", http.MethodPost, backupExport, h.BasicExport)
 	router.AddRoute("/backup/import", http.MethodPost, backupImport, h.BasicImport)
+	router.AddRoute("/backup/export/stream", http.MethodPost, backupExportStream, h.BasicExportStream)
+	router.AddRoute("/backup/import/stream", http.MethodPost, backupImportStream, h.BasicImportStream)
 	router.AddRoute("/collections", http.MethodGet, collectionDescribe, h.GetCollection)
 	router.AddRoute("/graphql", http.MethodGet, graphQLGet, h.ExecRequest)
 	router.AddRoute("/graphql", http.MethodPost, graphQLPost, h.ExecRequest)
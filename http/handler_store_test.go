@@ -0,0 +1,75 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore/memory"
+	"github.com/sourcenetwork/defradb/db"
+)
+
+func newTestClient(t *testing.T, cdb client.DB) *Client {
+	handler, err := NewHandler(cdb, ServerOptions{})
+	require.NoError(t, err)
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL)
+	require.NoError(t, err)
+	return c
+}
+
+func TestBasicExportStream_ThenImportStream_RoundTrips(t *testing.T) {
+	ctx := context.Background()
+	source := newTestClient(t, setupDatabase(t))
+
+	config := &client.BackupConfig{
+		Format:      client.BackupFormatNDJSON,
+		Compression: client.BackupCompressionZstd,
+	}
+	var buf bytes.Buffer
+	err := source.BasicExportTo(ctx, config, &buf)
+	require.NoError(t, err)
+
+	targetDB, err := db.NewDB(ctx, memory.NewDatastore(ctx))
+	require.NoError(t, err)
+	_, err = targetDB.AddSchema(ctx, `type User {
+		name: String
+	}`)
+	require.NoError(t, err)
+	target := newTestClient(t, targetDB)
+
+	err = target.BasicImportFrom(ctx, &client.BackupConfig{Format: client.BackupFormatNDJSON}, &buf)
+	require.NoError(t, err)
+
+	var exported bytes.Buffer
+	err = target.BasicExportTo(ctx, &client.BackupConfig{}, &exported)
+	require.NoError(t, err)
+	require.Contains(t, exported.String(), `"name":"bob"`)
+}
+
+func TestBasicExportStream_WithInvalidFormat_ReturnError(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t, setupDatabase(t))
+
+	var buf bytes.Buffer
+	err := c.BasicExportTo(ctx, &client.BackupConfig{Format: "xml"}, &buf)
+	require.ErrorContains(t, err, "invalid backup format")
+	require.Zero(t, buf.Len())
+}
@@ -0,0 +1,148 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+type webhookHandler struct{}
+
+func (s *webhookHandler) AddWebhook(rw http.ResponseWriter, req *http.Request) {
+	db := req.Context().Value(dbContextKey).(client.DB)
+
+	var hook client.Webhook
+	if err := requestJSON(req, &hook); err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	hook, err := db.AddWebhook(req.Context(), hook)
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, hook)
+}
+
+func (s *webhookHandler) DeleteWebhook(rw http.ResponseWriter, req *http.Request) {
+	db := req.Context().Value(dbContextKey).(client.DB)
+
+	err := db.DeleteWebhook(req.Context(), chi.URLParam(req, "id"))
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	rw.WriteHeader(http.StatusOK)
+}
+
+func (s *webhookHandler) GetAllWebhooks(rw http.ResponseWriter, req *http.Request) {
+	db := req.Context().Value(dbContextKey).(client.DB)
+
+	hooks, err := db.GetAllWebhooks(req.Context())
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, hooks)
+}
+
+func (s *webhookHandler) GetAllWebhookDeadLetters(rw http.ResponseWriter, req *http.Request) {
+	db := req.Context().Value(dbContextKey).(client.DB)
+
+	letters, err := db.GetAllWebhookDeadLetters(req.Context())
+	if err != nil {
+		responseJSON(rw, http.StatusBadRequest, errorResponse{err})
+		return
+	}
+	responseJSON(rw, http.StatusOK, letters)
+}
+
+func (h *webhookHandler) bindRoutes(router *Router) {
+	successResponse := &openapi3.ResponseRef{
+		Ref: "#/components/responses/success",
+	}
+	errorResponse := &openapi3.ResponseRef{
+		Ref: "#/components/responses/error",
+	}
+	webhookSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/webhook",
+	}
+	webhookDeadLetterSchema := &openapi3.SchemaRef{
+		Ref: "#/components/schemas/webhook_dead_letter",
+	}
+
+	webhookRequest := openapi3.NewRequestBody().
+		WithRequired(true).
+		WithContent(openapi3.NewContentWithJSONSchemaRef(webhookSchema))
+
+	webhookResponse := openapi3.NewResponse().
+		WithDescription("Webhook").
+		WithContent(openapi3.NewContentWithJSONSchemaRef(webhookSchema))
+
+	addWebhook := openapi3.NewOperation()
+	addWebhook.Description = "Add a webhook"
+	addWebhook.OperationID = "webhook_add"
+	addWebhook.Tags = []string{"webhook"}
+	addWebhook.RequestBody = &openapi3.RequestBodyRef{
+		Value: webhookRequest,
+	}
+	addWebhook.AddResponse(200, webhookResponse)
+	addWebhook.Responses["400"] = errorResponse
+
+	webhookIDPathParam := openapi3.NewPathParameter("id").
+		WithRequired(true).
+		WithSchema(openapi3.NewStringSchema())
+
+	deleteWebhook := openapi3.NewOperation()
+	deleteWebhook.Description = "Delete a webhook"
+	deleteWebhook.OperationID = "webhook_delete"
+	deleteWebhook.Tags = []string{"webhook"}
+	deleteWebhook.AddParameter(webhookIDPathParam)
+	deleteWebhook.Responses = make(openapi3.Responses)
+	deleteWebhook.Responses["200"] = successResponse
+	deleteWebhook.Responses["400"] = errorResponse
+
+	getWebhooksSchema := openapi3.NewArraySchema()
+	getWebhooksSchema.Items = webhookSchema
+	getWebhooksResponse := openapi3.NewResponse().
+		WithDescription("Webhooks").
+		WithContent(openapi3.NewContentWithJSONSchema(getWebhooksSchema))
+
+	getWebhooks := openapi3.NewOperation()
+	getWebhooks.Description = "List webhooks"
+	getWebhooks.OperationID = "webhook_list"
+	getWebhooks.Tags = []string{"webhook"}
+	getWebhooks.AddResponse(200, getWebhooksResponse)
+	getWebhooks.Responses["400"] = errorResponse
+
+	getDeadLettersSchema := openapi3.NewArraySchema()
+	getDeadLettersSchema.Items = webhookDeadLetterSchema
+	getDeadLettersResponse := openapi3.NewResponse().
+		WithDescription("Webhook dead letters").
+		WithContent(openapi3.NewContentWithJSONSchema(getDeadLettersSchema))
+
+	getDeadLetters := openapi3.NewOperation()
+	getDeadLetters.Description = "List payloads that could not be delivered to their webhook"
+	getDeadLetters.OperationID = "webhook_dead_letter_list"
+	getDeadLetters.Tags = []string{"webhook"}
+	getDeadLetters.AddResponse(200, getDeadLettersResponse)
+	getDeadLetters.Responses["400"] = errorResponse
+
+	router.AddRoute("/webhooks", http.MethodGet, getWebhooks, h.GetAllWebhooks)
+	router.AddRoute("/webhooks", http.MethodPost, addWebhook, h.AddWebhook)
+	router.AddRoute("/webhooks/deadletters", http.MethodGet, getDeadLetters, h.GetAllWebhookDeadLetters)
+	router.AddRoute("/webhooks/{id}", http.MethodDelete, deleteWebhook, h.DeleteWebhook)
+}
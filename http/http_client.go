@@ -23,9 +23,28 @@ type httpClient struct {
 	client  *http.Client
 	baseURL *url.URL
 	txValue string
+	apiKey  string
+	token   string
 }
 
-func newHttpClient(rawURL string) (*httpClient, error) {
+// ClientOption is an option for configuring the HTTP client.
+type ClientOption func(*httpClient)
+
+// WithAPIKey returns an option to authenticate all requests with the given API key.
+func WithAPIKey(key string) ClientOption {
+	return func(c *httpClient) {
+		c.apiKey = key
+	}
+}
+
+// WithBearerToken returns an option to authenticate all requests with the given JWT.
+func WithBearerToken(token string) ClientOption {
+	return func(c *httpClient) {
+		c.token = token
+	}
+}
+
+func newHttpClient(rawURL string, opts ...ClientOption) (*httpClient, error) {
 	if !strings.HasPrefix(rawURL, "http") {
 		rawURL = "http://" + rawURL
 	}
@@ -37,6 +56,9 @@ func newHttpClient(rawURL string) (*httpClient, error) {
 		client:  http.DefaultClient,
 		baseURL: baseURL.JoinPath("/api/v0"),
 	}
+	for _, opt := range opts {
+		opt(&client)
+	}
 	return &client, nil
 }
 
@@ -45,6 +67,8 @@ func (c *httpClient) withTxn(value uint64) *httpClient {
 		client:  c.client,
 		baseURL: c.baseURL,
 		txValue: fmt.Sprintf("%d", value),
+		apiKey:  c.apiKey,
+		token:   c.token,
 	}
 }
 
@@ -55,6 +79,12 @@ func (c *httpClient) setDefaultHeaders(req *http.Request) {
 	if c.txValue != "" {
 		req.Header.Set(TX_HEADER_NAME, c.txValue)
 	}
+	if c.apiKey != "" {
+		req.Header.Set(API_KEY_HEADER_NAME, c.apiKey)
+	}
+	if c.token != "" {
+		req.Header.Set(AUTHORIZATION_HEADER_NAME, bearerPrefix+c.token)
+	}
 }
 
 func (c *httpClient) request(req *http.Request) ([]byte, error) {
@@ -85,6 +115,35 @@ func (c *httpClient) request(req *http.Request) ([]byte, error) {
 	return nil, errRes.Error
 }
 
+// requestStream sends the given request and returns the body of a successful response.
+//
+// The caller is responsible for closing the returned body.
+func (c *httpClient) requestStream(req *http.Request) (io.ReadCloser, error) {
+	c.setDefaultHeaders(req)
+	req.Header.Set("Accept", "application/octet-stream")
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	// request was successful
+	if res.StatusCode == http.StatusOK {
+		return res.Body, nil
+	}
+	defer res.Body.Close() //nolint:errcheck
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	// attempt to parse json error
+	var errRes errorResponse
+	if err := json.Unmarshal(data, &errRes); err != nil {
+		return nil, fmt.Errorf("%s", data)
+	}
+	return nil, errRes.Error
+}
+
 func (c *httpClient) requestJson(req *http.Request, out any) error {
 	data, err := c.request(req)
 	if err != nil {
@@ -53,6 +53,10 @@ var (
 	// If a transaction exists, all operations will be executed
 	// in the current transaction context.
 	colContextKey = contextKey("col")
+	// identityContextKey is the context key for the identity of the caller
+	//
+	// This will only be set if authentication is enabled.
+	identityContextKey = contextKey("identity")
 )
 
 // CorsMiddleware handles cross origin request
@@ -65,7 +69,7 @@ func CorsMiddleware(opts ServerOptions) func(http.Handler) http.Handler {
 			return slices.Contains(opts.AllowedOrigins, strings.ToLower(origin))
 		},
 		AllowedMethods: []string{"GET", "HEAD", "POST", "PATCH", "DELETE"},
-		AllowedHeaders: []string{"Content-Type"},
+		AllowedHeaders: []string{"Content-Type", AUTHORIZATION_HEADER_NAME, API_KEY_HEADER_NAME},
 		MaxAge:         300,
 	})
 }
@@ -86,6 +90,28 @@ func ApiMiddleware(db client.DB, txs *sync.Map, opts ServerOptions) func(http.Ha
 	}
 }
 
+// AuthMiddleware rejects requests that can not be authenticated by any of the configured
+// authenticators and sets the identity context for all other requests.
+//
+// All requests are accepted if no authenticators are configured.
+func AuthMiddleware(opts ServerOptions) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			if len(opts.Authenticators) == 0 {
+				next.ServeHTTP(rw, req)
+				return
+			}
+			identity, err := authenticate(req, opts.Authenticators)
+			if err != nil {
+				responseJSON(rw, http.StatusUnauthorized, errorResponse{err})
+				return
+			}
+			ctx := context.WithValue(req.Context(), identityContextKey, identity)
+			next.ServeHTTP(rw, req.WithContext(ctx))
+		})
+	}
+}
+
 // TransactionMiddleware sets the transaction context for the current request.
 func TransactionMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
@@ -117,14 +143,33 @@ func StoreMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		db := req.Context().Value(dbContextKey).(client.DB)
 
+		ctx := req.Context()
+		if identity, ok := ctx.Value(identityContextKey).(string); ok {
+			ctx = client.WithIdentity(ctx, identity)
+			policy, err := db.GetPolicy(ctx)
+			if err != nil {
+				responseJSON(rw, http.StatusInternalServerError, errorResponse{err})
+				return
+			}
+			if !policy.IsEmpty() {
+				ctx = client.WithAuthorization(ctx, identity, policy)
+			}
+		}
+		if permission, ok := storePermission(req); ok {
+			if err := client.CheckPermission(ctx, permission, ""); err != nil {
+				responseJSON(rw, http.StatusForbidden, errorResponse{err})
+				return
+			}
+		}
+
 		var store client.Store
-		if tx, ok := req.Context().Value(txContextKey).(datastore.Txn); ok {
+		if tx, ok := ctx.Value(txContextKey).(datastore.Txn); ok {
 			store = db.WithTxn(tx)
 		} else {
 			store = db
 		}
 
-		ctx := context.WithValue(req.Context(), storeContextKey, store)
+		ctx = context.WithValue(ctx, storeContextKey, store)
 		next.ServeHTTP(rw, req.WithContext(ctx))
 	})
 }
@@ -134,6 +179,15 @@ func LensMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		store := req.Context().Value(storeContextKey).(client.Store)
 
+		permission := client.SchemaAdminPermission
+		if req.Method == http.MethodGet {
+			permission = client.ReadPermission
+		}
+		if err := client.CheckPermission(req.Context(), permission, ""); err != nil {
+			responseJSON(rw, http.StatusForbidden, errorResponse{err})
+			return
+		}
+
 		var lens client.LensRegistry
 		if tx, ok := req.Context().Value(txContextKey).(datastore.Txn); ok {
 			lens = store.LensRegistry().WithTxn(tx)
@@ -151,7 +205,13 @@ func CollectionMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		store := req.Context().Value(storeContextKey).(client.Store)
 
-		col, err := store.GetCollectionByName(req.Context(), chi.URLParam(req, "name"))
+		name := chi.URLParam(req, "name")
+		if err := client.CheckPermission(req.Context(), collectionPermission(req), name); err != nil {
+			responseJSON(rw, http.StatusForbidden, errorResponse{err})
+			return
+		}
+
+		col, err := store.GetCollectionByName(req.Context(), name)
 		if err != nil {
 			rw.WriteHeader(http.StatusNotFound)
 			return
@@ -165,3 +225,60 @@ func CollectionMiddleware(next http.Handler) http.Handler {
 		next.ServeHTTP(rw, req.WithContext(ctx))
 	})
 }
+
+// routeSegments returns the segments of the request path relative to the API router.
+func routeSegments(req *http.Request) []string {
+	path := req.URL.Path
+	if rctx := chi.RouteContext(req.Context()); rctx != nil && rctx.RoutePath != "" {
+		path = rctx.RoutePath
+	}
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+// storePermission returns the permission required by the given request that is not
+// scoped to a single collection.
+//
+// False is returned for requests whose permission is checked by the [LensMiddleware] or
+// the [CollectionMiddleware].
+func storePermission(req *http.Request) (client.Permission, bool) {
+	segments := routeSegments(req)
+	switch segments[0] {
+	case "graphql", "ccip", "tx":
+		// Mutations are checked per collection when planning the request.
+		return client.ReadPermission, true
+	case "schema":
+		if req.Method == http.MethodGet {
+			return client.ReadPermission, true
+		}
+		return client.SchemaAdminPermission, true
+	case "collections":
+		if len(segments) == 1 {
+			return client.ReadPermission, true
+		}
+		return "", false
+	case "lens":
+		return "", false
+	case "p2p":
+		return client.P2PAdminPermission, true
+	case "backup":
+		return client.BackupPermission, true
+	default:
+		return client.AdminPermission, true
+	}
+}
+
+// collectionPermission returns the permission required on the collection targeted by
+// the given request.
+func collectionPermission(req *http.Request) client.Permission {
+	segments := routeSegments(req)
+	if len(segments) > 2 && segments[2] == "indexes" {
+		if req.Method == http.MethodGet {
+			return client.ReadPermission
+		}
+		return client.SchemaAdminPermission
+	}
+	if req.Method == http.MethodGet {
+		return client.ReadPermission
+	}
+	return client.WritePermission
+}
@@ -0,0 +1,153 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func setupAuthorizedHandler(t *testing.T, setPolicy bool) *Handler {
+	cdb := setupDatabase(t)
+
+	if setPolicy {
+		err := cdb.SetPolicy(context.Background(), client.Policy{
+			Roles: []client.Role{
+				{
+					Name:        "admin",
+					Permissions: []client.Permission{client.AdminPermission, client.SchemaAdminPermission},
+				},
+				{Name: "reader", Permissions: []client.Permission{client.ReadPermission}},
+				{
+					Name:        "book-writer",
+					Permissions: []client.Permission{client.WritePermission},
+					Collections: []string{"Book"},
+				},
+			},
+			Members: map[string][]string{
+				"alice": {"admin"},
+				"bob":   {"reader"},
+				"carol": {"book-writer"},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	handler, err := NewHandler(cdb, ServerOptions{
+		Authenticators: []Authenticator{NewAPIKeyAuthenticator(map[string]string{
+			"alice-key": "alice",
+			"bob-key":   "bob",
+			"carol-key": "carol",
+		})},
+	})
+	require.NoError(t, err)
+	return handler
+}
+
+func serveWithAPIKey(handler http.Handler, key string, method string, path string, body string) *http.Response {
+	req := httptest.NewRequest(method, "http://localhost:9181/api/v0"+path, strings.NewReader(body))
+	req.Header.Set(API_KEY_HEADER_NAME, key)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec.Result()
+}
+
+func TestStoreMiddleware_WithReadRole_AllowsOnlyReads(t *testing.T) {
+	handler := setupAuthorizedHandler(t, true)
+
+	res := serveWithAPIKey(handler, "bob-key", http.MethodGet, "/collections", "")
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	res = serveWithAPIKey(handler, "bob-key", http.MethodPost, "/schema", "type Book { title: String }")
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+
+	res = serveWithAPIKey(handler, "bob-key", http.MethodGet, "/webhooks", "")
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+}
+
+func TestStoreMiddleware_WithoutPolicy_AllowsAllOperations(t *testing.T) {
+	handler := setupAuthorizedHandler(t, false)
+
+	res := serveWithAPIKey(handler, "bob-key", http.MethodGet, "/webhooks", "")
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func TestCollectionMiddleware_WithScopedRole_AllowsOnlyScopedCollection(t *testing.T) {
+	handler := setupAuthorizedHandler(t, true)
+
+	res := serveWithAPIKey(handler, "carol-key", http.MethodPost, "/collections/User", `{"name": "john"}`)
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+
+	res = serveWithAPIKey(handler, "alice-key", http.MethodPost, "/schema", "type Book { title: String }")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	res = serveWithAPIKey(handler, "carol-key", http.MethodPost, "/collections/Book", `{"title": "Dune"}`)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func TestLensMiddleware_WithReadRole_DeniesMigrationChanges(t *testing.T) {
+	handler := setupAuthorizedHandler(t, true)
+
+	res := serveWithAPIKey(handler, "bob-key", http.MethodGet, "/lens", "")
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	res = serveWithAPIKey(handler, "bob-key", http.MethodPost, "/lens/reload", "")
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+}
+
+func TestGraphQL_WithReadRole_DeniesMutations(t *testing.T) {
+	handler := setupAuthorizedHandler(t, true)
+
+	res := serveWithAPIKey(handler, "bob-key", http.MethodPost, "/graphql", `{"query": "query { User { name } }"}`)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	data, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data": [{"name": "bob"}], "errors": null}`, string(data))
+
+	res = serveWithAPIKey(
+		handler,
+		"bob-key",
+		http.MethodPost,
+		"/graphql",
+		`{"query": "mutation { create_User(data: \"{\\\"name\\\": \\\"john\\\"}\") { name } }"}`,
+	)
+	data, err = io.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "permission denied")
+}
+
+func TestStoreMiddleware_WithIdentity_SetsDocumentOwner(t *testing.T) {
+	handler := setupAuthorizedHandler(t, false)
+
+	res := serveWithAPIKey(handler, "carol-key", http.MethodPost, "/collections/User", `{"name": "john"}`)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	res = serveWithAPIKey(
+		handler,
+		"bob-key",
+		http.MethodPost,
+		"/graphql",
+		`{"query": "query { User(filter: {name: {_eq: \"john\"}}) { name _owner } }"}`,
+	)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	data, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data": [{"name": "john", "_owner": "carol"}], "errors": null}`, string(data))
+}
@@ -24,6 +24,8 @@ var openApiSchemas = map[string]any{
 	"create_tx":            &CreateTxResponse{},
 	"collection_update":    &CollectionUpdateRequest{},
 	"collection_delete":    &CollectionDeleteRequest{},
+	"collection_revert":    &CollectionRevertRequest{},
+	"collection_upsert":    &CollectionUpsertRequest{},
 	"peer_info":            &peer.AddrInfo{},
 	"graphql_request":      &GraphQLRequest{},
 	"graphql_response":     &GraphQLResponse{},
@@ -33,11 +35,22 @@ var openApiSchemas = map[string]any{
 	"index":                &client.IndexDescription{},
 	"delete_result":        &client.DeleteResult{},
 	"update_result":        &client.UpdateResult{},
+	"upsert_result":        &client.UpsertResult{},
 	"lens_config":          &client.LensConfig{},
 	"replicator":           &client.Replicator{},
 	"ccip_request":         &CCIPRequest{},
 	"ccip_response":        &CCIPResponse{},
 	"patch_schema_request": &patchSchemaRequest{},
+	"change":               &client.Change{},
+	"changes_result":       &client.ChangesResult{},
+	"webhook":              &client.Webhook{},
+	"webhook_dead_letter":  &client.WebhookDeadLetter{},
+	"policy":               &client.Policy{},
+	"encryption_key":       &EncryptionKey{},
+	"field_diff":           &client.FieldDiff{},
+	"import_result":        &client.ImportResult{},
+	"snapshot_request":     &SnapshotRequest{},
+	"snapshot_info":        &client.SnapshotInfo{},
 }
 
 func NewOpenAPISpec() (*openapi3.T, error) {
@@ -134,6 +147,18 @@ func NewOpenAPISpec() (*openapi3.T, error) {
 				Name:        "backup",
 				Description: "Database backup operations",
 			},
+			&openapi3.Tag{
+				Name:        "webhook",
+				Description: "Outbound webhook operations",
+			},
+			&openapi3.Tag{
+				Name:        "policy",
+				Description: "Authorization policy operations",
+			},
+			&openapi3.Tag{
+				Name:        "encryption",
+				Description: "Field encryption key operations",
+			},
 			&openapi3.Tag{
 				Name:        "graphql",
 				Description: "GraphQL query endpoints",
@@ -67,6 +67,8 @@ type ServerOptions struct {
 	RootDir string
 	// Domain is the domain for the API (optional).
 	Domain immutable.Option[string]
+	// Authenticators are used to authenticate requests when not empty.
+	Authenticators []Authenticator
 }
 
 type TLSOptions struct {
@@ -133,6 +135,15 @@ func WithAllowedOrigins(origins ...string) func(*Server) {
 	}
 }
 
+// WithAuthenticator returns an option to add an authenticator for API requests.
+//
+// Once any authenticator is added, requests that can not be authenticated are rejected.
+func WithAuthenticator(auth Authenticator) func(*Server) {
+	return func(s *Server) {
+		s.options.Authenticators = append(s.options.Authenticators, auth)
+	}
+}
+
 // WithAddress returns an option to set the address for the server.
 func WithAddress(addr string) func(*Server) {
 	return func(s *Server) {
@@ -28,6 +28,14 @@ func requestJSON(req *http.Request, out any) error {
 	return json.Unmarshal(data, out)
 }
 
+// requestJSONWithNumbers is the same as requestJSON but keeps the numbers of the request as
+// json.Number, so that no precision is lost to float parsing.
+func requestJSONWithNumbers(req *http.Request, out any) error {
+	dec := json.NewDecoder(req.Body)
+	dec.UseNumber()
+	return dec.Decode(out)
+}
+
 func responseJSON(rw http.ResponseWriter, status int, out any) {
 	rw.Header().Add("Content-Type", "application/json")
 	rw.WriteHeader(status)
@@ -57,6 +57,12 @@ func (mc *MerkleClock) putBlock(
 	heads []cid.Cid,
 	delta core.Delta,
 ) (ipld.Node, error) {
+	if key, ok := SigningKeyFromContext(ctx); ok {
+		if err := signDelta(key, delta, heads); err != nil {
+			return nil, NewErrCreatingBlock(err)
+		}
+	}
+
 	node, err := makeNode(delta, heads)
 	if err != nil {
 		return nil, NewErrCreatingBlock(err)
@@ -26,6 +26,8 @@ const (
 	errReplacingHead          = "error replacing head"
 	errCouldNotFindBlock      = "error checking for known block "
 	errFailedToGetNextQResult = "failed to get next query result"
+	errUnsignedBlock          = "block is not signed"
+	errInvalidBlockSignature  = "invalid block signature"
 )
 
 var (
@@ -39,6 +41,8 @@ var (
 	ErrCouldNotFindBlock      = errors.New(errCouldNotFindBlock)
 	ErrFailedToGetNextQResult = errors.New(errFailedToGetNextQResult)
 	ErrDecodingHeight         = errors.New("error decoding height")
+	ErrUnsignedBlock          = errors.New(errUnsignedBlock)
+	ErrInvalidBlockSignature  = errors.New(errInvalidBlockSignature)
 )
 
 func NewErrCreatingBlock(inner error) error {
@@ -81,3 +85,11 @@ func NewErrCouldNotFindBlock(cid cid.Cid, inner error) error {
 func NewErrFailedToGetNextQResult(inner error) error {
 	return errors.Wrap(errFailedToGetNextQResult, inner)
 }
+
+func NewErrUnsignedBlock(cid cid.Cid) error {
+	return errors.New(errUnsignedBlock, errors.NewKV("Cid", cid))
+}
+
+func NewErrInvalidBlockSignature(cid cid.Cid, inner error) error {
+	return errors.Wrap(errInvalidBlockSignature, inner, errors.NewKV("Cid", cid))
+}
@@ -0,0 +1,125 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package clock
+
+import (
+	"context"
+
+	dag "github.com/ipfs/boxo/ipld/merkledag"
+	cid "github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	mh "github.com/multiformats/go-multihash"
+
+	"github.com/sourcenetwork/defradb/core"
+)
+
+type signingKeyContextKey struct{}
+
+// WithSigningKey returns a copy of the given context with which the blocks created by
+// a MerkleClock are signed using the given key.
+//
+// This is typically the libp2p key of the node, but the key of a user may also be used.
+func WithSigningKey(ctx context.Context, key crypto.PrivKey) context.Context {
+	return context.WithValue(ctx, signingKeyContextKey{}, key)
+}
+
+// SigningKeyFromContext returns the key set on the given context by [WithSigningKey], if any.
+func SigningKeyFromContext(ctx context.Context) (crypto.PrivKey, bool) {
+	key, ok := ctx.Value(signingKeyContextKey{}).(crypto.PrivKey)
+	return key, ok
+}
+
+// signDelta signs the block made of the given delta and links with the given key
+// and sets the signature on the delta.
+//
+// The signature is made over the CID of the block without the signature, the delta
+// must be encoded again after it has been signed.
+func signDelta(key crypto.PrivKey, delta core.Delta, heads []cid.Cid) error {
+	signer, err := crypto.MarshalPublicKey(key.GetPublic())
+	if err != nil {
+		return err
+	}
+
+	delta.SetSignature(nil, nil)
+	unsigned, err := makeNode(delta, heads)
+	if err != nil {
+		return err
+	}
+
+	signature, err := key.Sign(unsigned.Cid().Bytes())
+	if err != nil {
+		return err
+	}
+
+	delta.SetSignature(signer, signature)
+	return nil
+}
+
+// VerifyNode checks that the given block is signed and that its signature matches its content.
+//
+// The given delta must have been decoded from the given block. The ID of the signer is returned
+// if the signature is valid.
+func VerifyNode(nd ipld.Node, delta core.Delta) (peer.ID, error) {
+	signer, signature := delta.GetSignature()
+	if len(signer) == 0 || len(signature) == 0 {
+		return "", NewErrUnsignedBlock(nd.Cid())
+	}
+
+	pubKey, err := crypto.UnmarshalPublicKey(signer)
+	if err != nil {
+		return "", NewErrInvalidBlockSignature(nd.Cid(), err)
+	}
+
+	// Rebuild the block as it was before being signed, without altering the given delta.
+	delta.SetSignature(nil, nil)
+	unsigned, err := unsignedNode(nd, delta)
+	delta.SetSignature(signer, signature)
+	if err != nil {
+		return "", NewErrInvalidBlockSignature(nd.Cid(), err)
+	}
+
+	ok, err := pubKey.Verify(unsigned.Cid().Bytes(), signature)
+	if err != nil {
+		return "", NewErrInvalidBlockSignature(nd.Cid(), err)
+	}
+	if !ok {
+		return "", NewErrInvalidBlockSignature(nd.Cid(), nil)
+	}
+
+	return peer.IDFromPublicKey(pubKey)
+}
+
+// unsignedNode returns a block with the links of the given block and the given delta as data.
+func unsignedNode(nd ipld.Node, delta core.Delta) (ipld.Node, error) {
+	data, err := delta.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	unsigned := dag.NodeWithData(data)
+	err = unsigned.SetCidBuilder(cid.V1Builder{
+		Codec:    cid.DagProtobuf,
+		MhType:   mh.SHA2_256,
+		MhLength: -1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, link := range nd.Links() {
+		if err = unsigned.AddRawLink(link.Name, &ipld.Link{Cid: link.Cid}); err != nil {
+			return nil, err
+		}
+	}
+	return unsigned, nil
+}
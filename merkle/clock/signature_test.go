@@ -0,0 +1,77 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package clock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/core/crdt"
+)
+
+func TestMerkleClockPutBlock_WithSigningKey_SignsBlock(t *testing.T) {
+	key, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	require.NoError(t, err)
+
+	ctx := WithSigningKey(context.Background(), key)
+	clk := newTestMerkleClock()
+	delta := &crdt.LWWRegDelta{
+		Data: []byte("test"),
+	}
+	node, err := clk.putBlock(ctx, nil, delta)
+	require.NoError(t, err)
+
+	decoded, err := clk.crdt.DeltaDecode(node)
+	require.NoError(t, err)
+
+	signer, err := VerifyNode(node, decoded)
+	require.NoError(t, err)
+
+	expected, err := peer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+	require.Equal(t, expected, signer)
+}
+
+func TestVerifyNode_WithUnsignedBlock_ReturnsError(t *testing.T) {
+	clk := newTestMerkleClock()
+	delta := &crdt.LWWRegDelta{
+		Data: []byte("test"),
+	}
+	node, err := clk.putBlock(context.Background(), nil, delta)
+	require.NoError(t, err)
+
+	_, err = VerifyNode(node, delta)
+	require.ErrorIs(t, err, ErrUnsignedBlock)
+}
+
+func TestVerifyNode_WithAlteredDelta_ReturnsError(t *testing.T) {
+	key, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	require.NoError(t, err)
+
+	ctx := WithSigningKey(context.Background(), key)
+	clk := newTestMerkleClock()
+	delta := &crdt.LWWRegDelta{
+		Data: []byte("test"),
+	}
+	_, err = clk.putBlock(ctx, nil, delta)
+	require.NoError(t, err)
+
+	delta.Data = []byte("forged")
+	forged, err := makeNode(delta, nil)
+	require.NoError(t, err)
+
+	_, err = VerifyNode(forged, delta)
+	require.ErrorIs(t, err, ErrInvalidBlockSignature)
+}
@@ -62,6 +62,27 @@ func (m *MerkleCompositeDAG) Delete(
 	log.Debug(ctx, "Applying delta-mutator 'Delete' on CompositeDAG")
 	delta := m.reg.Set([]byte{}, links)
 	delta.Status = client.Deleted
+	delta.Identity, _ = client.IdentityFromContext(ctx)
+	nd, err := m.clock.AddDAGNode(ctx, delta)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return nd, delta.GetPriority(), nil
+}
+
+// Restore sets the values of CompositeDAG for the restoration of a deleted document.
+func (m *MerkleCompositeDAG) Restore(
+	ctx context.Context,
+	patch []byte,
+	links []core.DAGLink,
+) (ipld.Node, uint64, error) {
+	// Set() call on underlying CompositeDAG CRDT
+	// persist/publish delta
+	log.Debug(ctx, "Applying delta-mutator 'Restore' on CompositeDAG")
+	delta := m.reg.Set(patch, links)
+	delta.Restored = true
+	delta.Identity, _ = client.IdentityFromContext(ctx)
 	nd, err := m.clock.AddDAGNode(ctx, delta)
 	if err != nil {
 		return nil, 0, err
@@ -80,6 +101,7 @@ func (m *MerkleCompositeDAG) Set(
 	// persist/publish delta
 	log.Debug(ctx, "Applying delta-mutator 'Set' on CompositeDAG")
 	delta := m.reg.Set(patch, links)
+	delta.Identity, _ = client.IdentityFromContext(ctx)
 	nd, err := m.clock.AddDAGNode(ctx, delta)
 	if err != nil {
 		return nil, 0, err
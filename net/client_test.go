@@ -100,19 +100,18 @@ func TestPushlogW_WithValidPeerID_NoError(t *testing.T) {
 	err = col.Save(ctx, doc)
 	require.NoError(t, err)
 
+	block := getHeadBlock(ctx, t, n1.db, doc)
+
 	col, err = n2.db.GetCollectionByName(ctx, "User")
 	require.NoError(t, err)
 	err = col.Save(ctx, doc)
 	require.NoError(t, err)
 
-	cid, err := createCID(doc)
-	require.NoError(t, err)
-
 	err = n1.server.pushLog(ctx, events.Update{
 		DocKey:     doc.Key().String(),
-		Cid:        cid,
+		Cid:        block.Cid(),
 		SchemaRoot: col.SchemaRoot(),
-		Block:      &EmptyNode{},
+		Block:      block,
 		Priority:   1,
 	}, n2.PeerInfo().ID)
 	require.NoError(t, err)
@@ -13,10 +13,12 @@
 package net
 
 import (
+	"strings"
 	"time"
 
 	cconnmgr "github.com/libp2p/go-libp2p/core/connmgr"
 	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/p2p/net/connmgr"
 	ma "github.com/multiformats/go-multiaddr"
 	"google.golang.org/grpc"
@@ -33,6 +35,13 @@ type Options struct {
 	GRPCServerOptions []grpc.ServerOption
 	GRPCDialOptions   []grpc.DialOption
 	ConnManager       cconnmgr.ConnManager
+
+	// TrustedSigners are the peers whose signed blocks are accepted. If empty, the blocks
+	// signed by the peer sending them are accepted.
+	TrustedSigners []peer.ID
+	// AllowUnsignedBlocks allows blocks that are not signed, such as those created before
+	// block signing was introduced, to be accepted.
+	AllowUnsignedBlocks bool
 }
 
 type NodeOpt func(*Options) error
@@ -70,6 +79,16 @@ func WithConfig(cfg *config.Config) NodeOpt {
 		}
 		opt.EnableRelay = cfg.Net.RelayEnabled
 		opt.EnablePubSub = cfg.Net.PubSubEnabled
+		opt.AllowUnsignedBlocks = cfg.Net.AllowUnsignedBlocks
+		if len(cfg.Net.TrustedSigners) > 0 {
+			for _, signer := range strings.Split(cfg.Net.TrustedSigners, ",") {
+				id, err := peer.Decode(signer)
+				if err != nil {
+					return err
+				}
+				opt.TrustedSigners = append(opt.TrustedSigners, id)
+			}
+		}
 		opt.ConnManager, err = NewConnManager(100, 400, time.Second*20)
 		if err != nil {
 			return err
@@ -102,6 +121,25 @@ func WithEnableRelay(enable bool) NodeOpt {
 	}
 }
 
+// WithTrustedSigners sets the peers whose signed blocks are accepted.
+//
+// Only the trusted signers may assert the identity of the caller that made a change, the blocks
+// asserting one are rejected if signed by any other peer.
+func WithTrustedSigners(signers ...peer.ID) NodeOpt {
+	return func(opt *Options) error {
+		opt.TrustedSigners = signers
+		return nil
+	}
+}
+
+// WithAllowUnsignedBlocks allows blocks that are not signed to be accepted.
+func WithAllowUnsignedBlocks(allow bool) NodeOpt {
+	return func(opt *Options) error {
+		opt.AllowUnsignedBlocks = allow
+		return nil
+	}
+}
+
 // ListenP2PAddrStrings sets the address to listen on given as strings.
 func WithListenP2PAddrStrings(addrs ...string) NodeOpt {
 	return func(opt *Options) error {
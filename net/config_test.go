@@ -79,6 +79,18 @@ func TestWithEnableRelay(t *testing.T) {
 	require.True(t, opt.EnableRelay)
 }
 
+func TestWithConfigWithTrustedSigners(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Net.TrustedSigners = "QmYyQSo1c1Ym7orWxLYvCrM2EmxFTANf8wXmmE7DWjhx5N"
+	cfg.Net.AllowUnsignedBlocks = true
+
+	opt, err := NewMergedOptions(WithConfig(cfg))
+	require.NoError(t, err)
+	require.Len(t, opt.TrustedSigners, 1)
+	require.Equal(t, "QmYyQSo1c1Ym7orWxLYvCrM2EmxFTANf8wXmmE7DWjhx5N", opt.TrustedSigners[0].String())
+	require.True(t, opt.AllowUnsignedBlocks)
+}
+
 func TestWithListenP2PAddrStringsWithError(t *testing.T) {
 	addr := "/willerror/0.0.0.0/tcp/9999"
 	_, err := NewMergedOptions(WithListenP2PAddrStrings(addr))
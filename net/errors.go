@@ -13,6 +13,7 @@ package net
 import (
 	"fmt"
 
+	"github.com/ipfs/go-cid"
 	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/sourcenetwork/defradb/errors"
@@ -26,6 +27,8 @@ const (
 	errReplicatorExists        = "replicator already exists for %s with peerID %s"
 	errReplicatorDocKey        = "failed to get dockey for replicator %s with peerID %s"
 	errReplicatorCollections   = "failed to get collections for replicator"
+	errUntrustedSigner         = "block is not signed by a trusted signer"
+	errUntrustedIdentity       = "block asserts an identity its signer is not trusted to assert"
 )
 
 var (
@@ -35,6 +38,8 @@ var (
 	ErrNilDB                    = errors.New("database object can't be nil")
 	ErrNilUpdateChannel         = errors.New("tried to subscribe to update channel, but update channel is nil")
 	ErrSelfTargetForReplicator  = errors.New("can't target ourselves as a replicator")
+	ErrUntrustedSigner          = errors.New(errUntrustedSigner)
+	ErrUntrustedIdentity        = errors.New(errUntrustedIdentity)
 )
 
 func NewErrPushLog(inner error, kv ...errors.KV) error {
@@ -64,3 +69,16 @@ func NewErrReplicatorDocKey(inner error, collection string, peerID peer.ID, kv .
 func NewErrReplicatorCollections(inner error, kv ...errors.KV) error {
 	return errors.Wrap(errReplicatorCollections, inner, kv...)
 }
+
+func NewErrUntrustedSigner(cid cid.Cid, signer peer.ID) error {
+	return errors.New(errUntrustedSigner, errors.NewKV("Cid", cid), errors.NewKV("Signer", signer))
+}
+
+func NewErrUntrustedIdentity(cid cid.Cid, signer peer.ID, identity string) error {
+	return errors.New(
+		errUntrustedIdentity,
+		errors.NewKV("Cid", cid),
+		errors.NewKV("Signer", signer),
+		errors.NewKV("Identity", identity),
+	)
+}
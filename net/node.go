@@ -149,6 +149,11 @@ func NewNode(
 		}
 	}
 
+	trustedSigners := make(map[peer.ID]struct{}, len(options.TrustedSigners))
+	for _, signer := range options.TrustedSigners {
+		trustedSigners[signer] = struct{}{}
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 
 	peer, err := NewPeer(
@@ -164,6 +169,8 @@ func NewNode(
 		cancel()
 		return nil, fin.Cleanup(err)
 	}
+	peer.trustedSigners = trustedSigners
+	peer.allowUnsignedBlocks = options.AllowUnsignedBlocks
 
 	n := &Node{
 		// WARNING: The current usage of these channels means that consumers of them
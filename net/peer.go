@@ -75,6 +75,12 @@ type Peer struct {
 	replicators map[string]map[peer.ID]struct{}
 	mu          sync.Mutex
 
+	// trustedSigners is the set of peers whose signed blocks are accepted. If empty, the blocks
+	// signed by the peer sending them are accepted.
+	trustedSigners map[peer.ID]struct{}
+	// allowUnsignedBlocks allows blocks that are not signed to be accepted.
+	allowUnsignedBlocks bool
+
 	// peer DAG service
 	ipld.DAGService
 	exch  exchange.Interface
@@ -129,6 +135,34 @@ func NewPeer(
 	return p, nil
 }
 
+// isTrustedSigner returns true if the blocks signed by the given signer and received from
+// the given sender are accepted.
+//
+// The blocks signed by this node are always accepted.
+func (p *Peer) isTrustedSigner(sender peer.ID, signer peer.ID) bool {
+	if signer == p.host.ID() {
+		return true
+	}
+	if len(p.trustedSigners) == 0 {
+		return signer == sender
+	}
+	_, ok := p.trustedSigners[signer]
+	return ok
+}
+
+// isIdentitySigner returns true if the caller identities asserted by the blocks signed by the
+// given signer are accepted.
+//
+// Only this node and the explicitly trusted signers may assert identities, any other peer could
+// otherwise claim to act on behalf of the owner of a document.
+func (p *Peer) isIdentitySigner(signer peer.ID) bool {
+	if signer == p.host.ID() {
+		return true
+	}
+	_, ok := p.trustedSigners[signer]
+	return ok
+}
+
 // Start all the internal workers/goroutines/loops that manage the P2P state.
 func (p *Peer) Start() error {
 	p.mu.Lock()
@@ -15,11 +15,13 @@ import (
 	"testing"
 	"time"
 
+	dag "github.com/ipfs/boxo/ipld/merkledag"
 	"github.com/ipfs/go-cid"
 	ds "github.com/ipfs/go-datastore"
 	ipld "github.com/ipfs/go-ipld-format"
 	libp2p "github.com/libp2p/go-libp2p"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
 	mh "github.com/multiformats/go-multihash"
 	rpc "github.com/sourcenetwork/go-libp2p-pubsub-rpc"
@@ -114,9 +116,12 @@ func createCID(doc *client.Document) (cid.Cid, error) {
 
 const randomMultiaddr = "/ip4/127.0.0.1/tcp/0"
 
-func newTestNode(ctx context.Context, t *testing.T) (client.DB, *Node) {
+func newTestNode(ctx context.Context, t *testing.T, opts ...NodeOpt) (client.DB, *Node) {
+	key, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	require.NoError(t, err)
+
 	store := memory.NewDatastore(ctx)
-	db, err := db.NewDB(ctx, store, db.WithUpdateEvents())
+	db, err := db.NewDB(ctx, store, db.WithUpdateEvents(), db.WithSigningKey(key))
 	require.NoError(t, err)
 
 	cfg := config.DefaultConfig()
@@ -125,13 +130,22 @@ func newTestNode(ctx context.Context, t *testing.T) (client.DB, *Node) {
 	n, err := NewNode(
 		ctx,
 		db,
-		WithConfig(cfg),
+		append([]NodeOpt{WithConfig(cfg), WithPrivateKey(key)}, opts...)...,
 	)
 	require.NoError(t, err)
 
 	return db, n
 }
 
+// getHeadBlock returns the block of the head of the given document.
+func getHeadBlock(ctx context.Context, t *testing.T, db client.DB, doc *client.Document) ipld.Node {
+	block, err := db.Blockstore().Get(ctx, doc.Head())
+	require.NoError(t, err)
+	nd, err := dag.DecodeProtobufBlock(block)
+	require.NoError(t, err)
+	return nd
+}
+
 func TestNewPeer_NoError(t *testing.T) {
 	ctx := context.Background()
 	store := memory.NewDatastore(ctx)
@@ -18,17 +18,21 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/fxamacker/cbor/v2"
 	dag "github.com/ipfs/boxo/ipld/merkledag"
 	blocks "github.com/ipfs/go-block-format"
 	"github.com/ipfs/go-cid"
 	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/core"
+	corecrdt "github.com/sourcenetwork/defradb/core/crdt"
 	"github.com/sourcenetwork/defradb/datastore"
 	"github.com/sourcenetwork/defradb/db/base"
 	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/logging"
+	"github.com/sourcenetwork/defradb/merkle/clock"
 	merklecrdt "github.com/sourcenetwork/defradb/merkle/crdt"
 )
 
@@ -87,11 +91,56 @@ func (bp *blockProcessor) processBlock(ctx context.Context, nd ipld.Node, field
 		return errors.Wrap("failed to decode delta object", err)
 	}
 
+	// Blocks that have been tampered with must never be merged.
+	signer, err := bp.verifyNode(nd, delta)
+	if err != nil {
+		return err
+	}
+
+	compositeDelta, isComposite := delta.(*corecrdt.CompositeDAGDelta)
+	if isComposite {
+		err = bp.checkIdentity(nd, signer, compositeDelta)
+		if err != nil {
+			return err
+		}
+		err = checkDocumentOwner(ctx, bp.txn, bp.col, bp.dsKey.DocKey, compositeDelta)
+		if err != nil {
+			return err
+		}
+		err = checkFieldConstraints(bp.col, compositeDelta)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = crdt.Clock().ProcessNode(ctx, delta, nd)
 	if err != nil {
 		return err
 	}
 
+	if isComposite {
+		if compositeDelta.GetPriority() == 1 && compositeDelta.Identity != "" {
+			err = base.WriteDocumentOwner(ctx, bp.txn, bp.col.ID(), bp.dsKey.DocKey, compositeDelta.Identity)
+			if err != nil {
+				return err
+			}
+		}
+		err = base.WritePendingChange(ctx, bp.txn, bp.col.ID(), client.Change{
+			DocKey:          bp.dsKey.DocKey,
+			Cid:             nd.Cid().String(),
+			SchemaVersionID: compositeDelta.SchemaVersionID,
+			Height:          compositeDelta.GetPriority(),
+			Deleted:         compositeDelta.Status.IsDeleted(),
+		})
+		if err != nil {
+			return err
+		}
+		err = base.WriteDocVersion(ctx, bp.txn, bp.col.ID(), bp.dsKey.DocKey, nd.Cid().String())
+		if err != nil {
+			return err
+		}
+	}
+
 	for _, link := range nd.Links() {
 		if link.Name == core.HEAD {
 			continue
@@ -166,6 +215,123 @@ func initCRDTForType(
 	), nil
 }
 
+// checkDocumentOwner returns an error if the given composite delta changes a document that the
+// local policy restricts to its owner, and the change was not made by that owner.
+//
+// The creation of a document is never restricted.
+func checkDocumentOwner(
+	ctx context.Context,
+	txn datastore.Txn,
+	col client.Collection,
+	docKey string,
+	delta *corecrdt.CompositeDAGDelta,
+) error {
+	if delta.GetPriority() == 1 {
+		return nil
+	}
+	policy, err := base.GetPolicy(ctx, txn)
+	if err != nil {
+		return err
+	}
+	owner, err := base.GetDocumentOwner(ctx, txn, col.ID(), docKey)
+	if err != nil {
+		return err
+	}
+	if !policy.IsDocumentAllowed(delta.Identity, client.WritePermission, col.Name(), owner) {
+		return client.NewErrPermissionDenied(delta.Identity, client.WritePermission, col.Name())
+	}
+	return nil
+}
+
+// checkFieldConstraints returns an error if a value of the given composite delta violates the
+// constraints of its field within the local schema of the collection.
+//
+// The values of encrypted fields are not validated.
+func checkFieldConstraints(col client.Collection, delta *corecrdt.CompositeDAGDelta) error {
+	if len(delta.Data) == 0 {
+		return nil
+	}
+	var values map[string]any
+	err := cbor.Unmarshal(delta.Data, &values)
+	if err != nil {
+		return err
+	}
+	schema := col.Schema()
+	for name, value := range values {
+		field, ok := schema.GetField(name)
+		if !ok || field.IsEncrypted || field.Constraints == "" {
+			continue
+		}
+		value, err := core.DecodeFieldValue(field, value)
+		if err != nil {
+			return err
+		}
+		err = base.ValidateFieldConstraints(schema.VersionID, field, value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyNode returns an error if the given block is not signed, unless unsigned blocks are
+// allowed, or if its signature does not match its content.
+//
+// The ID of the signer is returned if the block is signed.
+func (bp *blockProcessor) verifyNode(nd ipld.Node, delta core.Delta) (peer.ID, error) {
+	signer, err := clock.VerifyNode(nd, delta)
+	if errors.Is(err, clock.ErrUnsignedBlock) && bp.allowUnsignedBlocks {
+		return "", nil
+	}
+	return signer, err
+}
+
+// verifyHeadBlock returns an error if the given composite block, received from the given
+// sender, is not valid or is not signed by a trusted signer.
+//
+// The blocks linked by the head block are vouched for by its signer and only need to have a
+// valid signature.
+func (bp *blockProcessor) verifyHeadBlock(ctx context.Context, nd ipld.Node, sender peer.ID) error {
+	crdt, err := initCRDTForType(ctx, bp.txn, bp.col, bp.dsKey, "")
+	if err != nil {
+		return err
+	}
+	delta, err := crdt.DeltaDecode(nd)
+	if err != nil {
+		return errors.Wrap("failed to decode delta object", err)
+	}
+	signer, err := bp.verifyNode(nd, delta)
+	if err != nil {
+		return err
+	}
+	if signer != "" && !bp.isTrustedSigner(sender, signer) {
+		return NewErrUntrustedSigner(nd.Cid(), signer)
+	}
+	if compositeDelta, ok := delta.(*corecrdt.CompositeDAGDelta); ok {
+		return bp.checkIdentity(nd, signer, compositeDelta)
+	}
+	return nil
+}
+
+// checkIdentity returns an error if the given composite delta asserts the identity of the caller
+// that made the change, and the signer of its block is not trusted to assert identities.
+//
+// The identity is otherwise a string any peer could set, it is only as trustworthy as the signer
+// vouching for it.
+func (bp *blockProcessor) checkIdentity(
+	nd ipld.Node,
+	signer peer.ID,
+	delta *corecrdt.CompositeDAGDelta,
+) error {
+	if delta.Identity == "" {
+		return nil
+	}
+	if signer == "" || !bp.isIdentitySigner(signer) {
+		return NewErrUntrustedIdentity(nd.Cid(), signer, delta.Identity)
+	}
+	return nil
+}
+
 func decodeBlockBuffer(buf []byte, cid cid.Cid) (ipld.Node, error) {
 	blk, err := blocks.NewBlockWithCid(buf, cid)
 	if err != nil {
@@ -284,8 +284,13 @@ func (s *server) PushLog(ctx context.Context, req *pb.PushLogRequest) (*pb.PushL
 			return nil, errors.Wrap("failed to decode block to ipld.Node", err)
 		}
 
-		var session sync.WaitGroup
 		bp := newBlockProcessor(s.peer, txn, col, dsKey, getter)
+		err = bp.verifyHeadBlock(ctx, nd, pid)
+		if err != nil {
+			return nil, err
+		}
+
+		var session sync.WaitGroup
 		err = bp.processRemoteBlock(ctx, &session, nd, true)
 		if err != nil {
 			log.ErrorE(
@@ -15,15 +15,23 @@ import (
 	"testing"
 	"time"
 
+	dag "github.com/ipfs/boxo/ipld/merkledag"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/event"
 	"github.com/libp2p/go-libp2p/core/host"
+	libpeer "github.com/libp2p/go-libp2p/core/peer"
 	rpc "github.com/sourcenetwork/go-libp2p-pubsub-rpc"
 	"github.com/stretchr/testify/require"
 	grpcpeer "google.golang.org/grpc/peer"
 
 	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core/crdt"
 	"github.com/sourcenetwork/defradb/datastore/memory"
+	"github.com/sourcenetwork/defradb/db"
+	"github.com/sourcenetwork/defradb/db/base"
 	"github.com/sourcenetwork/defradb/errors"
+	"github.com/sourcenetwork/defradb/merkle/clock"
 	net_pb "github.com/sourcenetwork/defradb/net/pb"
 )
 
@@ -245,37 +253,65 @@ func TestDocQueue(t *testing.T) {
 	q.mu.Unlock()
 }
 
-func TestPushLog(t *testing.T) {
-	ctx := context.Background()
-	db, n := newTestNode(ctx, t)
-	err := n.Start()
+// newSourceDocument creates a document in a new database with the same schema as the
+// one used by the PushLog tests and returns it along with the database and its head block.
+func newSourceDocument(
+	ctx context.Context,
+	t *testing.T,
+	opts ...db.Option,
+) (client.DB, *client.Document, ipld.Node) {
+	source, err := db.NewDB(ctx, memory.NewDatastore(ctx), opts...)
 	require.NoError(t, err)
+	t.Cleanup(source.Close)
 
-	_, err = db.AddSchema(ctx, `type User {
+	_, err = source.AddSchema(ctx, `type User {
 		name: String
 		age: Int
 	}`)
 	require.NoError(t, err)
 
-	col, err := db.GetCollectionByName(ctx, "User")
+	col, err := source.GetCollectionByName(ctx, "User")
 	require.NoError(t, err)
 
 	doc, err := client.NewDocFromJSON([]byte(`{"name": "John", "age": 30}`))
 	require.NoError(t, err)
+	err = col.Create(ctx, doc)
+	require.NoError(t, err)
 
-	cid, err := createCID(doc)
+	return source, doc, getHeadBlock(ctx, t, source, doc)
+}
+
+// copyFieldBlocks copies the blocks linked by the given composite block from the source
+// database to the target one, so that they need not be fetched from the network.
+func copyFieldBlocks(ctx context.Context, t *testing.T, source client.DB, target client.DB, block ipld.Node) {
+	for _, link := range block.Links() {
+		fieldBlock, err := source.Blockstore().Get(ctx, link.Cid)
+		require.NoError(t, err)
+		err = target.Blockstore().Put(ctx, fieldBlock)
+		require.NoError(t, err)
+	}
+}
+
+// pushLog pushes the given block of the given document to the given node from the given sender.
+func pushLog(
+	ctx context.Context,
+	t *testing.T,
+	n *Node,
+	sender libpeer.ID,
+	doc *client.Document,
+	block ipld.Node,
+) error {
+	col, err := n.db.GetCollectionByName(ctx, "User")
 	require.NoError(t, err)
 
 	ctx = grpcpeer.NewContext(ctx, &grpcpeer.Peer{
-		Addr: addr{n.PeerID()},
+		Addr: addr{sender},
 	})
 
-	block := &EmptyNode{}
-
 	_, err = n.server.PushLog(ctx, &net_pb.PushLogRequest{
 		Body: &net_pb.PushLogRequest_Body{
 			DocKey:     []byte(doc.Key().String()),
-			Cid:        cid.Bytes(),
+			Cid:        block.Cid().Bytes(),
 			SchemaRoot: []byte(col.SchemaRoot()),
 			Creator:    n.PeerID().String(),
 			Log: &net_pb.Document_Log{
@@ -283,5 +319,233 @@ func TestPushLog(t *testing.T) {
 			},
 		},
 	})
+	return err
+}
+
+func setupPushLogNode(ctx context.Context, t *testing.T, opts ...NodeOpt) *Node {
+	db, n := newTestNode(ctx, t, opts...)
+	err := n.Start()
+	require.NoError(t, err)
+	t.Cleanup(n.Close)
+
+	_, err = db.AddSchema(ctx, `type User {
+		name: String
+		age: Int
+	}`)
+	require.NoError(t, err)
+	return n
+}
+
+func TestPushLog(t *testing.T) {
+	ctx := context.Background()
+	n := setupPushLogNode(ctx, t)
+
+	key, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	require.NoError(t, err)
+	signer, err := libpeer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+	source, doc, block := newSourceDocument(ctx, t, db.WithSigningKey(key))
+	copyFieldBlocks(ctx, t, source, n.db, block)
+
+	err = pushLog(ctx, t, n, signer, doc, block)
+	require.NoError(t, err)
+
+	col, err := n.db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	_, err = col.Get(ctx, doc.Key(), false)
+	require.NoError(t, err)
+}
+
+func TestPushLog_WithBlockSignedByOtherPeer_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	n := setupPushLogNode(ctx, t)
+
+	key, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	require.NoError(t, err)
+	_, doc, block := newSourceDocument(ctx, t, db.WithSigningKey(key))
+
+	sender, err := libpeer.Decode("QmYyQSo1c1Ym7orWxLYvCrM2EmxFTANf8wXmmE7DWjhx5N")
+	require.NoError(t, err)
+
+	err = pushLog(ctx, t, n, sender, doc, block)
+	require.ErrorIs(t, err, ErrUntrustedSigner)
+}
+
+func TestPushLog_WithTrustedSigners_AcceptsOnlyTrustedSigners(t *testing.T) {
+	ctx := context.Background()
+
+	trustedKey, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	require.NoError(t, err)
+	trusted, err := libpeer.IDFromPrivateKey(trustedKey)
+	require.NoError(t, err)
+	n := setupPushLogNode(ctx, t, WithTrustedSigners(trusted))
+
+	// The sending peer is no longer trusted once trusted signers are set.
+	key, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	require.NoError(t, err)
+	sender, err := libpeer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+	_, doc, block := newSourceDocument(ctx, t, db.WithSigningKey(key))
+
+	err = pushLog(ctx, t, n, sender, doc, block)
+	require.ErrorIs(t, err, ErrUntrustedSigner)
+
+	source, doc, block := newSourceDocument(ctx, t, db.WithSigningKey(trustedKey))
+	copyFieldBlocks(ctx, t, source, n.db, block)
+
+	err = pushLog(ctx, t, n, sender, doc, block)
+	require.NoError(t, err)
+}
+
+func TestPushLog_WithUnsignedBlock_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	n := setupPushLogNode(ctx, t)
+
+	_, doc, block := newSourceDocument(ctx, t)
+
+	err := pushLog(ctx, t, n, n.PeerID(), doc, block)
+	require.ErrorIs(t, err, clock.ErrUnsignedBlock)
+}
+
+func TestPushLog_WithUnsignedBlockAndAllowUnsignedBlocks_NoError(t *testing.T) {
+	ctx := context.Background()
+	n := setupPushLogNode(ctx, t, WithAllowUnsignedBlocks(true))
+
+	source, doc, block := newSourceDocument(ctx, t)
+	copyFieldBlocks(ctx, t, source, n.db, block)
+
+	err := pushLog(ctx, t, n, n.PeerID(), doc, block)
+	require.NoError(t, err)
+
+	col, err := n.db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	_, err = col.Get(ctx, doc.Key(), false)
+	require.NoError(t, err)
+}
+
+func TestPushLog_WithForgedBlock_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	n := setupPushLogNode(ctx, t)
+
+	key, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	require.NoError(t, err)
+	_, doc, block := newSourceDocument(ctx, t, db.WithSigningKey(key))
+
+	// Alter the content of the block while keeping its original signature.
+	delta, err := crdt.CompositeDAG{}.DeltaDecode(block)
+	require.NoError(t, err)
+	delta.SetPriority(delta.GetPriority() + 1)
+	data, err := delta.Marshal()
+	require.NoError(t, err)
+	forged := block.Copy().(*dag.ProtoNode)
+	forged.SetData(data)
+
+	signer, err := libpeer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+	err = pushLog(ctx, t, n, signer, doc, forged)
+	require.ErrorIs(t, err, clock.ErrInvalidBlockSignature)
+}
+
+func TestPushLog_WithIdentityAndUntrustedIdentitySigner_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	n := setupPushLogNode(ctx, t)
+
+	// The sending peer may sign blocks, but not assert the identity of their author.
+	key, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	require.NoError(t, err)
+	signer, err := libpeer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+	source, doc, block := newSourceDocument(client.WithIdentity(ctx, "bob"), t, db.WithSigningKey(key))
+	copyFieldBlocks(ctx, t, source, n.db, block)
+
+	err = pushLog(ctx, t, n, signer, doc, block)
+	require.ErrorIs(t, err, ErrUntrustedIdentity)
+}
+
+func TestPushLog_WithIdentityAndTrustedSigner_RecordsOwner(t *testing.T) {
+	ctx := context.Background()
+
+	key, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	require.NoError(t, err)
+	signer, err := libpeer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+	n := setupPushLogNode(ctx, t, WithTrustedSigners(signer))
+
+	source, doc, block := newSourceDocument(client.WithIdentity(ctx, "bob"), t, db.WithSigningKey(key))
+	copyFieldBlocks(ctx, t, source, n.db, block)
+
+	err = pushLog(ctx, t, n, signer, doc, block)
+	require.NoError(t, err)
+
+	col, err := n.db.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	txn, err := n.db.NewTxn(ctx, true)
+	require.NoError(t, err)
+	defer txn.Discard(ctx)
+
+	owner, err := base.GetDocumentOwner(ctx, txn, col.ID(), doc.Key().String())
+	require.NoError(t, err)
+	require.Equal(t, "bob", owner)
+}
+
+func TestCheckDocumentOwner_WithOwnerWritePolicy_RejectsChangesFromOtherIdentities(t *testing.T) {
+	ctx := context.Background()
+	source, doc, _ := newSourceDocument(client.WithIdentity(ctx, "bob"), t)
+
+	col, err := source.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	err = doc.Set("age", 31)
+	require.NoError(t, err)
+	err = col.Update(client.WithIdentity(ctx, "carol"), doc)
+	require.NoError(t, err)
+
+	err = source.SetPolicy(ctx, client.Policy{
+		Roles:     []client.Role{{Name: "admin", Permissions: []client.Permission{client.AdminPermission}}},
+		Members:   map[string][]string{"alice": {"admin"}},
+		Documents: []client.DocumentPolicy{{Collection: "User", OwnerWrite: true}},
+	})
+	require.NoError(t, err)
+
+	delta, err := crdt.CompositeDAG{}.DeltaDecode(getHeadBlock(ctx, t, source, doc))
+	require.NoError(t, err)
+	update := delta.(*crdt.CompositeDAGDelta)
+	require.Equal(t, "carol", update.Identity)
+
+	txn, err := source.NewTxn(ctx, true)
+	require.NoError(t, err)
+	defer txn.Discard(ctx)
+
+	err = checkDocumentOwner(ctx, txn, col, doc.Key().String(), update)
+	require.ErrorIs(t, err, client.ErrPermissionDenied)
+
+	update.Identity = "bob"
+	err = checkDocumentOwner(ctx, txn, col, doc.Key().String(), update)
+	require.NoError(t, err)
+}
+
+func TestCheckFieldConstraints_WithViolatingValue_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	source, _, block := newSourceDocument(ctx, t)
+
+	target, err := db.NewDB(ctx, memory.NewDatastore(ctx))
+	require.NoError(t, err)
+	t.Cleanup(target.Close)
+	_, err = target.AddSchema(ctx, `type User {
+		name: String
+		age: Int @constraint(max: 20)
+	}`)
+	require.NoError(t, err)
+	col, err := target.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+
+	delta, err := crdt.CompositeDAG{}.DeltaDecode(block)
+	require.NoError(t, err)
+
+	err = checkFieldConstraints(col, delta.(*crdt.CompositeDAGDelta))
+	require.ErrorIs(t, err, base.ErrConstraintViolation)
+
+	sourceCol, err := source.GetCollectionByName(ctx, "User")
+	require.NoError(t, err)
+	err = checkFieldConstraints(sourceCol, delta.(*crdt.CompositeDAGDelta))
 	require.NoError(t, err)
 }
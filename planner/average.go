@@ -11,6 +11,8 @@
 package planner
 
 import (
+	"math/big"
+
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/core"
@@ -98,6 +100,12 @@ func (n *averageNode) Next() (bool, error) {
 		n.currentValue.Fields[n.virtualFieldIndex] = sum / float64(count)
 	case int64:
 		n.currentValue.Fields[n.virtualFieldIndex] = float64(sum) / float64(count)
+	case *big.Int:
+		average := new(big.Rat).SetFrac(sum, big.NewInt(int64(count)))
+		n.currentValue.Fields[n.virtualFieldIndex] = client.NewDecimalFromRat(average)
+	case client.Decimal:
+		average := new(big.Rat).Quo(sum.Rat(), new(big.Rat).SetInt64(int64(count)))
+		n.currentValue.Fields[n.virtualFieldIndex] = client.NewDecimalFromRat(average)
 	default:
 		return false, client.NewErrUnhandledType("sum", sumProp)
 	}
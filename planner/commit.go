@@ -16,11 +16,14 @@ import (
 	blocks "github.com/ipfs/go-block-format"
 	cid "github.com/ipfs/go-cid"
 	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/core"
 	"github.com/sourcenetwork/defradb/db/fetcher"
+	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/planner/mapper"
 )
 
@@ -216,10 +219,16 @@ func (n *dagScanNode) Next() (bool, error) {
 		return false, err
 	}
 
-	currentValue, heads, err := n.dagBlockToNodeDoc(block)
+	currentValue, heads, readable, err := n.dagBlockToNodeDoc(block)
 	if err != nil {
 		return false, err
 	}
+	if !readable {
+		// The commits of documents that can not be read by the caller are skipped, along
+		// with the rest of the document's history.
+		n.visitedNodes[currentCid.String()] = true
+		return n.Next()
+	}
 
 	// the dagscan node can traverse into the merkle dag
 	// based on the specified depth limit.
@@ -288,7 +297,7 @@ which returns the current dag commit for the stored CRDT value.
 All the dagScanNode endpoints use similar structures
 */
 
-func (n *dagScanNode) dagBlockToNodeDoc(block blocks.Block) (core.Doc, []*ipld.Link, error) {
+func (n *dagScanNode) dagBlockToNodeDoc(block blocks.Block) (core.Doc, []*ipld.Link, bool, error) {
 	commit := n.commitSelect.DocumentMapping.NewDoc()
 	cid := block.Cid()
 	n.commitSelect.DocumentMapping.SetFirstOfName(&commit, "cid", cid.String())
@@ -296,29 +305,29 @@ func (n *dagScanNode) dagBlockToNodeDoc(block blocks.Block) (core.Doc, []*ipld.L
 	// decode the delta, get the priority and payload
 	nd, err := dag.DecodeProtobuf(block.RawData())
 	if err != nil {
-		return core.Doc{}, nil, err
+		return core.Doc{}, nil, false, err
 	}
 
 	// @todo: Wrap delta unmarshaling into a proper typed interface.
 	var delta map[string]any
 	if err := cbor.Unmarshal(nd.Data(), &delta); err != nil {
-		return core.Doc{}, nil, err
+		return core.Doc{}, nil, false, err
 	}
 
 	prio, ok := delta["Priority"].(uint64)
 	if !ok {
-		return core.Doc{}, nil, ErrDeltaMissingPriority
+		return core.Doc{}, nil, false, ErrDeltaMissingPriority
 	}
 
 	schemaVersionId, ok := delta["SchemaVersionID"].(string)
 	if !ok {
-		return core.Doc{}, nil, ErrDeltaMissingSchemaVersionID
+		return core.Doc{}, nil, false, ErrDeltaMissingSchemaVersionID
 	}
 	n.commitSelect.DocumentMapping.SetFirstOfName(&commit, request.SchemaVersionIDFieldName, schemaVersionId)
 
 	fieldName, ok := delta["FieldName"]
 	if !ok {
-		return core.Doc{}, nil, ErrDeltaMissingFieldName
+		return core.Doc{}, nil, false, ErrDeltaMissingFieldName
 	}
 
 	var fieldID string
@@ -330,17 +339,17 @@ func (n *dagScanNode) dagBlockToNodeDoc(block blocks.Block) (core.Doc, []*ipld.L
 	default:
 		cols, err := n.planner.db.GetCollectionsByVersionID(n.planner.ctx, schemaVersionId)
 		if err != nil {
-			return core.Doc{}, nil, err
+			return core.Doc{}, nil, false, err
 		}
 		if len(cols) == 0 {
-			return core.Doc{}, nil, client.NewErrCollectionNotFoundForSchemaVersion(schemaVersionId)
+			return core.Doc{}, nil, false, client.NewErrCollectionNotFoundForSchemaVersion(schemaVersionId)
 		}
 
 		// Because we only care about the schema, we can safely take the first - the schema is the same
 		// for all in the set.
 		field, ok := cols[0].Schema().GetField(fieldName.(string))
 		if !ok {
-			return core.Doc{}, nil, client.NewErrFieldNotExist(fieldName.(string))
+			return core.Doc{}, nil, false, client.NewErrFieldNotExist(fieldName.(string))
 		}
 		fieldID = field.ID.String()
 	}
@@ -350,9 +359,15 @@ func (n *dagScanNode) dagBlockToNodeDoc(block blocks.Block) (core.Doc, []*ipld.L
 	n.commitSelect.DocumentMapping.SetFirstOfName(&commit, request.FieldNameFieldName, fieldName)
 	n.commitSelect.DocumentMapping.SetFirstOfName(&commit, request.FieldIDFieldName, fieldID)
 
+	signer, err := signerFromDelta(delta)
+	if err != nil {
+		return core.Doc{}, nil, false, err
+	}
+	n.commitSelect.DocumentMapping.SetFirstOfName(&commit, request.SignerFieldName, signer)
+
 	dockey, ok := delta["DocKey"].([]byte)
 	if !ok {
-		return core.Doc{}, nil, ErrDeltaMissingDockey
+		return core.Doc{}, nil, false, ErrDeltaMissingDockey
 	}
 
 	n.commitSelect.DocumentMapping.SetFirstOfName(&commit,
@@ -360,10 +375,10 @@ func (n *dagScanNode) dagBlockToNodeDoc(block blocks.Block) (core.Doc, []*ipld.L
 
 	cols, err := n.planner.db.GetCollectionsByVersionID(n.planner.ctx, schemaVersionId)
 	if err != nil {
-		return core.Doc{}, nil, err
+		return core.Doc{}, nil, false, err
 	}
 	if len(cols) == 0 {
-		return core.Doc{}, nil, client.NewErrCollectionNotFoundForSchemaVersion(schemaVersionId)
+		return core.Doc{}, nil, false, client.NewErrCollectionNotFoundForSchemaVersion(schemaVersionId)
 	}
 
 	// WARNING: This will become incorrect once we allow multiple collections to share the same schema,
@@ -372,6 +387,11 @@ func (n *dagScanNode) dagBlockToNodeDoc(block blocks.Block) (core.Doc, []*ipld.L
 	n.commitSelect.DocumentMapping.SetFirstOfName(&commit,
 		request.CollectionIDFieldName, int64(cols[0].ID()))
 
+	readable, err := n.isReadable(cols[0], string(dockey))
+	if err != nil || !readable {
+		return core.Doc{}, nil, false, err
+	}
+
 	heads := make([]*ipld.Link, 0)
 
 	// links
@@ -398,7 +418,53 @@ func (n *dagScanNode) dagBlockToNodeDoc(block blocks.Block) (core.Doc, []*ipld.L
 		}
 	}
 
-	return commit, heads, nil
+	return commit, heads, true, nil
 }
 
 func (n *dagScanNode) Append() bool { return true }
+
+// isReadable returns true if the caller may read the given document of the given collection.
+//
+// Commits of collections outside the scope of the roles of the caller are never readable.
+func (n *dagScanNode) isReadable(col client.Collection, docKey string) (bool, error) {
+	err := client.CheckPermission(n.planner.ctx, client.ReadPermission, col.Name())
+	if errors.Is(err, client.ErrPermissionDenied) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !client.HasDocumentPolicy(n.planner.ctx, col.Name()) {
+		return true, nil
+	}
+	owner, err := n.planner.getDocumentOwner(col.ID(), docKey)
+	if err != nil {
+		return false, err
+	}
+	err = client.CheckDocumentPermission(n.planner.ctx, client.ReadPermission, col.Name(), owner)
+	if errors.Is(err, client.ErrPermissionDenied) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// signerFromDelta returns the peer ID of the signer of the commit holding the given decoded delta,
+// or nil if the commit is not signed.
+func signerFromDelta(delta map[string]any) (any, error) {
+	signer, ok := delta["Signer"].([]byte)
+	if !ok || len(signer) == 0 {
+		return nil, nil
+	}
+	pubKey, err := crypto.UnmarshalPublicKey(signer)
+	if err != nil {
+		return nil, err
+	}
+	id, err := peer.IDFromPublicKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return id.String(), nil
+}
@@ -12,6 +12,7 @@ package planner
 
 import (
 	"encoding/json"
+	"strings"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
@@ -37,13 +38,18 @@ type createNode struct {
 	// collection name, meta-data, etc.
 	collection client.Collection
 
-	// newDoc is the JSON string of the new document, unparsed
+	// newDocStr is the JSON string of the new document, or a JSON array
+	// of new documents, unparsed
 	newDocStr string
-	doc       *client.Document
+	// inputs are the new documents given as typed input objects, if any
+	inputs []request.MutationInput
+	docs   []*client.Document
 
 	err error
 
-	returned bool
+	created bool
+	// returned is the number of created documents yielded so far
+	returned int
 	results  planNode
 
 	execInfo createExecInfo
@@ -59,16 +65,48 @@ func (n *createNode) Kind() string { return "createNode" }
 func (n *createNode) Init() error { return nil }
 
 func (n *createNode) Start() error {
-	doc, err := client.NewDocFromJSON([]byte(n.newDocStr))
+	if len(n.inputs) > 0 {
+		// documents given as inputs are parsed once the documents they
+		// relate to have been written
+		return nil
+	}
+	docs, err := parseCreateDocs(n.newDocStr)
 	if err != nil {
 		n.err = err
 		return err
 	}
-	n.doc = doc
+	n.docs = docs
 	return nil
 }
 
-// Next only returns once.
+// parseCreateDocs parses the given create payload, which is either a single
+// JSON document or a JSON array of documents.
+func parseCreateDocs(data string) ([]*client.Document, error) {
+	if !strings.HasPrefix(strings.TrimSpace(data), "[") {
+		doc, err := client.NewDocFromJSON([]byte(data))
+		if err != nil {
+			return nil, err
+		}
+		return []*client.Document{doc}, nil
+	}
+
+	var rawDocs []json.RawMessage
+	if err := json.Unmarshal([]byte(data), &rawDocs); err != nil {
+		return nil, err
+	}
+	docs := make([]*client.Document, len(rawDocs))
+	for i, rawDoc := range rawDocs {
+		doc, err := client.NewDocFromJSON(rawDoc)
+		if err != nil {
+			return nil, err
+		}
+		docs[i] = doc
+	}
+	return docs, nil
+}
+
+// Next creates all of the documents on the first call, and then
+// returns them one at a time, in the order they were given.
 func (n *createNode) Next() (bool, error) {
 	n.execInfo.iterations++
 
@@ -76,32 +114,21 @@ func (n *createNode) Next() (bool, error) {
 		return false, n.err
 	}
 
-	if n.returned {
-		return false, nil
-	}
-
-	if err := n.collection.WithTxn(n.p.txn).Create(n.p.ctx, n.doc); err != nil {
-		return false, err
-	}
-
-	currentValue := n.documentMapping.NewDoc()
-
-	currentValue.SetKey(n.doc.Key().String())
-	for i, value := range n.doc.Values() {
-		if len(n.documentMapping.IndexesByName[i.Name()]) > 0 {
-			n.documentMapping.SetFirstOfName(&currentValue, i.Name(), value.Value())
-		} else if aliasName := i.Name() + request.RelatedObjectID; len(n.documentMapping.IndexesByName[aliasName]) > 0 {
-			n.documentMapping.SetFirstOfName(&currentValue, aliasName, value.Value())
-		} else {
-			return false, client.NewErrFieldNotExist(i.Name())
+	if !n.created {
+		if err := n.create(); err != nil {
+			return false, err
 		}
+		n.created = true
 	}
 
-	n.returned = true
-	n.currentValue = currentValue
+	if n.returned >= len(n.docs) {
+		return false, nil
+	}
+	doc := n.docs[n.returned]
+	n.returned++
 
 	desc := n.collection.Description()
-	docKey := base.MakeDocKey(desc, currentValue.GetKey())
+	docKey := base.MakeDocKey(desc, doc.Key().String())
 	n.results.Spans(core.NewSpans(core.NewSpan(docKey, docKey.PrefixEnd())))
 
 	err := n.results.Init()
@@ -109,9 +136,11 @@ func (n *createNode) Next() (bool, error) {
 		return false, err
 	}
 
-	err = n.results.Start()
-	if err != nil {
-		return false, err
+	if n.returned == 1 {
+		err = n.results.Start()
+		if err != nil {
+			return false, err
+		}
 	}
 
 	// get the next result based on our point lookup
@@ -127,6 +156,45 @@ func (n *createNode) Next() (bool, error) {
 	return true, nil
 }
 
+func (n *createNode) create() error {
+	col := n.collection.WithTxn(n.p.txn)
+
+	for _, input := range n.inputs {
+		data, err := n.p.writePrimaryRelations(col, input, nil)
+		if err != nil {
+			return err
+		}
+		doc, err := client.NewDocFromJSON([]byte(data))
+		if err != nil {
+			return err
+		}
+		n.docs = append(n.docs, doc)
+	}
+
+	if err := col.CreateMany(n.p.ctx, n.docs); err != nil {
+		return err
+	}
+
+	for i, input := range n.inputs {
+		if err := n.p.writeSecondaryRelations(col, n.docs[i].Key().String(), input); err != nil {
+			return err
+		}
+	}
+
+	for _, doc := range n.docs {
+		for i := range doc.Values() {
+			if len(n.documentMapping.IndexesByName[i.Name()]) > 0 {
+				continue
+			}
+			if aliasName := i.Name() + request.RelatedObjectID; len(n.documentMapping.IndexesByName[aliasName]) > 0 {
+				continue
+			}
+			return client.NewErrFieldNotExist(i.Name())
+		}
+	}
+	return nil
+}
+
 func (n *createNode) Spans(spans core.Spans) { /* no-op */ }
 
 func (n *createNode) Close() error {
@@ -136,10 +204,21 @@ func (n *createNode) Close() error {
 func (n *createNode) Source() planNode { return n.results }
 
 func (n *createNode) simpleExplain() (map[string]any, error) {
-	data := map[string]any{}
-	err := json.Unmarshal([]byte(n.newDocStr), &data)
-	if err != nil {
-		return nil, err
+	var data any
+	if len(n.inputs) > 0 {
+		inputs := make([]any, len(n.inputs))
+		for i, input := range n.inputs {
+			err := json.Unmarshal([]byte(input.Data), &inputs[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+		data = inputs
+	} else {
+		err := json.Unmarshal([]byte(n.newDocStr), &data)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return map[string]any{
@@ -174,6 +253,7 @@ func (p *Planner) CreateDoc(parsed *mapper.Mutation) (planNode, error) {
 	create := &createNode{
 		p:         p,
 		newDocStr: parsed.Data,
+		inputs:    parsed.Input,
 		results:   results,
 		docMapper: docMapper{parsed.DocumentMapping},
 	}
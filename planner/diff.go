@@ -0,0 +1,102 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/planner/mapper"
+)
+
+// diffNode yields the fields whose values differ between two versions of the
+// document provided via Spans, one document per field.
+//
+// It is used to resolve `_diff` selections.
+type diffNode struct {
+	documentIterator
+	docMapper
+
+	p          *Planner
+	collection client.Collection
+	diffSelect *mapper.Select
+
+	docKey string
+	diff   []client.FieldDiff
+}
+
+func (p *Planner) Diff(diffSelect *mapper.Select, collection client.Collection) *diffNode {
+	return &diffNode{
+		p:          p,
+		collection: collection,
+		diffSelect: diffSelect,
+		docMapper:  docMapper{diffSelect.DocumentMapping},
+	}
+}
+
+func (n *diffNode) Kind() string {
+	return "diffNode"
+}
+
+func (n *diffNode) Init() error {
+	n.diff = nil
+	if n.docKey == "" {
+		// The document is only provided once the parent document has been fetched.
+		return nil
+	}
+
+	key, err := client.NewDocKeyFromString(n.docKey)
+	if err != nil {
+		return err
+	}
+
+	n.diff, err = n.collection.WithTxn(n.p.txn).Diff(
+		n.p.ctx,
+		key,
+		n.diffSelect.DiffFrom.Value(),
+		n.diffSelect.DiffTo.Value(),
+	)
+	return err
+}
+
+func (n *diffNode) Start() error {
+	return nil
+}
+
+// Spans sets the document to diff, which is passed as the DocKey of the first span.
+func (n *diffNode) Spans(spans core.Spans) {
+	if len(spans.Value) == 0 {
+		return
+	}
+	n.docKey = spans.Value[0].Start().DocKey
+}
+
+func (n *diffNode) Next() (bool, error) {
+	if len(n.diff) == 0 {
+		return false, nil
+	}
+	fieldDiff := n.diff[0]
+	n.diff = n.diff[1:]
+
+	n.currentValue = n.documentMapping.NewDoc()
+	n.documentMapping.SetFirstOfName(&n.currentValue, request.DiffFieldFieldName, fieldDiff.Field)
+	n.documentMapping.SetFirstOfName(&n.currentValue, request.DiffBeforeFieldName, fieldDiff.Before)
+	n.documentMapping.SetFirstOfName(&n.currentValue, request.DiffAfterFieldName, fieldDiff.After)
+	return true, nil
+}
+
+func (n *diffNode) Source() planNode { return nil }
+
+func (n *diffNode) Close() error {
+	return nil
+}
+
+func (n *diffNode) Append() bool { return true }
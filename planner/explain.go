@@ -40,6 +40,8 @@ var (
 	_ explainablePlanNode = (*groupNode)(nil)
 	_ explainablePlanNode = (*limitNode)(nil)
 	_ explainablePlanNode = (*orderNode)(nil)
+	_ explainablePlanNode = (*revertNode)(nil)
+	_ explainablePlanNode = (*upsertNode)(nil)
 	_ explainablePlanNode = (*scanNode)(nil)
 	_ explainablePlanNode = (*selectNode)(nil)
 	_ explainablePlanNode = (*selectTopNode)(nil)
@@ -51,6 +53,7 @@ var (
 
 const (
 	childFieldNameLabel = "childFieldName"
+	cidLabel            = "cid"
 	collectionIDLabel   = "collectionID"
 	collectionNameLabel = "collectionName"
 	dataLabel           = "data"
@@ -64,6 +67,7 @@ const (
 	offsetLabel         = "offset"
 	sourcesLabel        = "sources"
 	spansLabel          = "spans"
+	updateLabel         = "update"
 )
 
 // buildDebugExplainGraph dumps the entire plan graph as is, with all the plan nodes.
@@ -128,10 +128,19 @@ func toSelect(
 		}
 	}
 
+	targetable := toTargetable(thisIndex, selectRequest, mapping)
+	err = setOrderEnumValues(ctx, store, collection, selectRequest.OrderBy, targetable.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Select{
-		Targetable:      toTargetable(thisIndex, selectRequest, mapping),
+		Targetable:      targetable,
 		DocumentMapping: mapping,
 		Cid:             selectRequest.CID,
+		AsOf:            selectRequest.AsOf,
+		DiffFrom:        selectRequest.DiffFrom,
+		DiffTo:          selectRequest.DiffTo,
 		CollectionName:  collectionName,
 		Fields:          fields,
 	}, nil
@@ -713,6 +722,8 @@ func getCollectionName(
 		return parentCollectionName, nil
 	} else if selectRequest.Root == request.CommitSelection {
 		return parentCollectionName, nil
+	} else if selectRequest.Root == request.DiffSelection {
+		return parentCollectionName, nil
 	}
 
 	if parentCollectionName != "" {
@@ -770,11 +781,20 @@ func getTopLevelInfo(
 		mapping.SetTypeName(collectionName)
 
 		mapping.Add(mapping.GetNextIndex(), request.DeletedFieldName)
+		mapping.Add(mapping.GetNextIndex(), request.OwnerFieldName)
 
 		return mapping, collection, nil
 	}
 
-	if selectRequest.Name == request.LinksFieldName {
+	if selectRequest.Root == request.DiffSelection {
+		for i, f := range request.DiffFields {
+			mapping.Add(i, f)
+		}
+
+		// Setting the type name must be done after adding the fields, as
+		// the typeName index is dynamic, but the field indexes are not
+		mapping.SetTypeName(request.DiffTypeName)
+	} else if selectRequest.Name == request.LinksFieldName {
 		for i, f := range request.LinksFields {
 			mapping.Add(i, f)
 		}
@@ -1100,9 +1120,13 @@ func ToMutation(ctx context.Context, store client.Store, mutationRequest *reques
 	}
 
 	return &Mutation{
-		Select: *underlyingSelect,
-		Type:   MutationType(mutationRequest.Type),
-		Data:   mutationRequest.Data,
+		Select:        *underlyingSelect,
+		Type:          MutationType(mutationRequest.Type),
+		Data:          mutationRequest.Data,
+		Input:         mutationRequest.Input,
+		Cid:           mutationRequest.Cid,
+		Update:        mutationRequest.Update,
+		RequestFilter: mutationRequest.Filter,
 	}, nil
 }
 
@@ -1158,6 +1182,10 @@ func toFilterMap(
 		key := &Operator{
 			Operation: sourceKey,
 		}
+		if _, isGeoOp := request.GeoFilterOps[sourceKey]; isGeoOp {
+			// The conditions of geo operators are objects of their own properties.
+			return key, sourceClause
+		}
 		switch typedClause := sourceClause.(type) {
 		case []any:
 			// If the clause is an array then we need to convert any inner maps.
@@ -1202,8 +1230,14 @@ func toFilterMap(
 			returnClause := map[connor.FilterKey]any{}
 			for innerSourceKey, innerSourceValue := range typedClause {
 				var innerMapping *core.DocumentMapping
+				_, isGeoOp := request.GeoFilterOps[innerSourceKey]
 				switch innerSourceValue.(type) {
 				case map[string]any:
+					if isGeoOp {
+						// The conditions of geo operators apply to the value of this property.
+						innerMapping = mapping
+						break
+					}
 					// If the innerSourceValue is also a map, then we should parse the nested clause
 					// using the child mapping, as this key must refer to a host property in a join
 					// and deeper keys must refer to properties on the child items.
@@ -1292,6 +1326,13 @@ func toOrderBy(source immutable.Option[request.OrderBy], mapping *core.DocumentM
 			FieldIndexes: fieldIndexes,
 			Direction:    SortDirection(condition.Direction),
 		}
+		if condition.DistanceFrom.HasValue() {
+			point := condition.DistanceFrom.Value()
+			conditions[conditionIndex].DistanceFrom = immutable.Some(client.GeoPoint{
+				Latitude:  point[0],
+				Longitude: point[1],
+			})
+		}
 	}
 
 	return &OrderBy{
@@ -1299,6 +1340,47 @@ func toOrderBy(source immutable.Option[request.OrderBy], mapping *core.DocumentM
 	}
 }
 
+// setOrderEnumValues sets the declared enum values of the order conditions sorting by an
+// enum field, walking the condition fields through the related collections.
+func setOrderEnumValues(
+	ctx context.Context,
+	store client.Store,
+	collection client.Collection,
+	source immutable.Option[request.OrderBy],
+	orderBy *OrderBy,
+) error {
+	if collection == nil || orderBy == nil {
+		return nil
+	}
+
+	for conditionIndex, condition := range source.Value().Conditions {
+		schema := collection.Schema()
+		for fieldIndex, field := range condition.Fields {
+			fieldDesc, ok := schema.GetField(field)
+			if !ok {
+				break
+			}
+			if fieldIndex == len(condition.Fields)-1 {
+				if fieldDesc.Kind == client.FieldKind_ENUM {
+					if enum, ok := schema.GetEnum(fieldDesc.Schema); ok {
+						orderBy.Conditions[conditionIndex].EnumValues = enum.Values
+					}
+				}
+				break
+			}
+			if !fieldDesc.IsObject() {
+				break
+			}
+			relatedCollection, err := store.GetCollectionByName(ctx, fieldDesc.Schema)
+			if err != nil {
+				return err
+			}
+			schema = relatedCollection.Schema()
+		}
+	}
+	return nil
+}
+
 // RunFilter runs the given filter expression
 // using the document, and evaluates.
 func RunFilter(doc any, filter *Filter) (bool, error) {
@@ -1411,7 +1493,8 @@ func (o *OrderBy) equal(other *OrderBy) bool {
 
 	for i, conditionA := range o.Conditions {
 		conditionB := other.Conditions[i]
-		if conditionA.Direction != conditionB.Direction {
+		if conditionA.Direction != conditionB.Direction ||
+			conditionA.DistanceFrom != conditionB.DistanceFrom {
 			return false
 		}
 
@@ -10,6 +10,12 @@
 
 package mapper
 
+import (
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client/request"
+)
+
 type MutationType int
 
 const (
@@ -17,6 +23,8 @@ const (
 	CreateObjects
 	UpdateObjects
 	DeleteObjects
+	RevertObjects
+	UpsertObjects
 )
 
 // Mutation represents a request to mutate data stored in Defra.
@@ -30,6 +38,20 @@ type Mutation struct {
 	// The data to be used for the mutation.  For example, during a create this
 	// will be the json representation of the object to be inserted.
 	Data string
+
+	// The documents given as typed input objects, if any, in which case Data is empty.
+	Input []request.MutationInput
+
+	// The version that the document should be reverted to during a revert.
+	Cid string
+
+	// The patch applied to the matching document during an upsert, Data holding
+	// the document to create if no document matches.
+	Update string
+
+	// The filter of the request, the matching document of an upsert is looked up by
+	// the collection with it.
+	RequestFilter immutable.Option[request.Filter]
 }
 
 func (m *Mutation) CloneTo(index int) Requestable {
@@ -38,8 +60,12 @@ func (m *Mutation) CloneTo(index int) Requestable {
 
 func (m *Mutation) cloneTo(index int) *Mutation {
 	return &Mutation{
-		Select: *m.Select.cloneTo(index),
-		Type:   m.Type,
-		Data:   m.Data,
+		Select:        *m.Select.cloneTo(index),
+		Type:          m.Type,
+		Data:          m.Data,
+		Input:         m.Input,
+		Cid:           m.Cid,
+		Update:        m.Update,
+		RequestFilter: m.RequestFilter,
 	}
 }
@@ -13,6 +13,7 @@ package mapper
 import (
 	"github.com/sourcenetwork/immutable"
 
+	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/core"
 )
 
@@ -30,6 +31,13 @@ type Select struct {
 	// A commit identifier that can be specified to request data at a given time.
 	Cid immutable.Option[string]
 
+	// A point in history at which the documents should be read.
+	AsOf immutable.Option[request.AsOf]
+
+	// The composite commit CIDs of the document versions compared by a _diff select.
+	DiffFrom immutable.Option[string]
+	DiffTo   immutable.Option[string]
+
 	// The name of the collection that this Select selects data from.
 	CollectionName string
 
@@ -57,6 +65,9 @@ func (s *Select) cloneTo(index int) *Select {
 		Targetable:      *s.Targetable.cloneTo(index),
 		DocumentMapping: s.DocumentMapping,
 		Cid:             s.Cid,
+		AsOf:            s.AsOf,
+		DiffFrom:        s.DiffFrom,
+		DiffTo:          s.DiffTo,
 		CollectionName:  s.CollectionName,
 		Fields:          s.Fields,
 	}
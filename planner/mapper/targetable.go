@@ -13,6 +13,7 @@ package mapper
 import (
 	"github.com/sourcenetwork/immutable"
 
+	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/connor"
 	"github.com/sourcenetwork/defradb/core"
@@ -181,6 +182,15 @@ type OrderCondition struct {
 
 	// The direction in which the sort should be applied.
 	Direction SortDirection
+
+	// The declared values of the enum of the property to sort by, if it is an enum.
+	//
+	// Enum values are sorted by their declaration order instead of lexically.
+	EnumValues []string
+
+	// The point the distance of the property to sort by is measured from, if sorting geo points
+	// by distance.
+	DistanceFrom immutable.Option[client.GeoPoint]
 }
 
 type OrderBy struct {
@@ -22,6 +22,8 @@ var (
 	_ planNode = (*orderNode)(nil)
 	_ planNode = (*parallelNode)(nil)
 	_ planNode = (*pipeNode)(nil)
+	_ planNode = (*revertNode)(nil)
+	_ planNode = (*upsertNode)(nil)
 	_ planNode = (*scanNode)(nil)
 	_ planNode = (*selectNode)(nil)
 	_ planNode = (*selectTopNode)(nil)
@@ -147,6 +147,10 @@ func (p *Planner) newPlan(stmt any) (planNode, error) {
 }
 
 func (p *Planner) newObjectMutationPlan(stmt *mapper.Mutation) (planNode, error) {
+	if err := client.CheckPermission(p.ctx, client.WritePermission, stmt.Name); err != nil {
+		return nil, err
+	}
+
 	switch stmt.Type {
 	case mapper.CreateObjects:
 		return p.CreateDoc(stmt)
@@ -157,6 +161,12 @@ func (p *Planner) newObjectMutationPlan(stmt *mapper.Mutation) (planNode, error)
 	case mapper.DeleteObjects:
 		return p.DeleteDocs(stmt)
 
+	case mapper.RevertObjects:
+		return p.RevertDoc(stmt)
+
+	case mapper.UpsertObjects:
+		return p.UpsertDoc(stmt)
+
 	default:
 		return nil, client.NewErrUnhandledType("mutation", stmt.Type)
 	}
@@ -237,6 +247,12 @@ func (p *Planner) expandPlan(planNode planNode, parentPlan *selectTopNode) error
 	case *deleteNode:
 		return p.expandPlan(n.source, parentPlan)
 
+	case *revertNode:
+		return p.expandPlan(n.results, parentPlan)
+
+	case *upsertNode:
+		return p.expandPlan(n.results, parentPlan)
+
 	default:
 		return nil
 	}
@@ -535,10 +551,35 @@ func (p *Planner) executeRequest(
 }
 
 // RunRequest classifies the type of request to run, runs it, and then returns the result(s).
+//
+// All of the fields of a mutation operation are run in the order they were requested, within
+// the transaction of the planner. If there is only one, its documents are returned. If there
+// are more, one result is returned per field in that same order, holding the documents of the
+// field by its alias, or name.
 func (p *Planner) RunRequest(
 	ctx context.Context,
 	req *request.Request,
 ) (result []map[string]any, err error) {
+	if len(req.Queries) == 0 && len(req.Mutations) > 0 && len(req.Mutations[0].Selections) > 1 {
+		result = []map[string]any{}
+		for _, selection := range req.Mutations[0].Selections {
+			fieldReq := &request.Request{
+				Mutations: []*request.OperationDefinition{
+					{
+						Selections: []request.Selection{selection},
+						Directives: req.Mutations[0].Directives,
+					},
+				},
+			}
+			fieldResult, err := p.RunRequest(ctx, fieldReq)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, map[string]any{mutationResultKey(selection): fieldResult})
+		}
+		return result, nil
+	}
+
 	planNode, err := p.makePlan(req)
 	if err != nil {
 		return nil, err
@@ -572,6 +613,19 @@ func (p *Planner) RunRequest(
 	return p.executeRequest(ctx, planNode)
 }
 
+// mutationResultKey returns the key the results of the given mutation field are returned by,
+// its alias if it has one, or its name.
+func mutationResultKey(selection request.Selection) string {
+	mutation, ok := selection.(*request.ObjectMutation)
+	if !ok {
+		return ""
+	}
+	if mutation.Alias.HasValue() {
+		return mutation.Alias.Value()
+	}
+	return mutation.Name
+}
+
 // RunSubscriptionRequest plans a request specific to a subscription and returns the result.
 func (p *Planner) RunSubscriptionRequest(
 	ctx context.Context,
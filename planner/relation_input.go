@@ -0,0 +1,174 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"encoding/json"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+)
+
+// createInput creates the document given by the typed input, along with the documents
+// created by its relation inputs, and returns the dockey of the new document.
+//
+// The documents it relates to through the primary side of its relations are written first,
+// so that their dockeys can be set on it, and those it relates to through the secondary side
+// are written after it. The given related keys are set on the document as well.
+func (p *Planner) createInput(
+	col client.Collection,
+	input request.MutationInput,
+	relatedKeys map[string]string,
+) (string, error) {
+	data, err := p.writePrimaryRelations(col, input, relatedKeys)
+	if err != nil {
+		return "", err
+	}
+	doc, err := client.NewDocFromJSON([]byte(data))
+	if err != nil {
+		return "", err
+	}
+	if err := col.Create(p.ctx, doc); err != nil {
+		return "", err
+	}
+	docKey := doc.Key().String()
+	return docKey, p.writeSecondaryRelations(col, docKey, input)
+}
+
+// writePrimaryRelations connects or creates the documents the given input relates to through
+// the primary side of its relations, and returns the input data with their dockeys, and the
+// given related keys, set.
+func (p *Planner) writePrimaryRelations(
+	col client.Collection,
+	input request.MutationInput,
+	relatedKeys map[string]string,
+) (string, error) {
+	keys := make(map[string]string, len(relatedKeys))
+	for name, key := range relatedKeys {
+		keys[name] = key
+	}
+
+	for _, relation := range input.Relations {
+		field, ok := col.Schema().GetField(relation.Name)
+		if !ok {
+			return "", client.NewErrFieldNotExist(relation.Name)
+		}
+		if !field.IsPrimaryRelation() {
+			continue
+		}
+
+		key := relation.Connect
+		if relation.Create != nil {
+			relatedCol, err := p.getRelatedCollection(field)
+			if err != nil {
+				return "", err
+			}
+			key, err = p.createInput(relatedCol, *relation.Create, nil)
+			if err != nil {
+				return "", err
+			}
+		}
+		keys[field.Name+request.RelatedObjectID] = key
+	}
+
+	if len(keys) == 0 {
+		return input.Data, nil
+	}
+
+	data := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(input.Data), &data); err != nil {
+		return "", err
+	}
+	for name, key := range keys {
+		raw, err := json.Marshal(key)
+		if err != nil {
+			return "", err
+		}
+		data[name] = raw
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+// writeSecondaryRelations connects or creates the documents the given input relates to through
+// the secondary side of its relations, by setting the dockey of the document of the given key
+// on them.
+func (p *Planner) writeSecondaryRelations(
+	col client.Collection,
+	docKey string,
+	input request.MutationInput,
+) error {
+	for _, relation := range input.Relations {
+		field, ok := col.Schema().GetField(relation.Name)
+		if !ok {
+			return client.NewErrFieldNotExist(relation.Name)
+		}
+		if field.IsPrimaryRelation() {
+			continue
+		}
+
+		relatedCol, err := p.getRelatedCollection(field)
+		if err != nil {
+			return err
+		}
+		relatedSchema := relatedCol.Schema()
+		relatedField, ok := relatedCol.Description().GetFieldByRelation(
+			field.RelationName,
+			col.Name(),
+			field.Name,
+			&relatedSchema,
+		)
+		if !ok {
+			return ErrUnknownRelationType
+		}
+		relatedKeyName := relatedField.Name + request.RelatedObjectID
+
+		if relation.Create != nil {
+			_, err := p.createInput(relatedCol, *relation.Create, map[string]string{relatedKeyName: docKey})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		key, err := client.NewDocKeyFromString(relation.Connect)
+		if err != nil {
+			return err
+		}
+		patch, err := json.Marshal(map[string]string{relatedKeyName: docKey})
+		if err != nil {
+			return err
+		}
+		if _, err := relatedCol.UpdateWithKey(p.ctx, key, string(patch)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getRelatedCollection returns the collection related to by the given relation field,
+// bound to the transaction of the planner.
+func (p *Planner) getRelatedCollection(field client.FieldDescription) (client.Collection, error) {
+	if !field.IsObject() {
+		return nil, ErrUnknownRelationType
+	}
+	if err := client.CheckPermission(p.ctx, client.WritePermission, field.Schema); err != nil {
+		return nil, err
+	}
+	col, err := p.db.GetCollectionByName(p.ctx, field.Schema)
+	if err != nil {
+		return nil, err
+	}
+	return col.WithTxn(p.txn), nil
+}
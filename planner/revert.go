@@ -0,0 +1,155 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/db/base"
+	"github.com/sourcenetwork/defradb/planner/mapper"
+)
+
+// revertNode is used to construct and execute
+// an object revert mutation.
+//
+// Like create nodes, revert nodes act on a single
+// document and return it once, as it is after the revert.
+type revertNode struct {
+	documentIterator
+	docMapper
+
+	p *Planner
+
+	collection client.Collection
+
+	docKey string
+	cid    string
+
+	returned bool
+	results  planNode
+
+	execInfo revertExecInfo
+}
+
+type revertExecInfo struct {
+	// Total number of times revertNode was executed.
+	iterations uint64
+}
+
+func (n *revertNode) Kind() string { return "revertNode" }
+
+func (n *revertNode) Init() error { return nil }
+
+func (n *revertNode) Start() error { return nil }
+
+// Next only returns once.
+func (n *revertNode) Next() (bool, error) {
+	n.execInfo.iterations++
+
+	if n.returned {
+		return false, nil
+	}
+	n.returned = true
+
+	key, err := client.NewDocKeyFromString(n.docKey)
+	if err != nil {
+		return false, err
+	}
+	err = n.collection.WithTxn(n.p.txn).Revert(n.p.ctx, key, n.cid)
+	if err != nil {
+		return false, err
+	}
+
+	desc := n.collection.Description()
+	docKey := base.MakeDocKey(desc, n.docKey)
+	n.results.Spans(core.NewSpans(core.NewSpan(docKey, docKey.PrefixEnd())))
+
+	err = n.results.Init()
+	if err != nil {
+		return false, err
+	}
+
+	err = n.results.Start()
+	if err != nil {
+		return false, err
+	}
+
+	// get the next result based on our point lookup
+	next, err := n.results.Next()
+	if err != nil {
+		return false, err
+	}
+	if !next {
+		return false, nil
+	}
+
+	n.currentValue = n.results.Value()
+	return true, nil
+}
+
+func (n *revertNode) Spans(spans core.Spans) { /* no-op */ }
+
+func (n *revertNode) Close() error {
+	return n.results.Close()
+}
+
+func (n *revertNode) Source() planNode { return n.results }
+
+func (n *revertNode) simpleExplain() (map[string]any, error) {
+	return map[string]any{
+		idsLabel: []string{n.docKey},
+		cidLabel: n.cid,
+	}, nil
+}
+
+// Explain method returns a map containing all attributes of this node that
+// are to be explained, subscribes / opts-in this node to be an explainablePlanNode.
+func (n *revertNode) Explain(explainType request.ExplainType) (map[string]any, error) {
+	switch explainType {
+	case request.SimpleExplain:
+		return n.simpleExplain()
+
+	case request.ExecuteExplain:
+		return map[string]any{
+			"iterations": n.execInfo.iterations,
+		}, nil
+
+	default:
+		return nil, ErrUnknownExplainRequestType
+	}
+}
+
+func (p *Planner) RevertDoc(parsed *mapper.Mutation) (planNode, error) {
+	col, err := p.db.GetCollectionByName(p.ctx, parsed.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := p.Select(&parsed.Select)
+	if err != nil {
+		return nil, err
+	}
+
+	var docKey string
+	if parsed.DocKeys.HasValue() && len(parsed.DocKeys.Value()) > 0 {
+		docKey = parsed.DocKeys.Value()[0]
+	}
+
+	return &revertNode{
+		p:          p,
+		collection: col,
+		docKey:     docKey,
+		cid:        parsed.Cid,
+		results:    results,
+		docMapper:  docMapper{parsed.DocumentMapping},
+	}, nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/sourcenetwork/defradb/core"
 	"github.com/sourcenetwork/defradb/db/base"
 	"github.com/sourcenetwork/defradb/db/fetcher"
+	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/lens"
 	"github.com/sourcenetwork/defradb/planner/filter"
 	"github.com/sourcenetwork/defradb/planner/mapper"
@@ -51,6 +52,10 @@ type scanNode struct {
 	filter *mapper.Filter
 	slct   *mapper.Select
 
+	// withOwner is true if the owner of each document must be fetched, either because
+	// it was requested or because reads are restricted by the document owner.
+	withOwner bool
+
 	fetcher fetcher.Fetcher
 
 	execInfo scanExecInfo
@@ -138,11 +143,14 @@ func (n *scanNode) tryAddField(fieldName string) bool {
 
 func (scan *scanNode) initFetcher(
 	cid immutable.Option[string],
+	asOf immutable.Option[request.AsOf],
 	indexedField immutable.Option[client.FieldDescription],
 ) {
 	var f fetcher.Fetcher
 	if cid.HasValue() {
 		f = new(fetcher.VersionedFetcher)
+	} else if asOf.HasValue() {
+		f = fetcher.NewAsOfFetcher(asOf.Value())
 	} else {
 		f = new(fetcher.DocumentFetcher)
 
@@ -150,7 +158,13 @@ func (scan *scanNode) initFetcher(
 			typeIndex := scan.documentMapping.FirstIndexOfName(indexedField.Value().Name)
 			field := mapper.Field{Index: typeIndex, Name: indexedField.Value().Name}
 			var indexFilter *mapper.Filter
-			scan.filter, indexFilter = filter.SplitByField(scan.filter, field)
+			if indexedField.Value().Kind == client.FieldKind_GEOPOINT {
+				// Geo indexes only narrow the documents down to the cells covering the area of
+				// the condition, which is kept to match their points exactly.
+				indexFilter = filter.CopyField(scan.filter, field)
+			} else {
+				scan.filter, indexFilter = filter.SplitByField(scan.filter, field)
+			}
 			if indexFilter != nil {
 				fieldDesc, _ := scan.col.Schema().GetField(indexedField.Value().Name)
 				f = fetcher.NewIndexFetcher(f, fieldDesc, indexFilter)
@@ -192,28 +206,49 @@ func (n *scanNode) Next() (bool, error) {
 		return false, nil
 	}
 
-	doc, execInfo, err := n.fetcher.FetchNext(n.p.ctx)
-	if err != nil {
-		return false, err
-	}
-	n.execInfo.fetches.Add(execInfo)
+	for {
+		doc, execInfo, err := n.fetcher.FetchNext(n.p.ctx)
+		if err != nil {
+			return false, err
+		}
+		n.execInfo.fetches.Add(execInfo)
 
-	if doc == nil {
-		return false, nil
-	}
+		if doc == nil {
+			return false, nil
+		}
 
-	n.currentValue, err = fetcher.DecodeToDoc(doc, n.documentMapping, false)
-	if err != nil {
-		return false, err
-	}
+		n.currentValue, err = fetcher.DecodeToDoc(doc, n.documentMapping, false)
+		if err != nil {
+			return false, err
+		}
 
-	n.documentMapping.SetFirstOfName(
-		&n.currentValue,
-		request.DeletedFieldName,
-		n.currentValue.Status.IsDeleted(),
-	)
+		n.documentMapping.SetFirstOfName(
+			&n.currentValue,
+			request.DeletedFieldName,
+			n.currentValue.Status.IsDeleted(),
+		)
 
-	return true, nil
+		if !n.withOwner {
+			return true, nil
+		}
+
+		owner, err := n.p.getDocumentOwner(n.col.ID(), n.currentValue.GetKey())
+		if err != nil {
+			return false, err
+		}
+		err = client.CheckDocumentPermission(n.p.ctx, client.ReadPermission, n.col.Name(), owner)
+		if errors.Is(err, client.ErrPermissionDenied) {
+			// Documents that can not be read by the caller are skipped.
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		if owner != "" {
+			n.documentMapping.SetFirstOfName(&n.currentValue, request.OwnerFieldName, owner)
+		}
+		return true, nil
+	}
 }
 
 func (n *scanNode) Spans(spans core.Spans) {
@@ -298,10 +333,18 @@ func (p *Planner) Scan(
 		docMapper: docMapper{mapperSelect.DocumentMapping},
 	}
 
+	err := client.CheckPermission(p.ctx, client.ReadPermission, mapperSelect.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+
 	col, err := p.db.GetCollectionByName(p.ctx, mapperSelect.CollectionName)
 	if err != nil {
 		return nil, err
 	}
+	scan.withOwner = client.HasDocumentPolicy(p.ctx, col.Name()) ||
+		hasField(mapperSelect, request.OwnerFieldName)
+
 	err = scan.initCollection(col)
 	if err != nil {
 		return nil, err
@@ -379,3 +422,19 @@ func (n *multiScanNode) DocumentMap() *core.DocumentMapping {
 func (n *multiScanNode) addReader() {
 	n.numReaders++
 }
+
+// getDocumentOwner returns the identity of the owner of the given document, or an empty
+// string if the document has no owner.
+func (p *Planner) getDocumentOwner(collectionID uint32, docKey string) (string, error) {
+	return base.GetDocumentOwner(p.ctx, p.txn, collectionID, docKey)
+}
+
+// hasField returns true if the given select requests a field with the given name.
+func hasField(slct *mapper.Select, name string) bool {
+	for _, field := range slct.Fields {
+		if field.GetName() == name {
+			return true
+		}
+	}
+	return false
+}
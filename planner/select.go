@@ -290,7 +290,7 @@ func (n *selectNode) initSource() ([]aggregateNode, error) {
 	}
 
 	if isScanNode {
-		origScan.initFetcher(n.selectReq.Cid, findFilteredByIndexedField(origScan))
+		origScan.initFetcher(n.selectReq.Cid, n.selectReq.AsOf, findFilteredByIndexedField(origScan))
 	}
 
 	return aggregates, nil
@@ -303,6 +303,14 @@ func findFilteredByIndexedField(scanNode *scanNode) immutable.Option[client.Fiel
 		for i := range indexedFields {
 			typeIndex := scanNode.documentMapping.FirstIndexOfName(indexedFields[i].Name)
 			if scanNode.filter.HasIndex(typeIndex) {
+				if indexedFields[i].Kind == client.FieldKind_JSON &&
+					!hasJSONPathIndex(scanNode, indexedFields[i], typeIndex) {
+					continue
+				}
+				if indexedFields[i].Kind == client.FieldKind_GEOPOINT &&
+					!hasGeoBounds(scanNode, typeIndex) {
+					continue
+				}
 				// we return the first found indexed field to keep it simple for now
 				// more sophisticated optimization logic can be added later
 				return immutable.Some(indexedFields[i])
@@ -312,6 +320,30 @@ func findFilteredByIndexedField(scanNode *scanNode) immutable.Option[client.Fiel
 	return immutable.None[client.FieldDescription]()
 }
 
+// hasJSONPathIndex returns true if a JSON path index of the collection resolves the filter
+// condition on the given JSON field.
+func hasJSONPathIndex(scanNode *scanNode, field client.FieldDescription, typeIndex int) bool {
+	for key, condition := range scanNode.filter.Conditions {
+		if propIndex, isOk := key.(*mapper.PropertyIndex); isOk && propIndex.Index == typeIndex {
+			_, found := fetcher.FindJSONPathIndex(scanNode.col, field, condition)
+			return found
+		}
+	}
+	return false
+}
+
+// hasGeoBounds returns true if the filter condition on the given GeoPoint field restricts its
+// points to a bounded area, which a geo index resolves.
+func hasGeoBounds(scanNode *scanNode, typeIndex int) bool {
+	for key, condition := range scanNode.filter.Conditions {
+		if propIndex, isOk := key.(*mapper.PropertyIndex); isOk && propIndex.Index == typeIndex {
+			_, found := fetcher.FindGeoBounds(condition)
+			return found
+		}
+	}
+	return false
+}
+
 func (n *selectNode) initFields(selectReq *mapper.Select) ([]aggregateNode, error) {
 	aggregates := []aggregateNode{}
 	// loop over the sub type
@@ -361,6 +393,12 @@ func (n *selectNode) initFields(selectReq *mapper.Select) ([]aggregateNode, erro
 				if err := n.addSubPlan(f.Index, commitPlan); err != nil {
 					return nil, err
 				}
+			} else if f.Name == request.DiffFieldName {
+				diffPlan := n.planner.Diff(f, n.collection)
+
+				if err := n.addSubPlan(f.Index, diffPlan); err != nil {
+					return nil, err
+				}
 			} else if f.Name == request.GroupFieldName {
 				if selectReq.GroupBy == nil {
 					return nil, ErrGroupOutsideOfGroupBy
@@ -11,6 +11,8 @@
 package planner
 
 import (
+	"math/big"
+
 	"github.com/sourcenetwork/immutable"
 	"github.com/sourcenetwork/immutable/enumerable"
 
@@ -27,7 +29,7 @@ type sumNode struct {
 	p    *Planner
 	plan planNode
 
-	isFloat           bool
+	kind              sumKind
 	virtualFieldIndex int
 	aggregateMapping  []mapper.AggregateTarget
 
@@ -39,67 +41,103 @@ type sumExecInfo struct {
 	iterations uint64
 }
 
+// sumKind is the kind of the value of a sum, given by the kinds of the summed values.
+type sumKind uint8
+
+const (
+	sumKindInt sumKind = iota
+	sumKindFloat
+	sumKindBigInt
+	sumKindDecimal
+)
+
+// sumKindOf returns the kind of the sum of the values of the given field kind.
+func sumKindOf(kind client.FieldKind) sumKind {
+	switch kind {
+	case client.FieldKind_FLOAT, client.FieldKind_FLOAT_ARRAY, client.FieldKind_NILLABLE_FLOAT_ARRAY:
+		return sumKindFloat
+	case client.FieldKind_BIGINT:
+		return sumKindBigInt
+	case client.FieldKind_DECIMAL:
+		return sumKindDecimal
+	default:
+		return sumKindInt
+	}
+}
+
+// with returns the kind of the sum of values of both kinds, floats summed with big integers
+// are summed as decimals so that no precision is lost.
+func (k sumKind) with(other sumKind) sumKind {
+	if (k == sumKindFloat && other == sumKindBigInt) || (k == sumKindBigInt && other == sumKindFloat) {
+		return sumKindDecimal
+	}
+	if other > k {
+		return other
+	}
+	return k
+}
+
+// average returns the kind of the average of values of the kind.
+//
+// It is important that averages are floats even if their underlying values are ints
+// else sum will round them down to the nearest whole number, averages of exact numbers
+// are decimals.
+func (k sumKind) average() sumKind {
+	if k == sumKindBigInt || k == sumKindDecimal {
+		return sumKindDecimal
+	}
+	return sumKindFloat
+}
+
 func (p *Planner) Sum(
 	field *mapper.Aggregate,
 	parent *mapper.Select,
 ) (*sumNode, error) {
-	isFloat := false
+	kind := sumKindInt
 	for _, target := range field.AggregateTargets {
-		isTargetFloat, err := p.isValueFloat(parent, &target)
+		targetKind, err := p.getSumKind(parent, &target)
 		if err != nil {
 			return nil, err
 		}
-		// If one source property is a float, the result will be a float - no need to check the rest
-		if isTargetFloat {
-			isFloat = true
-			break
-		}
+		kind = kind.with(targetKind)
 	}
 
 	return &sumNode{
 		p:                 p,
-		isFloat:           isFloat,
+		kind:              kind,
 		aggregateMapping:  field.AggregateTargets,
 		virtualFieldIndex: field.Index,
 		docMapper:         docMapper{field.DocumentMapping},
 	}, nil
 }
 
-// Returns true if the value to be summed is a float, otherwise false.
-func (p *Planner) isValueFloat(
+// Returns the kind of the sum of the values to be summed.
+func (p *Planner) getSumKind(
 	parent *mapper.Select,
 	source *mapper.AggregateTarget,
-) (bool, error) {
-	// It is important that averages are floats even if their underlying values are ints
-	// else sum will round them down to the nearest whole number
-	if source.ChildTarget.Name == request.AverageFieldName {
-		return true, nil
-	}
-
+) (sumKind, error) {
 	if !source.ChildTarget.HasValue {
 		parentCol, err := p.db.GetCollectionByName(p.ctx, parent.CollectionName)
 		if err != nil {
-			return false, err
+			return sumKindInt, err
 		}
 
 		fieldDescription, fieldDescriptionFound := parentCol.Schema().GetField(source.Name)
 		if !fieldDescriptionFound {
-			return false, client.NewErrFieldNotExist(source.Name)
+			return sumKindInt, client.NewErrFieldNotExist(source.Name)
 		}
-		return fieldDescription.Kind == client.FieldKind_FLOAT_ARRAY ||
-			fieldDescription.Kind == client.FieldKind_FLOAT ||
-			fieldDescription.Kind == client.FieldKind_NILLABLE_FLOAT_ARRAY, nil
+		return sumKindOf(fieldDescription.Kind), nil
 	}
 
 	// If path length is two, we are summing a group or a child relationship
 	if source.ChildTarget.Name == request.CountFieldName {
-		// If we are summing a count, we know it is an int and can return false early
-		return false, nil
+		// If we are summing a count, we know it is an int and can return early
+		return sumKindInt, nil
 	}
 
 	child, isChildSelect := parent.FieldAt(source.Index).AsSelect()
 	if !isChildSelect {
-		return false, ErrMissingChildSelect
+		return sumKindInt, ErrMissingChildSelect
 	}
 
 	if _, isAggregate := request.Aggregates[source.ChildTarget.Name]; isAggregate {
@@ -108,36 +146,34 @@ func (p *Planner) isValueFloat(
 		// of N-depth aggregations (e.g. sum of sum of sum of...)
 		sourceField := child.FieldAt(source.ChildTarget.Index).(*mapper.Aggregate)
 
+		kind := sumKindInt
 		for _, aggregateTarget := range sourceField.AggregateTargets {
-			isFloat, err := p.isValueFloat(
+			targetKind, err := p.getSumKind(
 				child,
 				&aggregateTarget,
 			)
 			if err != nil {
-				return false, err
-			}
-
-			// If one source property is a float, the result will be a float - no need to check the rest
-			if isFloat {
-				return true, nil
+				return sumKindInt, err
 			}
+			kind = kind.with(targetKind)
 		}
-		return false, nil
+		if source.ChildTarget.Name == request.AverageFieldName {
+			return kind.average(), nil
+		}
+		return kind, nil
 	}
 
 	childCol, err := p.db.GetCollectionByName(p.ctx, child.CollectionName)
 	if err != nil {
-		return false, err
+		return sumKindInt, err
 	}
 
 	fieldDescription, fieldDescriptionFound := childCol.Schema().GetField(source.ChildTarget.Name)
 	if !fieldDescriptionFound {
-		return false, client.NewErrFieldNotExist(source.ChildTarget.Name)
+		return sumKindInt, client.NewErrFieldNotExist(source.ChildTarget.Name)
 	}
 
-	return fieldDescription.Kind == client.FieldKind_FLOAT_ARRAY ||
-		fieldDescription.Kind == client.FieldKind_FLOAT ||
-		fieldDescription.Kind == client.FieldKind_NILLABLE_FLOAT_ARRAY, nil
+	return sumKindOf(fieldDescription.Kind), nil
 }
 
 func (n *sumNode) Kind() string {
@@ -224,6 +260,8 @@ func (n *sumNode) Next() (bool, error) {
 	n.currentValue = n.plan.Value()
 
 	sum := float64(0)
+	// exactSum holds the sum of the numbers of arbitrary precision
+	exactSum := new(big.Rat)
 
 	for _, source := range n.aggregateMapping {
 		child := n.currentValue.Fields[source.Index]
@@ -237,11 +275,21 @@ func (n *sumNode) Next() (bool, error) {
 				case int:
 					return float64(v)
 				case int64:
+					if n.kind == sumKindBigInt || n.kind == sumKindDecimal {
+						exactSum.Add(exactSum, new(big.Rat).SetInt64(v))
+						return 0
+					}
 					return float64(v)
 				case uint64:
 					return float64(v)
 				case float64:
 					return v
+				case *big.Int:
+					exactSum.Add(exactSum, new(big.Rat).SetInt(v))
+					return 0
+				case client.Decimal:
+					exactSum.Add(exactSum, v.Rat())
+					return 0
 				default:
 					// return nothing, cannot be summed
 					return 0
@@ -300,9 +348,21 @@ func (n *sumNode) Next() (bool, error) {
 	}
 
 	var typedSum any
-	if n.isFloat {
-		typedSum = sum
-	} else {
+	switch n.kind {
+	case sumKindDecimal:
+		// Floats are added from the shortest decimal representation that rounds to them.
+		floatSum, err := client.NewDecimalFromValue(sum)
+		if err != nil {
+			return false, err
+		}
+		typedSum = client.NewDecimalFromRat(exactSum.Add(exactSum, floatSum.Rat()))
+	case sumKindBigInt:
+		bigSum := new(big.Int).Quo(exactSum.Num(), exactSum.Denom())
+		typedSum = bigSum.Add(bigSum, big.NewInt(int64(sum)))
+	case sumKindFloat:
+		exactFloat, _ := exactSum.Float64()
+		typedSum = sum + exactFloat
+	default:
 		typedSum = int64(sum)
 	}
 	n.currentValue.Fields[n.virtualFieldIndex] = typedSum
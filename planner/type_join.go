@@ -611,7 +611,7 @@ func (join *invertibleTypeJoin) invertJoinDirectionWithIndex(
 	subScan := getScanNode(join.subType)
 	subScan.tryAddField(join.rootName + request.RelatedObjectID)
 	subScan.filter = fieldFilter
-	subScan.initFetcher(immutable.Option[string]{}, immutable.Some(field))
+	subScan.initFetcher(immutable.Option[string]{}, immutable.None[request.AsOf](), immutable.Some(field))
 
 	join.invert()
 
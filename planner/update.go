@@ -31,6 +31,8 @@ type updateNode struct {
 	ids    []string
 
 	patch string
+	// input is the update given as a typed input object, if any
+	input *request.MutationInput
 
 	isUpdating bool
 
@@ -52,6 +54,9 @@ func (n *updateNode) Next() (bool, error) {
 	n.execInfo.iterations++
 
 	if n.isUpdating {
+		// the documents related to by the input are only written once a
+		// document to update has been found
+		hasPatch := n.input == nil
 		for {
 			next, err := n.results.Next()
 			if err != nil {
@@ -66,10 +71,23 @@ func (n *updateNode) Next() (bool, error) {
 			if err != nil {
 				return false, err
 			}
+			if !hasPatch {
+				n.patch, err = n.p.writePrimaryRelations(n.collection, *n.input, nil)
+				if err != nil {
+					return false, err
+				}
+				hasPatch = true
+			}
 			_, err = n.collection.UpdateWithKey(n.p.ctx, key, n.patch)
 			if err != nil {
 				return false, err
 			}
+			if n.input != nil {
+				err = n.p.writeSecondaryRelations(n.collection, key.String(), *n.input)
+				if err != nil {
+					return false, err
+				}
+			}
 
 			n.execInfo.updates++
 		}
@@ -162,6 +180,10 @@ func (p *Planner) UpdateDocs(parsed *mapper.Mutation) (planNode, error) {
 		patch:      parsed.Data,
 		docMapper:  docMapper{parsed.DocumentMapping},
 	}
+	if len(parsed.Input) > 0 {
+		update.input = &parsed.Input[0]
+		update.patch = parsed.Input[0].Data
+	}
 
 	// get collection
 	col, err := p.db.GetCollectionByName(p.ctx, parsed.Name)
@@ -0,0 +1,199 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"encoding/json"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/db/base"
+	"github.com/sourcenetwork/defradb/planner/mapper"
+)
+
+// upsertNode is used to construct and execute
+// an object upsert mutation.
+//
+// The write is done by the collection, which updates the single document
+// matching the filter, or creates the new document if there is none. Like
+// create nodes, the document is then returned once, as it is after the write.
+type upsertNode struct {
+	documentIterator
+	docMapper
+
+	p *Planner
+
+	collection client.Collection
+
+	filter *mapper.Filter
+	// requestFilter is the filter given to the collection to find the matching document.
+	requestFilter immutable.Option[request.Filter]
+
+	// newDocStr is the JSON string of the document to create, unparsed
+	newDocStr string
+	patch     string
+
+	returned bool
+	results  planNode
+
+	execInfo upsertExecInfo
+}
+
+type upsertExecInfo struct {
+	// Total number of times upsertNode was executed.
+	iterations uint64
+
+	// Total number of documents created.
+	creates uint64
+
+	// Total number of documents updated.
+	updates uint64
+}
+
+func (n *upsertNode) Kind() string { return "upsertNode" }
+
+func (n *upsertNode) Init() error { return nil }
+
+func (n *upsertNode) Start() error { return nil }
+
+// Next only returns once.
+func (n *upsertNode) Next() (bool, error) {
+	n.execInfo.iterations++
+
+	if n.returned {
+		return false, nil
+	}
+	n.returned = true
+
+	doc, err := client.NewDocFromJSON([]byte(n.newDocStr))
+	if err != nil {
+		return false, err
+	}
+	res, err := n.collection.Upsert(n.p.ctx, n.requestFilter, doc, n.patch)
+	if err != nil {
+		return false, err
+	}
+	if res.Created {
+		n.execInfo.creates++
+	} else {
+		n.execInfo.updates++
+	}
+
+	desc := n.collection.Description()
+	docKey := base.MakeDocKey(desc, res.DocKey)
+	n.results.Spans(core.NewSpans(core.NewSpan(docKey, docKey.PrefixEnd())))
+
+	err = n.results.Init()
+	if err != nil {
+		return false, err
+	}
+
+	err = n.results.Start()
+	if err != nil {
+		return false, err
+	}
+
+	// get the next result based on our point lookup
+	next, err := n.results.Next()
+	if err != nil {
+		return false, err
+	}
+	if !next {
+		return false, nil
+	}
+
+	n.currentValue = n.results.Value()
+	return true, nil
+}
+
+func (n *upsertNode) Spans(spans core.Spans) { /* no-op */ }
+
+func (n *upsertNode) Close() error {
+	return n.results.Close()
+}
+
+func (n *upsertNode) Source() planNode { return n.results }
+
+func (n *upsertNode) simpleExplain() (map[string]any, error) {
+	simpleExplainMap := map[string]any{}
+
+	// Add the filter attribute if it exists, otherwise have it nil.
+	if n.filter == nil {
+		simpleExplainMap[filterLabel] = nil
+	} else {
+		simpleExplainMap[filterLabel] = n.filter.ToMap(n.documentMapping)
+	}
+
+	data := map[string]any{}
+	err := json.Unmarshal([]byte(n.newDocStr), &data)
+	if err != nil {
+		return nil, err
+	}
+	simpleExplainMap[dataLabel] = data
+
+	patch := map[string]any{}
+	err = json.Unmarshal([]byte(n.patch), &patch)
+	if err != nil {
+		return nil, err
+	}
+	simpleExplainMap[updateLabel] = patch
+
+	return simpleExplainMap, nil
+}
+
+// Explain method returns a map containing all attributes of this node that
+// are to be explained, subscribes / opts-in this node to be an explainablePlanNode.
+func (n *upsertNode) Explain(explainType request.ExplainType) (map[string]any, error) {
+	switch explainType {
+	case request.SimpleExplain:
+		return n.simpleExplain()
+
+	case request.ExecuteExplain:
+		return map[string]any{
+			"iterations": n.execInfo.iterations,
+			"creates":    n.execInfo.creates,
+			"updates":    n.execInfo.updates,
+		}, nil
+
+	default:
+		return nil, ErrUnknownExplainRequestType
+	}
+}
+
+func (p *Planner) UpsertDoc(parsed *mapper.Mutation) (planNode, error) {
+	col, err := p.db.GetCollectionByName(p.ctx, parsed.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// The results are a point lookup of the written document, which may
+	// no longer match the filter after the update.
+	resultsSelect := parsed.Select.CloneTo(parsed.Index).(*mapper.Select)
+	resultsSelect.Filter = nil
+	results, err := p.Select(resultsSelect)
+	if err != nil {
+		return nil, err
+	}
+
+	return &upsertNode{
+		p:             p,
+		collection:    col.WithTxn(p.txn),
+		filter:        parsed.Filter,
+		requestFilter: parsed.RequestFilter,
+		newDocStr:     parsed.Data,
+		patch:         parsed.Update,
+		results:       results,
+		docMapper:     docMapper{parsed.DocumentMapping},
+	}, nil
+}
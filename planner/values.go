@@ -13,6 +13,7 @@ package planner
 import (
 	"sort"
 
+	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/core"
 	"github.com/sourcenetwork/defradb/db/base"
 	"github.com/sourcenetwork/defradb/db/container"
@@ -95,8 +96,8 @@ func (n *valuesNode) Less(i, j int) bool {
 func (n *valuesNode) docValueLess(docA, docB core.Doc) bool {
 	for _, order := range n.ordering {
 		compare := base.Compare(
-			getDocProp(docA, order.FieldIndexes),
-			getDocProp(docB, order.FieldIndexes),
+			getOrderValue(docA, order),
+			getOrderValue(docB, order),
 		)
 
 		if order.Direction == mapper.DESC {
@@ -130,6 +131,33 @@ func (n *valuesNode) Len() int {
 	return n.docs.Len()
 }
 
+// getOrderValue returns the value of the given document to sort by, enum values are
+// replaced by their declaration index, and geo points by their distance from the point
+// of the order.
+func getOrderValue(obj core.Doc, order mapper.OrderCondition) any {
+	value := getDocProp(obj, order.FieldIndexes)
+	if order.DistanceFrom.HasValue() {
+		point, ok := value.(client.GeoPoint)
+		if !ok {
+			return value
+		}
+		return order.DistanceFrom.Value().Distance(point)
+	}
+	if order.EnumValues == nil || value == nil {
+		return value
+	}
+	s, ok := value.(string)
+	if !ok {
+		return value
+	}
+	for i, enumValue := range order.EnumValues {
+		if enumValue == s {
+			return int64(i)
+		}
+	}
+	return int64(len(order.EnumValues))
+}
+
 // getMapProp is a utility to easily get a specific
 // property from a map object. The map may have further nested maps
 // that need to be accessed.
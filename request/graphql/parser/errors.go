@@ -15,13 +15,21 @@ import "github.com/sourcenetwork/defradb/errors"
 var (
 	ErrFilterMissingArgumentType      = errors.New("couldn't find filter argument type")
 	ErrInvalidOrderDirection          = errors.New("invalid order direction string")
+	ErrInvalidDistanceOrder           = errors.New("invalid order by distance, expected a geo point")
 	ErrFailedToParseConditionsFromAST = errors.New("couldn't parse conditions value from AST")
 	ErrFailedToParseConditionValue    = errors.New("failed to parse condition value from query filter statement")
 	ErrEmptyDataPayload               = errors.New("given data payload is empty")
+	ErrInputMissingArgumentType       = errors.New("couldn't find input argument type")
+	ErrDataWithInput                  = errors.New("data can not be used alongside input")
+	ErrInvalidRelationInput           = errors.New("relation input requires exactly one of connect or create")
 	ErrUnknownMutationName            = errors.New("unknown mutation name")
 	ErrInvalidExplainTypeArg          = errors.New("invalid explain request type argument")
 	ErrInvalidNumberOfExplainArgs     = errors.New("invalid number of arguments to an explain request")
 	ErrUnknownExplainType             = errors.New("invalid / unknown explain type")
 	ErrUnknownGQLOperation            = errors.New("unknown GraphQL operation type")
 	ErrInvalidFilterConditions        = errors.New("invalid filter condition type, expected map")
+	ErrInvalidAsOf                    = errors.New("asOf requires exactly one of height or time")
+	ErrAsOfWithCid                    = errors.New("asOf can not be used alongside cid")
+	ErrInvalidAsOfHeight              = errors.New("invalid asOf height, expected an integer")
+	ErrInvalidAsOfTime                = errors.New("invalid asOf time, expected an RFC3339 string")
 )
@@ -92,6 +92,18 @@ func parseConditionsInOrder(stmt *ast.ObjectValue) (any, error) {
 	}
 	for _, field := range stmt.Fields {
 		name := field.Name.Value
+		if obj, isObject := field.Value.(*ast.ObjectValue); isObject {
+			cond, isDistance, err := parseDistanceOrder(obj)
+			if err != nil {
+				return nil, err
+			}
+			if isDistance {
+				cond.Fields = []string{name}
+				conditions = append(conditions, cond)
+				continue
+			}
+		}
+
 		val, err := parseVal(field.Value, parseConditionsInOrder)
 		if err != nil {
 			return nil, err
@@ -127,6 +139,52 @@ func parseConditionsInOrder(stmt *ast.ObjectValue) (any, error) {
 	return conditions, nil
 }
 
+// parseDistanceOrder parses the order by distance of a GeoPoint field, and returns true, or
+// false if the given object does not order by distance.
+//
+// Eg. order: {location: {_distanceFrom: {latitude: 48.85, longitude: 2.35}, _direction: DESC}}
+func parseDistanceOrder(stmt *ast.ObjectValue) (request.OrderCondition, bool, error) {
+	cond := request.OrderCondition{Direction: request.ASC}
+	isDistance := false
+	for _, field := range stmt.Fields {
+		switch field.Name.Value {
+		case request.OrderDistanceFrom:
+			obj, ok := field.Value.(*ast.ObjectValue)
+			if !ok {
+				return cond, false, ErrInvalidDistanceOrder
+			}
+			var point [2]float64
+			for _, coordinate := range obj.Fields {
+				val, err := parseVal(coordinate.Value, parseConditionsInOrder)
+				if err != nil {
+					return cond, false, err
+				}
+				f, ok := client.GeoCoordinate(val)
+				if !ok {
+					return cond, false, ErrInvalidDistanceOrder
+				}
+				switch coordinate.Name.Value {
+				case client.GeoPointLatitude:
+					point[0] = f
+				case client.GeoPointLongitude:
+					point[1] = f
+				}
+			}
+			cond.DistanceFrom = immutable.Some(point)
+			isDistance = true
+
+		case request.OrderDirectionArg:
+			name, _ := field.Value.GetValue().(string)
+			dir, ok := request.NameToOrderDirection[name]
+			if !ok {
+				return cond, false, ErrInvalidOrderDirection
+			}
+			cond.Direction = dir
+		}
+	}
+	return cond, isDistance, nil
+}
+
 // parseConditions loops over the stmt ObjectValue fields, and extracts
 // all the relevant name/value pairs.
 func ParseConditions(stmt *ast.ObjectValue, inputType gql.Input) (map[string]any, error) {
@@ -11,6 +11,8 @@
 package parser
 
 import (
+	"encoding/json"
+	"strconv"
 	"strings"
 
 	gql "github.com/sourcenetwork/graphql-go"
@@ -26,6 +28,8 @@ var (
 		"create": request.CreateObjects,
 		"update": request.UpdateObjects,
 		"delete": request.DeleteObjects,
+		"revert": request.RevertObjects,
+		"upsert": request.UpsertObjects,
 	}
 )
 
@@ -99,12 +103,28 @@ func parseMutation(schema gql.Schema, parent *gql.Object, field *ast.Field) (*re
 	for _, argument := range field.Arguments {
 		prop := argument.Name.Value
 		// parse each individual arg type seperately
-		if prop == request.Data { // parse data
+		if prop == request.Data || prop == request.Create { // parse data
+			data, err := parseMutationData(argument.Value)
+			if err != nil {
+				return nil, err
+			}
+			mut.Data = data
+		} else if prop == request.Input {
+			inputType, ok := getArgumentType(fieldDef, request.Input)
+			if !ok {
+				return nil, ErrInputMissingArgumentType
+			}
+			input, err := parseMutationInputs(argument.Value, inputType)
+			if err != nil {
+				return nil, err
+			}
+			mut.Input = input
+		} else if prop == request.Update {
 			raw := argument.Value.(*ast.StringValue)
 			if raw.Value == "" {
 				return nil, ErrEmptyDataPayload
 			}
-			mut.Data = raw.Value
+			mut.Update = raw.Value
 		} else if prop == request.FilterClause { // parse filter
 			obj := argument.Value.(*ast.ObjectValue)
 			filterType, ok := getArgumentType(fieldDef, request.FilterClause)
@@ -131,9 +151,16 @@ func parseMutation(schema gql.Schema, parent *gql.Object, field *ast.Field) (*re
 				ids[i] = id.Value
 			}
 			mut.IDs = immutable.Some(ids)
+		} else if prop == request.Cid {
+			raw := argument.Value.(*ast.StringValue)
+			mut.Cid = raw.Value
 		}
 	}
 
+	if mut.Data != "" && len(mut.Input) > 0 {
+		return nil, ErrDataWithInput
+	}
+
 	// if theres no field selections, just return
 	if field.SelectionSet == nil {
 		return mut, nil
@@ -147,3 +174,204 @@ func parseMutation(schema gql.Schema, parent *gql.Object, field *ast.Field) (*re
 	mut.Fields, err = parseSelectFields(schema, request.ObjectSelection, fieldObject, field.SelectionSet)
 	return mut, err
 }
+
+// parseMutationData parses the data argument of a mutation, which is either a single
+// JSON document string, or a list of them. A list is returned as a single JSON array.
+func parseMutationData(value ast.Value) (string, error) {
+	switch raw := value.(type) {
+	case *ast.StringValue:
+		if raw.Value == "" {
+			return "", ErrEmptyDataPayload
+		}
+		return raw.Value, nil
+
+	case *ast.ListValue:
+		if len(raw.Values) == 0 {
+			return "", ErrEmptyDataPayload
+		}
+		docs := make([]string, len(raw.Values))
+		for i, val := range raw.Values {
+			doc, ok := val.(*ast.StringValue)
+			if !ok {
+				return "", client.NewErrUnexpectedType[*ast.StringValue]("data argument", val)
+			}
+			if doc.Value == "" {
+				return "", ErrEmptyDataPayload
+			}
+			docs[i] = doc.Value
+		}
+		return "[" + strings.Join(docs, ",") + "]", nil
+
+	default:
+		return "", client.NewErrUnexpectedType[*ast.StringValue]("data argument", value)
+	}
+}
+
+// parseMutationInputs parses the input argument of a mutation, which is either a single
+// typed input object, or a list of them.
+func parseMutationInputs(value ast.Value, inputType gql.Input) ([]request.MutationInput, error) {
+	if list, ok := inputType.(*gql.List); ok {
+		inputType = list.OfType
+	}
+	inputObject, ok := inputType.(*gql.InputObject)
+	if !ok {
+		return nil, ErrInputMissingArgumentType
+	}
+
+	values := []ast.Value{value}
+	if list, ok := value.(*ast.ListValue); ok {
+		values = list.Values
+	}
+	if len(values) == 0 {
+		return nil, ErrEmptyDataPayload
+	}
+
+	inputs := make([]request.MutationInput, len(values))
+	for i, val := range values {
+		obj, ok := val.(*ast.ObjectValue)
+		if !ok {
+			return nil, client.NewErrUnexpectedType[*ast.ObjectValue]("input argument", val)
+		}
+		input, err := parseMutationInput(obj, inputObject)
+		if err != nil {
+			return nil, err
+		}
+		inputs[i] = input
+	}
+	return inputs, nil
+}
+
+// parseMutationInput parses a typed input object into the json representation of its
+// non-relation fields, and its relations.
+func parseMutationInput(obj *ast.ObjectValue, inputObject *gql.InputObject) (request.MutationInput, error) {
+	input := request.MutationInput{}
+	inputFields := inputObject.Fields()
+
+	data := []string{}
+	for _, field := range obj.Fields {
+		name := field.Name.Value
+		inputField, ok := inputFields[name]
+		if !ok {
+			return request.MutationInput{}, client.NewErrFieldNotExist(name)
+		}
+
+		relationType, isRelation := gql.GetNamed(inputField.Type).(*gql.InputObject)
+		if !isRelation {
+			value, err := valueToJSON(field.Value)
+			if err != nil {
+				return request.MutationInput{}, err
+			}
+			key, err := json.Marshal(name)
+			if err != nil {
+				return request.MutationInput{}, err
+			}
+			data = append(data, string(key)+":"+value)
+			continue
+		}
+
+		values := []ast.Value{field.Value}
+		if list, ok := field.Value.(*ast.ListValue); ok {
+			values = list.Values
+		}
+		for _, val := range values {
+			relation, err := parseRelationInput(name, val, relationType)
+			if err != nil {
+				return request.MutationInput{}, err
+			}
+			input.Relations = append(input.Relations, relation)
+		}
+	}
+
+	input.Data = "{" + strings.Join(data, ",") + "}"
+	return input, nil
+}
+
+// parseRelationInput parses a create-or-connect input object of the given relation field.
+func parseRelationInput(
+	name string,
+	value ast.Value,
+	relationType *gql.InputObject,
+) (request.RelationInput, error) {
+	obj, ok := value.(*ast.ObjectValue)
+	if !ok {
+		return request.RelationInput{}, client.NewErrUnexpectedType[*ast.ObjectValue]("relation input", value)
+	}
+
+	relation := request.RelationInput{Name: name}
+	connect, hasConnect := tryGet(obj.Fields, request.Connect)
+	create, hasCreate := tryGet(obj.Fields, request.Create)
+	if hasConnect == hasCreate {
+		return request.RelationInput{}, ErrInvalidRelationInput
+	}
+
+	if hasConnect {
+		raw, ok := connect.Value.(*ast.StringValue)
+		if !ok {
+			return request.RelationInput{}, client.NewErrUnexpectedType[*ast.StringValue]("connect", connect.Value)
+		}
+		relation.Connect = raw.Value
+		return relation, nil
+	}
+
+	createObj, ok := create.Value.(*ast.ObjectValue)
+	if !ok {
+		return request.RelationInput{}, client.NewErrUnexpectedType[*ast.ObjectValue]("create", create.Value)
+	}
+	createType, ok := relationType.Fields()[request.Create].Type.(*gql.InputObject)
+	if !ok {
+		return request.RelationInput{}, ErrInputMissingArgumentType
+	}
+	createInput, err := parseMutationInput(createObj, createType)
+	if err != nil {
+		return request.RelationInput{}, err
+	}
+	relation.Create = &createInput
+	return relation, nil
+}
+
+// valueToJSON returns the json representation of the given ast value. Numbers keep their
+// exact text, and enum values are given by their name.
+func valueToJSON(value ast.Value) (string, error) {
+	switch value := value.(type) {
+	case *ast.StringValue:
+		raw, err := json.Marshal(value.Value)
+		return string(raw), err
+	case *ast.EnumValue:
+		raw, err := json.Marshal(value.Value)
+		return string(raw), err
+	case *ast.IntValue:
+		return value.Value, nil
+	case *ast.FloatValue:
+		return value.Value, nil
+	case *ast.BooleanValue:
+		return strconv.FormatBool(value.Value), nil
+	case *ast.NullValue:
+		return "null", nil
+	case *ast.ListValue:
+		values := make([]string, len(value.Values))
+		for i, val := range value.Values {
+			raw, err := valueToJSON(val)
+			if err != nil {
+				return "", err
+			}
+			values[i] = raw
+		}
+		return "[" + strings.Join(values, ",") + "]", nil
+	case *ast.ObjectValue:
+		fields := make([]string, len(value.Fields))
+		for i, field := range value.Fields {
+			key, err := json.Marshal(field.Name.Value)
+			if err != nil {
+				return "", err
+			}
+			raw, err := valueToJSON(field.Value)
+			if err != nil {
+				return "", err
+			}
+			fields[i] = string(key) + ":" + raw
+		}
+		return "{" + strings.Join(fields, ",") + "}", nil
+	default:
+		return "", client.NewErrUnhandledType("input value", value)
+	}
+}
@@ -12,6 +12,7 @@ package parser
 
 import (
 	"strconv"
+	"time"
 
 	gql "github.com/sourcenetwork/graphql-go"
 	"github.com/sourcenetwork/graphql-go/language/ast"
@@ -177,9 +178,25 @@ func parseSelect(
 		case request.ShowDeleted:
 			val := astValue.(*ast.BooleanValue)
 			slct.ShowDeleted = val.Value
+		case request.DiffFrom:
+			val := astValue.(*ast.StringValue)
+			slct.DiffFrom = immutable.Some(val.Value)
+		case request.DiffTo:
+			val := astValue.(*ast.StringValue)
+			slct.DiffTo = immutable.Some(val.Value)
+		case request.AsOfClause:
+			asOf, err := parseAsOf(astValue.(*ast.ObjectValue))
+			if err != nil {
+				return nil, err
+			}
+			slct.AsOf = immutable.Some(asOf)
 		}
 	}
 
+	if slct.AsOf.HasValue() && slct.CID.HasValue() {
+		return nil, ErrAsOfWithCid
+	}
+
 	// if theres no field selections, just return
 	if field.SelectionSet == nil {
 		return slct, nil
@@ -322,3 +339,37 @@ func parseAggregate(schema gql.Schema, parent *gql.Object, field *ast.Field, ind
 		Targets: targets,
 	}, nil
 }
+
+// parseAsOf parses the point in history given to the asOf argument.
+func parseAsOf(obj *ast.ObjectValue) (request.AsOf, error) {
+	asOf := request.AsOf{}
+	for _, field := range obj.Fields {
+		switch field.Name.Value {
+		case request.AsOfHeight:
+			val, ok := field.Value.(*ast.IntValue)
+			if !ok {
+				return request.AsOf{}, ErrInvalidAsOfHeight
+			}
+			height, err := strconv.ParseUint(val.Value, 10, 64)
+			if err != nil {
+				return request.AsOf{}, err
+			}
+			asOf.Height = immutable.Some(height)
+		case request.AsOfTime:
+			val, ok := field.Value.(*ast.StringValue)
+			if !ok {
+				return request.AsOf{}, ErrInvalidAsOfTime
+			}
+			t, err := time.Parse(time.RFC3339, val.Value)
+			if err != nil {
+				return request.AsOf{}, err
+			}
+			asOf.Time = immutable.Some(t)
+		}
+	}
+
+	if asOf.Height.HasValue() == asOf.Time.HasValue() {
+		return request.AsOf{}, ErrInvalidAsOf
+	}
+	return asOf, nil
+}
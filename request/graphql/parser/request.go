@@ -183,6 +183,8 @@ func parseSelectFields(
 				switch node.Name.Value {
 				case request.VersionFieldName:
 					subroot = request.CommitSelection
+				case request.DiffFieldName:
+					subroot = request.DiffSelection
 				}
 
 				s, err := parseSelect(schema, subroot, parent, node, i)
@@ -12,8 +12,11 @@ package schema
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
+	"time"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
@@ -53,10 +56,23 @@ func fromAst(ctx context.Context, doc *ast.Document) (
 	relationManager := NewRelationManager()
 	definitions := []client.CollectionDefinition{}
 
+	// Enums must be known before the object definitions are parsed, as they may
+	// be declared after the objects using them.
+	enums := map[string]client.EnumDescription{}
+	for _, def := range doc.Definitions {
+		if enumDef, ok := def.(*ast.EnumDefinition); ok {
+			enum, err := enumFromAST(enumDef)
+			if err != nil {
+				return nil, err
+			}
+			enums[enum.Name] = enum
+		}
+	}
+
 	for _, def := range doc.Definitions {
 		switch defType := def.(type) {
 		case *ast.ObjectDefinition:
-			description, err := fromAstDefinition(ctx, relationManager, defType)
+			description, err := fromAstDefinition(ctx, relationManager, enums, defType)
 			if err != nil {
 				return nil, err
 			}
@@ -84,6 +100,7 @@ func fromAst(ctx context.Context, doc *ast.Document) (
 func fromAstDefinition(
 	ctx context.Context,
 	relationManager *RelationManager,
+	enums map[string]client.EnumDescription,
 	def *ast.ObjectDefinition,
 ) (client.CollectionDefinition, error) {
 	fieldDescriptions := []client.FieldDescription{
@@ -96,7 +113,7 @@ func fromAstDefinition(
 
 	indexDescriptions := []client.IndexDescription{}
 	for _, field := range def.Fields {
-		tmpFieldsDescriptions, err := fieldsFromAST(field, relationManager, def)
+		tmpFieldsDescriptions, err := fieldsFromAST(field, relationManager, enums, def)
 		if err != nil {
 			return client.CollectionDefinition{}, err
 		}
@@ -135,6 +152,16 @@ func fromAstDefinition(
 		}
 	}
 
+	var enumDescriptions []client.EnumDescription
+	for _, field := range fieldDescriptions {
+		if field.Kind != client.FieldKind_ENUM {
+			continue
+		}
+		if _, exists := (client.SchemaDescription{Enums: enumDescriptions}).GetEnum(field.Schema); !exists {
+			enumDescriptions = append(enumDescriptions, enums[field.Schema])
+		}
+	}
+
 	return client.CollectionDefinition{
 		Description: client.CollectionDescription{
 			Name:    def.Name.Value,
@@ -143,10 +170,26 @@ func fromAstDefinition(
 		Schema: client.SchemaDescription{
 			Name:   def.Name.Value,
 			Fields: fieldDescriptions,
+			Enums:  enumDescriptions,
 		},
 	}, nil
 }
 
+// enumFromAST parses an AST enum definition into an enum description.
+func enumFromAST(def *ast.EnumDefinition) (client.EnumDescription, error) {
+	enum := client.EnumDescription{
+		Name:   def.Name.Value,
+		Values: make([]string, 0, len(def.Values)),
+	}
+	for _, value := range def.Values {
+		if enum.IndexOf(value.Name.Value) != -1 {
+			return client.EnumDescription{}, NewErrDuplicateEnumValue(enum.Name, value.Name.Value)
+		}
+		enum.Values = append(enum.Values, value.Name.Value)
+	}
+	return enum, nil
+}
+
 // IsValidIndexName returns true if the name is a valid index name.
 // Valid index names must start with a letter or underscore, and can
 // contain letters, numbers, and underscores.
@@ -186,6 +229,18 @@ func fieldIndexFromAST(field *ast.FieldDefinition, directive *ast.Directive) (cl
 			if !IsValidIndexName(desc.Name) {
 				return client.IndexDescription{}, NewErrIndexWithInvalidName(desc.Name)
 			}
+		case types.IndexDirectivePropPath:
+			pathVal, ok := arg.Value.(*ast.ListValue)
+			if !ok || len(pathVal.Values) == 0 {
+				return client.IndexDescription{}, ErrIndexWithInvalidArg
+			}
+			for _, key := range pathVal.Values {
+				keyVal, ok := key.(*ast.StringValue)
+				if !ok {
+					return client.IndexDescription{}, ErrIndexWithInvalidArg
+				}
+				desc.Fields[0].Path = append(desc.Fields[0].Path, keyVal.Value)
+			}
 		default:
 			return client.IndexDescription{}, ErrIndexWithUnknownArg
 		}
@@ -259,14 +314,33 @@ func indexFromAST(directive *ast.Directive) (client.IndexDescription, error) {
 
 func fieldsFromAST(field *ast.FieldDefinition,
 	relationManager *RelationManager,
+	enums map[string]client.EnumDescription,
 	def *ast.ObjectDefinition,
 ) ([]client.FieldDescription, error) {
-	kind, err := astTypeToKind(field.Type)
+	fieldType := field.Type
+	isRequired := false
+	if nonNull, ok := fieldType.(*ast.NonNull); ok {
+		fieldType = nonNull.Type
+		isRequired = true
+	}
+
+	kind, err := astTypeToKind(fieldType)
 	if err != nil {
 		return nil, err
 	}
 
 	schema := ""
+	if kind == client.FieldKind_FOREIGN_OBJECT {
+		if _, isEnum := enums[fieldType.(*ast.Named).Name.Value]; isEnum {
+			kind = client.FieldKind_ENUM
+			schema = fieldType.(*ast.Named).Name.Value
+		}
+	} else if kind == client.FieldKind_FOREIGN_OBJECT_ARRAY {
+		if _, isEnum := enums[fieldType.(*ast.List).Type.(*ast.Named).Name.Value]; isEnum {
+			return nil, NewErrEnumArrayNotSupported(fieldType.(*ast.List).Type.(*ast.Named).Name.Value)
+		}
+	}
+
 	relationName := ""
 	relationType := client.RelationType(0)
 
@@ -274,7 +348,7 @@ func fieldsFromAST(field *ast.FieldDefinition,
 
 	if kind == client.FieldKind_FOREIGN_OBJECT || kind == client.FieldKind_FOREIGN_OBJECT_ARRAY {
 		if kind == client.FieldKind_FOREIGN_OBJECT {
-			schema = field.Type.(*ast.Named).Name.Value
+			schema = fieldType.(*ast.Named).Name.Value
 			relationType = client.Relation_Type_ONE
 			if _, exists := findDirective(field, "primary"); exists {
 				relationType |= client.Relation_Type_Primary
@@ -286,10 +360,14 @@ func fieldsFromAST(field *ast.FieldDefinition,
 				Kind:         client.FieldKind_DocKey,
 				Typ:          defaultCRDTForFieldKind[client.FieldKind_DocKey],
 				RelationType: client.Relation_Type_INTERNAL_ID,
+				IsRequired:   isRequired,
 			})
 		} else if kind == client.FieldKind_FOREIGN_OBJECT_ARRAY {
-			schema = field.Type.(*ast.List).Type.(*ast.Named).Name.Value
+			schema = fieldType.(*ast.List).Type.(*ast.Named).Name.Value
 			relationType = client.Relation_Type_MANY
+			if isRequired {
+				return nil, NewErrNonNullForTypeNotSupported(schema)
+			}
 		}
 
 		relationName, err = getRelationshipName(field, def.Name.Value, schema)
@@ -310,6 +388,27 @@ func fieldsFromAST(field *ast.FieldDefinition,
 		}
 	}
 
+	_, isEncrypted := findDirective(field, types.EncryptedLabel)
+	if isEncrypted && relationType != 0 {
+		return nil, NewErrEncryptedRelationField(field.Name.Value)
+	}
+
+	defaultValue, err := defaultValueFromAST(field, kind)
+	if err != nil {
+		return nil, err
+	}
+	if defaultValue != "" && kind == client.FieldKind_ENUM {
+		var value string
+		if err := json.Unmarshal([]byte(defaultValue), &value); err != nil || enums[schema].IndexOf(value) == -1 {
+			return nil, NewErrInvalidDefaultValue(field.Name.Value)
+		}
+	}
+
+	constraints, err := constraintsFromAST(field, kind)
+	if err != nil {
+		return nil, err
+	}
+
 	fieldDescription := client.FieldDescription{
 		Name:         field.Name.Value,
 		Kind:         kind,
@@ -317,6 +416,10 @@ func fieldsFromAST(field *ast.FieldDefinition,
 		Schema:       schema,
 		RelationName: relationName,
 		RelationType: relationType,
+		IsEncrypted:  isEncrypted,
+		IsRequired:   isRequired,
+		DefaultValue: defaultValue,
+		Constraints:  constraints,
 	}
 
 	fieldDescriptions = append(fieldDescriptions, fieldDescription)
@@ -332,6 +435,10 @@ func astTypeToKind(t ast.Type) (client.FieldKind, error) {
 		typeDateTime string = "DateTime"
 		typeString   string = "String"
 		typeBlob     string = "Blob"
+		typeJSON     string = "JSON"
+		typeDecimal  string = "Decimal"
+		typeBigInt   string = "BigInt"
+		typeGeoPoint string = "GeoPoint"
 	)
 
 	switch astTypeVal := t.(type) {
@@ -347,6 +454,10 @@ func astTypeToKind(t ast.Type) (client.FieldKind, error) {
 				return client.FieldKind_FLOAT_ARRAY, nil
 			case typeString:
 				return client.FieldKind_STRING_ARRAY, nil
+			case typeDateTime:
+				return client.FieldKind_DATETIME_ARRAY, nil
+			case typeBlob:
+				return client.FieldKind_BLOB_ARRAY, nil
 			default:
 				return 0, NewErrNonNullForTypeNotSupported(innerAstTypeVal.Type.(*ast.Named).Name.Value)
 			}
@@ -361,6 +472,10 @@ func astTypeToKind(t ast.Type) (client.FieldKind, error) {
 				return client.FieldKind_NILLABLE_FLOAT_ARRAY, nil
 			case typeString:
 				return client.FieldKind_NILLABLE_STRING_ARRAY, nil
+			case typeDateTime:
+				return client.FieldKind_NILLABLE_DATETIME_ARRAY, nil
+			case typeBlob:
+				return client.FieldKind_NILLABLE_BLOB_ARRAY, nil
 			default:
 				return client.FieldKind_FOREIGN_OBJECT_ARRAY, nil
 			}
@@ -382,18 +497,242 @@ func astTypeToKind(t ast.Type) (client.FieldKind, error) {
 			return client.FieldKind_STRING, nil
 		case typeBlob:
 			return client.FieldKind_BLOB, nil
+		case typeJSON:
+			return client.FieldKind_JSON, nil
+		case typeDecimal:
+			return client.FieldKind_DECIMAL, nil
+		case typeBigInt:
+			return client.FieldKind_BIGINT, nil
+		case typeGeoPoint:
+			return client.FieldKind_GEOPOINT, nil
 		default:
 			return client.FieldKind_FOREIGN_OBJECT, nil
 		}
 
-	case *ast.NonNull:
-		return 0, ErrNonNullNotSupported
-
 	default:
 		return 0, NewErrTypeNotFound(t.String())
 	}
 }
 
+// defaultValueFromAST returns the JSON encoded value of the `@default` directive of the given
+// field, converted from its GQL literal to the value of the given kind.
+//
+// It returns an empty value if the field has no `@default` directive.
+func defaultValueFromAST(field *ast.FieldDefinition, kind client.FieldKind) (client.FieldDefaultValue, error) {
+	directive, exists := findDirective(field, types.DefaultLabel)
+	if !exists {
+		return "", nil
+	}
+	if kind == client.FieldKind_FOREIGN_OBJECT || kind == client.FieldKind_FOREIGN_OBJECT_ARRAY {
+		return "", NewErrDefaultRelationField(field.Name.Value)
+	}
+	for _, arg := range directive.Arguments {
+		if arg.Name.Value != types.DefaultDirectivePropValue {
+			continue
+		}
+		value, ok := astValueToKind(arg.Value, kind)
+		if !ok {
+			return "", NewErrInvalidDefaultValue(field.Name.Value)
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		return client.FieldDefaultValue(data), nil
+	}
+	return "", NewErrInvalidDefaultValue(field.Name.Value)
+}
+
+// constraintsFromAST returns the encoded rules of the `@constraint` directive of the given field.
+//
+// It returns empty constraints if the field has no `@constraint` directive.
+func constraintsFromAST(field *ast.FieldDefinition, kind client.FieldKind) (client.FieldConstraints, error) {
+	directive, exists := findDirective(field, types.ConstraintLabel)
+	if !exists {
+		return "", nil
+	}
+
+	var constraints client.Constraints
+	for _, arg := range directive.Arguments {
+		var ok bool
+		switch arg.Name.Value {
+		case client.ConstraintMinLength:
+			constraints.MinLength, ok = astValueToIntPtr(arg.Value)
+		case client.ConstraintMaxLength:
+			constraints.MaxLength, ok = astValueToIntPtr(arg.Value)
+		case client.ConstraintMinItems:
+			constraints.MinItems, ok = astValueToIntPtr(arg.Value)
+		case client.ConstraintMaxItems:
+			constraints.MaxItems, ok = astValueToIntPtr(arg.Value)
+		case client.ConstraintMin:
+			constraints.Min, ok = astValueToFloatPtr(arg.Value)
+		case client.ConstraintMax:
+			constraints.Max, ok = astValueToFloatPtr(arg.Value)
+		case client.ConstraintPattern:
+			var value any
+			value, ok = astValueToKind(arg.Value, client.FieldKind_STRING)
+			if ok {
+				constraints.Pattern = value.(string)
+			}
+		case client.ConstraintOneOf:
+			constraints.OneOf, ok = astValueToOneOf(arg.Value)
+		default:
+			return "", NewErrConstraintWithUnknownArg(field.Name.Value, arg.Name.Value)
+		}
+		if !ok {
+			return "", NewErrConstraintWithInvalidArg(field.Name.Value, arg.Name.Value)
+		}
+	}
+
+	if err := constraints.Validate(kind); err != nil {
+		return "", err
+	}
+	return client.NewFieldConstraints(constraints)
+}
+
+func astValueToIntPtr(value ast.Value) (*int, bool) {
+	v, ok := astValueToKind(value, client.FieldKind_INT)
+	if !ok {
+		return nil, false
+	}
+	i := int(v.(int64))
+	return &i, true
+}
+
+func astValueToFloatPtr(value ast.Value) (*float64, bool) {
+	v, ok := astValueToKind(value, client.FieldKind_FLOAT)
+	if !ok {
+		return nil, false
+	}
+	f := v.(float64)
+	return &f, true
+}
+
+// astValueToOneOf converts the given GQL list of strings or numbers to the allowed values of
+// a constraint, numbers are converted to float64 as they would be by a JSON decoder.
+func astValueToOneOf(value ast.Value) ([]any, bool) {
+	list, ok := value.(*ast.ListValue)
+	if !ok {
+		return nil, false
+	}
+	values := make([]any, len(list.Values))
+	for i, v := range list.Values {
+		kind := client.FieldKind_FLOAT
+		if _, isString := v.(*ast.StringValue); isString {
+			kind = client.FieldKind_STRING
+		}
+		values[i], ok = astValueToKind(v, kind)
+		if !ok {
+			return nil, false
+		}
+	}
+	return values, true
+}
+
+// astValueToKind converts the given GQL literal to the Go value of the given kind.
+func astValueToKind(value ast.Value, kind client.FieldKind) (any, bool) {
+	switch kind {
+	case client.FieldKind_DocKey, client.FieldKind_STRING, client.FieldKind_BLOB:
+		v, ok := value.(*ast.StringValue)
+		if !ok {
+			return nil, false
+		}
+		return v.Value, true
+
+	case client.FieldKind_ENUM:
+		v, ok := value.(*ast.EnumValue)
+		if !ok {
+			return nil, false
+		}
+		return v.Value, true
+
+	case client.FieldKind_JSON:
+		v := types.JSONScalarType.ParseLiteral(value)
+		return v, v != nil
+
+	case client.FieldKind_DECIMAL:
+		v := types.DecimalScalarType.ParseLiteral(value)
+		return v, v != nil
+
+	case client.FieldKind_BIGINT:
+		v := types.BigIntScalarType.ParseLiteral(value)
+		return v, v != nil
+
+	case client.FieldKind_GEOPOINT:
+		v := types.GeoPointScalarType.ParseLiteral(value)
+		return v, v != nil
+
+	case client.FieldKind_DATETIME:
+		v, ok := value.(*ast.StringValue)
+		if !ok {
+			return nil, false
+		}
+		if _, err := time.Parse(time.RFC3339, v.Value); err != nil {
+			return nil, false
+		}
+		return v.Value, true
+
+	case client.FieldKind_INT:
+		v, ok := value.(*ast.IntValue)
+		if !ok {
+			return nil, false
+		}
+		i, err := strconv.ParseInt(v.Value, 10, 64)
+		return i, err == nil
+
+	case client.FieldKind_FLOAT:
+		var raw string
+		switch v := value.(type) {
+		case *ast.FloatValue:
+			raw = v.Value
+		case *ast.IntValue:
+			raw = v.Value
+		default:
+			return nil, false
+		}
+		f, err := strconv.ParseFloat(raw, 64)
+		return f, err == nil
+
+	case client.FieldKind_BOOL:
+		v, ok := value.(*ast.BooleanValue)
+		if !ok {
+			return nil, false
+		}
+		return v.Value, true
+
+	case client.FieldKind_BOOL_ARRAY, client.FieldKind_NILLABLE_BOOL_ARRAY:
+		return astListToKind(value, client.FieldKind_BOOL)
+	case client.FieldKind_INT_ARRAY, client.FieldKind_NILLABLE_INT_ARRAY:
+		return astListToKind(value, client.FieldKind_INT)
+	case client.FieldKind_FLOAT_ARRAY, client.FieldKind_NILLABLE_FLOAT_ARRAY:
+		return astListToKind(value, client.FieldKind_FLOAT)
+	case client.FieldKind_STRING_ARRAY, client.FieldKind_NILLABLE_STRING_ARRAY:
+		return astListToKind(value, client.FieldKind_STRING)
+	case client.FieldKind_DATETIME_ARRAY, client.FieldKind_NILLABLE_DATETIME_ARRAY:
+		return astListToKind(value, client.FieldKind_DATETIME)
+	case client.FieldKind_BLOB_ARRAY, client.FieldKind_NILLABLE_BLOB_ARRAY:
+		return astListToKind(value, client.FieldKind_BLOB)
+
+	default:
+		return nil, false
+	}
+}
+
+func astListToKind(value ast.Value, elementKind client.FieldKind) (any, bool) {
+	list, ok := value.(*ast.ListValue)
+	if !ok {
+		return nil, false
+	}
+	values := make([]any, len(list.Values))
+	for i, v := range list.Values {
+		values[i], ok = astValueToKind(v, elementKind)
+		if !ok {
+			return nil, false
+		}
+	}
+	return values, true
+}
+
 func findDirective(field *ast.FieldDefinition, directiveName string) (*ast.Directive, bool) {
 	for _, directive := range field.Directives {
 		if directive.Name.Value == directiveName {
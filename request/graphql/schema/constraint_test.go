@@ -0,0 +1,92 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func TestConstraints(t *testing.T) {
+	ctx := context.Background()
+
+	cols, err := FromString(ctx, `type user {
+		name: String @constraint(minLength: 1, maxLength: 50, pattern: "^[A-Z]")
+		age: Int @constraint(min: 0, max: 150)
+		status: String @constraint(oneOf: ["active", "banned"])
+		tags: [String!] @constraint(minItems: 1, maxItems: 3, maxLength: 10)
+		email: String
+	}`)
+	require.NoError(t, err)
+
+	field, ok := cols[0].Schema.GetField("name")
+	require.True(t, ok)
+	constraints, err := field.Constraints.Decode()
+	require.NoError(t, err)
+	assert.Equal(t, 1, *constraints.MinLength)
+	assert.Equal(t, 50, *constraints.MaxLength)
+	assert.Equal(t, "^[A-Z]", constraints.Pattern)
+
+	field, ok = cols[0].Schema.GetField("age")
+	require.True(t, ok)
+	assert.Equal(t, client.FieldConstraints(`{"min":0,"max":150}`), field.Constraints)
+
+	field, ok = cols[0].Schema.GetField("status")
+	require.True(t, ok)
+	constraints, err = field.Constraints.Decode()
+	require.NoError(t, err)
+	assert.Equal(t, []any{"active", "banned"}, constraints.OneOf)
+
+	field, ok = cols[0].Schema.GetField("tags")
+	require.True(t, ok)
+	constraints, err = field.Constraints.Decode()
+	require.NoError(t, err)
+	assert.Equal(t, 1, *constraints.MinItems)
+	assert.Equal(t, 3, *constraints.MaxItems)
+
+	field, ok = cols[0].Schema.GetField("email")
+	require.True(t, ok)
+	assert.Empty(t, field.Constraints)
+}
+
+func TestConstraints_WithInvalidArguments_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := FromString(ctx, `type user {
+		name: String @constraint(length: 1)
+	}`)
+	assert.ErrorIs(t, err, ErrConstraintWithUnknownArg)
+
+	_, err = FromString(ctx, `type user {
+		name: String @constraint(minLength: "one")
+	}`)
+	assert.ErrorIs(t, err, ErrConstraintWithInvalidArg)
+
+	_, err = FromString(ctx, `type user {
+		age: Int @constraint(minLength: 1)
+	}`)
+	assert.ErrorIs(t, err, client.ErrInvalidConstraint)
+
+	_, err = FromString(ctx, `type user {
+		age: Int @constraint(min: 10, max: 1)
+	}`)
+	assert.ErrorIs(t, err, client.ErrInvalidConstraint)
+
+	_, err = FromString(ctx, `type user {
+		name: String @constraint(pattern: "[a-")
+	}`)
+	assert.ErrorIs(t, err, client.ErrInvalidPattern)
+}
@@ -0,0 +1,104 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func TestRequiredFieldsAndDefaults(t *testing.T) {
+	ctx := context.Background()
+
+	cols, err := FromString(ctx, `
+	type book {
+		title: String! @default(value: "Untitled")
+		pages: Int @default(value: 100)
+		price: Float @default(value: 10)
+		tags: [String!] @default(value: ["new"])
+		author: author!
+	}
+	type author {
+		books: [book]
+	}`)
+	require.NoError(t, err)
+
+	book := cols[0].Schema
+	field, ok := book.GetField("title")
+	require.True(t, ok)
+	assert.True(t, field.IsRequired)
+	assert.Equal(t, client.FieldDefaultValue(`"Untitled"`), field.DefaultValue)
+
+	field, ok = book.GetField("pages")
+	require.True(t, ok)
+	assert.False(t, field.IsRequired)
+	assert.Equal(t, client.FieldDefaultValue("100"), field.DefaultValue)
+
+	field, ok = book.GetField("price")
+	require.True(t, ok)
+	assert.Equal(t, client.FieldDefaultValue("10"), field.DefaultValue)
+
+	field, ok = book.GetField("tags")
+	require.True(t, ok)
+	assert.Equal(t, client.FieldDefaultValue(`["new"]`), field.DefaultValue)
+
+	field, ok = book.GetField("author")
+	require.True(t, ok)
+	assert.True(t, field.IsRequired)
+	field, ok = book.GetField("author_id")
+	require.True(t, ok)
+	assert.True(t, field.IsRequired)
+}
+
+func TestDefault_WithInvalidValue_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := FromString(ctx, `type user {
+		age: Int @default(value: "old")
+	}`)
+	assert.ErrorContains(t, err, errInvalidDefaultValue)
+
+	_, err = FromString(ctx, `type user {
+		createdAt: DateTime @default(value: "yesterday")
+	}`)
+	assert.ErrorContains(t, err, errInvalidDefaultValue)
+}
+
+func TestDefault_WithRelation_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := FromString(ctx, `
+	type book {
+		author: author @default(value: "bae-123")
+	}
+	type author {
+		books: [book]
+	}`)
+	assert.ErrorContains(t, err, errDefaultRelationField)
+}
+
+func TestRequiredManyRelation_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := FromString(ctx, `
+	type book {
+		author: author
+	}
+	type author {
+		books: [book]!
+	}`)
+	assert.ErrorIs(t, err, ErrNonNullForTypeNotSupported)
+}
@@ -33,49 +33,69 @@ var (
 		&gql.Object{}: client.FieldKind_FOREIGN_OBJECT,
 		&gql.List{}:   client.FieldKind_FOREIGN_OBJECT_ARRAY,
 		// Custom scalars
-		schemaTypes.BlobScalarType: client.FieldKind_BLOB,
+		schemaTypes.BlobScalarType:     client.FieldKind_BLOB,
+		schemaTypes.JSONScalarType:     client.FieldKind_JSON,
+		schemaTypes.DecimalScalarType:  client.FieldKind_DECIMAL,
+		schemaTypes.BigIntScalarType:   client.FieldKind_BIGINT,
+		schemaTypes.GeoPointScalarType: client.FieldKind_GEOPOINT,
 		// More custom ones to come
-		// - JSON
 		// - Counters
 	}
 
 	fieldKindToGQLType = map[client.FieldKind]gql.Type{
-		client.FieldKind_DocKey:                gql.ID,
-		client.FieldKind_BOOL:                  gql.Boolean,
-		client.FieldKind_BOOL_ARRAY:            gql.NewList(gql.NewNonNull(gql.Boolean)),
-		client.FieldKind_NILLABLE_BOOL_ARRAY:   gql.NewList(gql.Boolean),
-		client.FieldKind_INT:                   gql.Int,
-		client.FieldKind_INT_ARRAY:             gql.NewList(gql.NewNonNull(gql.Int)),
-		client.FieldKind_NILLABLE_INT_ARRAY:    gql.NewList(gql.Int),
-		client.FieldKind_FLOAT:                 gql.Float,
-		client.FieldKind_FLOAT_ARRAY:           gql.NewList(gql.NewNonNull(gql.Float)),
-		client.FieldKind_NILLABLE_FLOAT_ARRAY:  gql.NewList(gql.Float),
-		client.FieldKind_DATETIME:              gql.DateTime,
-		client.FieldKind_STRING:                gql.String,
-		client.FieldKind_STRING_ARRAY:          gql.NewList(gql.NewNonNull(gql.String)),
-		client.FieldKind_NILLABLE_STRING_ARRAY: gql.NewList(gql.String),
-		client.FieldKind_BLOB:                  schemaTypes.BlobScalarType,
+		client.FieldKind_DocKey:                  gql.ID,
+		client.FieldKind_BOOL:                    gql.Boolean,
+		client.FieldKind_BOOL_ARRAY:              gql.NewList(gql.NewNonNull(gql.Boolean)),
+		client.FieldKind_NILLABLE_BOOL_ARRAY:     gql.NewList(gql.Boolean),
+		client.FieldKind_INT:                     gql.Int,
+		client.FieldKind_INT_ARRAY:               gql.NewList(gql.NewNonNull(gql.Int)),
+		client.FieldKind_NILLABLE_INT_ARRAY:      gql.NewList(gql.Int),
+		client.FieldKind_FLOAT:                   gql.Float,
+		client.FieldKind_FLOAT_ARRAY:             gql.NewList(gql.NewNonNull(gql.Float)),
+		client.FieldKind_NILLABLE_FLOAT_ARRAY:    gql.NewList(gql.Float),
+		client.FieldKind_DATETIME:                gql.DateTime,
+		client.FieldKind_DATETIME_ARRAY:          gql.NewList(gql.NewNonNull(gql.DateTime)),
+		client.FieldKind_NILLABLE_DATETIME_ARRAY: gql.NewList(gql.DateTime),
+		client.FieldKind_STRING:                  gql.String,
+		client.FieldKind_STRING_ARRAY:            gql.NewList(gql.NewNonNull(gql.String)),
+		client.FieldKind_NILLABLE_STRING_ARRAY:   gql.NewList(gql.String),
+		client.FieldKind_BLOB:                    schemaTypes.BlobScalarType,
+		client.FieldKind_BLOB_ARRAY:              gql.NewList(gql.NewNonNull(schemaTypes.BlobScalarType)),
+		client.FieldKind_NILLABLE_BLOB_ARRAY:     gql.NewList(schemaTypes.BlobScalarType),
+		client.FieldKind_JSON:                    schemaTypes.JSONScalarType,
+		client.FieldKind_DECIMAL:                 schemaTypes.DecimalScalarType,
+		client.FieldKind_BIGINT:                  schemaTypes.BigIntScalarType,
+		client.FieldKind_GEOPOINT:                schemaTypes.GeoPointScalarType,
 	}
 
 	// This map is fine to use
 	defaultCRDTForFieldKind = map[client.FieldKind]client.CType{
-		client.FieldKind_DocKey:                client.LWW_REGISTER,
-		client.FieldKind_BOOL:                  client.LWW_REGISTER,
-		client.FieldKind_BOOL_ARRAY:            client.LWW_REGISTER,
-		client.FieldKind_NILLABLE_BOOL_ARRAY:   client.LWW_REGISTER,
-		client.FieldKind_INT:                   client.LWW_REGISTER,
-		client.FieldKind_INT_ARRAY:             client.LWW_REGISTER,
-		client.FieldKind_NILLABLE_INT_ARRAY:    client.LWW_REGISTER,
-		client.FieldKind_FLOAT:                 client.LWW_REGISTER,
-		client.FieldKind_FLOAT_ARRAY:           client.LWW_REGISTER,
-		client.FieldKind_NILLABLE_FLOAT_ARRAY:  client.LWW_REGISTER,
-		client.FieldKind_DATETIME:              client.LWW_REGISTER,
-		client.FieldKind_STRING:                client.LWW_REGISTER,
-		client.FieldKind_STRING_ARRAY:          client.LWW_REGISTER,
-		client.FieldKind_NILLABLE_STRING_ARRAY: client.LWW_REGISTER,
-		client.FieldKind_BLOB:                  client.LWW_REGISTER,
-		client.FieldKind_FOREIGN_OBJECT:        client.NONE_CRDT,
-		client.FieldKind_FOREIGN_OBJECT_ARRAY:  client.NONE_CRDT,
+		client.FieldKind_DocKey:                  client.LWW_REGISTER,
+		client.FieldKind_BOOL:                    client.LWW_REGISTER,
+		client.FieldKind_BOOL_ARRAY:              client.LWW_REGISTER,
+		client.FieldKind_NILLABLE_BOOL_ARRAY:     client.LWW_REGISTER,
+		client.FieldKind_INT:                     client.LWW_REGISTER,
+		client.FieldKind_INT_ARRAY:               client.LWW_REGISTER,
+		client.FieldKind_NILLABLE_INT_ARRAY:      client.LWW_REGISTER,
+		client.FieldKind_FLOAT:                   client.LWW_REGISTER,
+		client.FieldKind_FLOAT_ARRAY:             client.LWW_REGISTER,
+		client.FieldKind_NILLABLE_FLOAT_ARRAY:    client.LWW_REGISTER,
+		client.FieldKind_DATETIME:                client.LWW_REGISTER,
+		client.FieldKind_DATETIME_ARRAY:          client.LWW_REGISTER,
+		client.FieldKind_NILLABLE_DATETIME_ARRAY: client.LWW_REGISTER,
+		client.FieldKind_STRING:                  client.LWW_REGISTER,
+		client.FieldKind_STRING_ARRAY:            client.LWW_REGISTER,
+		client.FieldKind_NILLABLE_STRING_ARRAY:   client.LWW_REGISTER,
+		client.FieldKind_BLOB:                    client.LWW_REGISTER,
+		client.FieldKind_BLOB_ARRAY:              client.LWW_REGISTER,
+		client.FieldKind_NILLABLE_BLOB_ARRAY:     client.LWW_REGISTER,
+		client.FieldKind_ENUM:                    client.LWW_REGISTER,
+		client.FieldKind_JSON:                    client.LWW_REGISTER,
+		client.FieldKind_DECIMAL:                 client.LWW_REGISTER,
+		client.FieldKind_BIGINT:                  client.LWW_REGISTER,
+		client.FieldKind_GEOPOINT:                client.LWW_REGISTER,
+		client.FieldKind_FOREIGN_OBJECT:          client.NONE_CRDT,
+		client.FieldKind_FOREIGN_OBJECT_ARRAY:    client.NONE_CRDT,
 	}
 )
 
@@ -121,10 +141,16 @@ An optional value that specifies as to whether deleted documents may be
  returned. This argument will propagate down through any child selects/joins.
 `
 	createDocumentDescription string = `
-Creates a single document of this type using the data provided.
+Creates documents of this type using the data provided. The created documents
+ are returned in the order they were given.
 `
 	createDataArgDescription string = `
-The json representation of the document you wish to create. Required.
+The json representation of the document you wish to create, or a list of them
+ to create many documents at once. Required.
+`
+	createInputArgDescription string = `
+The document you wish to create, or a list of them to create many documents at
+ once, as typed input objects. May not be used alongside data.
 `
 	updateDocumentsDescription string = `
 Updates documents in this collection using the data provided. Only documents
@@ -149,6 +175,28 @@ An optional filter for this update that will limit the update to the documents
 	updateDataArgDescription string = `
 The json representation of the fields to update and their new values. Required.
  Fields not explicitly mentioned here will not be updated.
+`
+	updateInputArgDescription string = `
+The fields to update and their new values, as a typed input object. May not be
+ used alongside data. Fields not explicitly mentioned here will not be updated.
+`
+	createInputDescription string = `
+The fields of a new document of this type. Relation fields either connect to an
+ existing document, or create a new one.
+`
+	updateInputDescription string = `
+The fields to update on documents of this type. Relation fields either connect
+ to an existing document, or create a new one.
+`
+	createOrConnectInputDescription string = `
+Relates a document to a document of this type, either an existing one given by
+ connect, or a new one given by create. Exactly one of them must be given.
+`
+	connectArgDescription string = `
+The dockey of the existing document to relate to.
+`
+	createArgDescription string = `
+The new document to create and relate to.
 `
 	deleteDocumentsDescription string = `
 Deletes documents in this collection matching any provided criteria. If no
@@ -169,6 +217,33 @@ An optional set of dockey values that will limit the delete to documents with
 An optional filter for this delete that will limit the delete to documents
  matching the given criteria. If no matching documents are found, the operation
  will succeed, but no documents will be deleted.
+`
+	revertDocumentDescription string = `
+Reverts the document with the given dockey to the version with the given cid. The
+ revert is written as a new update, and the document is restored if it has been
+ deleted since that version.
+`
+	revertIDArgDescription string = `
+The dockey of the document to revert.
+`
+	revertCidArgDescription string = `
+The cid of the composite commit of the version to revert the document to.
+`
+	upsertDocumentDescription string = `
+Updates the single document in this collection matching the given filter, or
+ creates a new document if no document matches. The operation fails if more
+ than one document matches the filter.
+`
+	upsertFilterArgDescription string = `
+The filter used to find the document to update. Required.
+`
+	upsertCreateArgDescription string = `
+The json representation of the document to create if no document matches the
+ filter. Required.
+`
+	upsertUpdateArgDescription string = `
+The json representation of the fields to update on the matching document and
+ their new values. Required.
 `
 	keyFieldDescription string = `
 The immutable primary key (dockey) value for this document.
@@ -181,8 +256,25 @@ The group field may be used to return a set of records belonging to the group.
 `
 	deletedFieldDescription string = `
 Indicates as to whether or not this document has been deleted.
+`
+	ownerFieldDescription string = `
+The identity of the caller that created this document, if it was created by an
+ identified caller.
 `
 	versionFieldDescription string = `
 Returns the head commit for this document.
+`
+	diffFieldDescription string = `
+Returns the fields whose values differ between two versions of this document.
+ Each version is reconstructed from its own history, so versions on concurrent
+ branches may be compared.
+`
+	diffFromArgDescription string = `
+The CID of the composite commit of the earlier version. If not provided the
+ state before the document was created is used.
+`
+	diffToArgDescription string = `
+The CID of the composite commit of the later version. If not provided the
+ current state of the document is used.
 `
 )
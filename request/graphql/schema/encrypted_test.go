@@ -0,0 +1,52 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptedField(t *testing.T) {
+	ctx := context.Background()
+
+	cols, err := FromString(ctx, `type user {
+		name: String
+		ssn: String @encrypted
+	}`)
+	require.NoError(t, err)
+	require.Len(t, cols, 1)
+
+	field, ok := cols[0].Schema.GetField("ssn")
+	require.True(t, ok)
+	assert.True(t, field.IsEncrypted)
+
+	field, ok = cols[0].Schema.GetField("name")
+	require.True(t, ok)
+	assert.False(t, field.IsEncrypted)
+	assert.True(t, cols[0].Schema.HasEncryptedFields())
+}
+
+func TestEncryptedField_WithRelation_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := FromString(ctx, `
+	type book {
+		author: author @encrypted
+	}
+	type author {
+		books: [book]
+	}`)
+	assert.ErrorContains(t, err, errEncryptedRelationField)
+}
@@ -0,0 +1,90 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+func TestEnumFields(t *testing.T) {
+	ctx := context.Background()
+
+	cols, err := FromString(ctx, `
+	type task {
+		title: String
+		status: Status! @default(value: OPEN)
+	}
+	type note {
+		text: String
+	}
+	enum Status {
+		OPEN
+		IN_PROGRESS
+		DONE
+	}`)
+	require.NoError(t, err)
+
+	task := cols[0].Schema
+	assert.Equal(
+		t,
+		[]client.EnumDescription{{Name: "Status", Values: []string{"OPEN", "IN_PROGRESS", "DONE"}}},
+		task.Enums,
+	)
+	field, ok := task.GetField("status")
+	require.True(t, ok)
+	assert.Equal(t, client.FieldKind_ENUM, field.Kind)
+	assert.Equal(t, "Status", field.Schema)
+	assert.Equal(t, client.LWW_REGISTER, field.Typ)
+	assert.True(t, field.IsRequired)
+	assert.Equal(t, client.FieldDefaultValue(`"OPEN"`), field.DefaultValue)
+
+	assert.Empty(t, cols[1].Schema.Enums)
+}
+
+func TestEnum_WithDuplicateValue_ReturnsError(t *testing.T) {
+	_, err := FromString(context.Background(), `
+	type task {
+		status: Status
+	}
+	enum Status {
+		OPEN
+		OPEN
+	}`)
+	assert.ErrorContains(t, err, errDuplicateEnumValue)
+}
+
+func TestEnum_WithInvalidDefault_ReturnsError(t *testing.T) {
+	_, err := FromString(context.Background(), `
+	type task {
+		status: Status @default(value: CLOSED)
+	}
+	enum Status {
+		OPEN
+	}`)
+	assert.ErrorContains(t, err, errInvalidDefaultValue)
+}
+
+func TestEnumArray_ReturnsError(t *testing.T) {
+	_, err := FromString(context.Background(), `
+	type task {
+		statuses: [Status]
+	}
+	enum Status {
+		OPEN
+	}`)
+	assert.ErrorContains(t, err, errEnumArrayNotSupported)
+}
@@ -26,6 +26,13 @@ const (
 	errIndexUnknownArgument       string = "index with unknown argument"
 	errIndexInvalidArgument       string = "index with invalid argument"
 	errIndexInvalidName           string = "index with invalid name"
+	errEncryptedRelationField     string = "relation fields cannot be encrypted"
+	errDefaultRelationField       string = "relation fields cannot have a default value"
+	errInvalidDefaultValue        string = "invalid default value for field"
+	errConstraintUnknownArgument  string = "constraint with unknown argument"
+	errConstraintInvalidArgument  string = "constraint with invalid argument"
+	errDuplicateEnumValue         string = "duplicate enum value"
+	errEnumArrayNotSupported      string = "enum arrays are not supported"
 )
 
 var (
@@ -42,12 +49,15 @@ var (
 	ErrRelationMissingTypes       = errors.New("relation is missing its defined types and fields")
 	ErrRelationInvalidType        = errors.New("relation has an invalid type to be finalize")
 	ErrMultipleRelationPrimaries  = errors.New("relation can only have a single field set as primary")
-	// NonNull is the literal name of the GQL type, so we have to disable the linter
-	//nolint:revive
-	ErrNonNullNotSupported = errors.New("NonNull fields are not currently supported")
-	ErrIndexMissingFields  = errors.New(errIndexMissingFields)
-	ErrIndexWithUnknownArg = errors.New(errIndexUnknownArgument)
-	ErrIndexWithInvalidArg = errors.New(errIndexInvalidArgument)
+	ErrIndexMissingFields         = errors.New(errIndexMissingFields)
+	ErrIndexWithUnknownArg        = errors.New(errIndexUnknownArgument)
+	ErrIndexWithInvalidArg        = errors.New(errIndexInvalidArgument)
+	ErrDefaultRelationField       = errors.New(errDefaultRelationField)
+	ErrInvalidDefaultValue        = errors.New(errInvalidDefaultValue)
+	ErrConstraintWithUnknownArg   = errors.New(errConstraintUnknownArgument)
+	ErrConstraintWithInvalidArg   = errors.New(errConstraintInvalidArgument)
+	ErrDuplicateEnumValue         = errors.New(errDuplicateEnumValue)
+	ErrEnumArrayNotSupported      = errors.New(errEnumArrayNotSupported)
 )
 
 func NewErrDuplicateField(objectName, fieldName string) error {
@@ -121,3 +131,43 @@ func NewErrRelationNotFound(relationName string) error {
 		errors.NewKV("RelationName", relationName),
 	)
 }
+
+func NewErrEncryptedRelationField(fieldName string) error {
+	return errors.New(errEncryptedRelationField, errors.NewKV("Field", fieldName))
+}
+
+func NewErrDefaultRelationField(fieldName string) error {
+	return errors.New(errDefaultRelationField, errors.NewKV("Field", fieldName))
+}
+
+func NewErrInvalidDefaultValue(fieldName string) error {
+	return errors.New(errInvalidDefaultValue, errors.NewKV("Field", fieldName))
+}
+
+func NewErrConstraintWithUnknownArg(fieldName, argName string) error {
+	return errors.New(
+		errConstraintUnknownArgument,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Argument", argName),
+	)
+}
+
+func NewErrConstraintWithInvalidArg(fieldName, argName string) error {
+	return errors.New(
+		errConstraintInvalidArgument,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Argument", argName),
+	)
+}
+
+func NewErrDuplicateEnumValue(enumName, value string) error {
+	return errors.New(
+		errDuplicateEnumValue,
+		errors.NewKV("Enum", enumName),
+		errors.NewKV("Value", value),
+	)
+}
+
+func NewErrEnumArrayNotSupported(enumName string) error {
+	return errors.New(errEnumArrayNotSupported, errors.NewKV("Enum", enumName))
+}
@@ -164,6 +164,14 @@ func (g *Generator) generate(ctx context.Context, collections []client.Collectio
 		return nil, err
 	}
 
+	// generate the typed mutation inputs of all types before any of the mutation
+	// fields, as the inputs of a type refer to those of its related types.
+	for _, t := range g.typeDefs {
+		for _, input := range g.genTypeMutationInputs(t) {
+			g.manager.schema.TypeMap()[input.Name()] = input
+		}
+	}
+
 	// now let's generate the mutation types.
 	mutationType := g.manager.schema.MutationType()
 	for _, t := range g.typeDefs {
@@ -360,6 +368,11 @@ func (g *Generator) buildTypes(
 	// get all the defined types from the AST
 	objs := make([]*gql.Object, 0)
 
+	err := g.buildEnumTypes(collections)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, c := range collections {
 		// Copy the loop variable before usage within the loop or it
 		// will be reassigned before the thunk is run
@@ -397,7 +410,13 @@ func (g *Generator) buildTypes(
 				}
 
 				var ttype gql.Type
-				if field.Kind == client.FieldKind_FOREIGN_OBJECT {
+				if field.Kind == client.FieldKind_ENUM {
+					var ok bool
+					ttype, ok = g.manager.schema.TypeMap()[field.Schema]
+					if !ok {
+						return nil, NewErrTypeNotFound(field.Schema)
+					}
+				} else if field.Kind == client.FieldKind_FOREIGN_OBJECT {
 					var ok bool
 					ttype, ok = g.manager.schema.TypeMap()[field.Schema]
 					if !ok {
@@ -429,12 +448,28 @@ func (g *Generator) buildTypes(
 				Type:        gql.NewList(schemaTypes.CommitObject),
 			}
 
+			// add _diff field
+			fields[request.DiffFieldName] = &gql.Field{
+				Description: diffFieldDescription,
+				Type:        gql.NewList(schemaTypes.FieldDiffObject),
+				Args: gql.FieldConfigArgument{
+					request.DiffFrom: schemaTypes.NewArgConfig(gql.String, diffFromArgDescription),
+					request.DiffTo:   schemaTypes.NewArgConfig(gql.String, diffToArgDescription),
+				},
+			}
+
 			// add _deleted field
 			fields[request.DeletedFieldName] = &gql.Field{
 				Description: deletedFieldDescription,
 				Type:        gql.Boolean,
 			}
 
+			// add _owner field
+			fields[request.OwnerFieldName] = &gql.Field{
+				Description: ownerFieldDescription,
+				Type:        gql.String,
+			}
+
 			gqlType, ok := g.manager.schema.TypeMap()[collection.Description.Name]
 			if !ok {
 				return nil, NewErrObjectNotFoundDuringThunk(collection.Description.Name)
@@ -460,6 +495,53 @@ func (g *Generator) buildTypes(
 	return objs, nil
 }
 
+// buildEnumTypes builds the enum types, and their filter operator blocks, of the enums
+// declared by the given collections.
+//
+// Collections sharing an enum may hold different versions of it, the enum type then holds
+// the values of every version.
+func (g *Generator) buildEnumTypes(collections []client.CollectionDefinition) error {
+	enumNames := []string{}
+	enumValues := map[string]*client.EnumDescription{}
+	for _, collection := range collections {
+		for _, enum := range collection.Schema.Enums {
+			existing, ok := enumValues[enum.Name]
+			if !ok {
+				existing = &client.EnumDescription{Name: enum.Name}
+				enumValues[enum.Name] = existing
+				enumNames = append(enumNames, enum.Name)
+			}
+			for _, value := range enum.Values {
+				if existing.IndexOf(value) == -1 {
+					existing.Values = append(existing.Values, value)
+				}
+			}
+		}
+	}
+
+	for _, name := range enumNames {
+		if _, ok := g.manager.schema.TypeMap()[name]; ok {
+			return NewErrSchemaTypeAlreadyExist(name)
+		}
+
+		values := gql.EnumValueConfigMap{}
+		for _, value := range enumValues[name].Values {
+			values[value] = &gql.EnumValueConfig{Value: value}
+		}
+		enum := gql.NewEnum(gql.EnumConfig{
+			Name:   name,
+			Values: values,
+		})
+		if err := g.manager.schema.AppendType(enum); err != nil {
+			return err
+		}
+		if err := g.manager.schema.AppendType(schemaTypes.NewEnumOperatorBlock(enum)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (g *Generator) genAggregateFields(ctx context.Context) error {
 	topLevelCountInputs := map[string]*gql.InputObject{}
 	topLevelNumericAggInputs := map[string]*gql.InputObject{}
@@ -754,6 +836,10 @@ func (g *Generator) genCountInlineArrayInputs(obj *gql.Object) []*gql.InputObjec
 		if !isList {
 			continue
 		}
+		// the diff is computed per document and can not be aggregated
+		if field.Name == request.DiffFieldName {
+			continue
+		}
 
 		// If it is an inline scalar array then we require an empty
 		//  object as an argument due to the lack of union input types
@@ -798,7 +884,8 @@ func (g *Generator) genNumericAggregateBaseArgInputs(obj *gql.Object) *gql.Input
 			hasSumableFields := false
 			// generate basic filter operator blocks for all the sumable types
 			for _, field := range obj.Fields() {
-				if field.Type == gql.Float || field.Type == gql.Int {
+				if field.Type == gql.Float || field.Type == gql.Int ||
+					field.Type == schemaTypes.DecimalScalarType || field.Type == schemaTypes.BigIntScalarType {
 					hasSumableFields = true
 					fieldsEnumCfg.Values[field.Name] = &gql.EnumValueConfig{Value: field.Name}
 					continue
@@ -902,6 +989,98 @@ func (g *Generator) GenerateMutationInputForGQLType(obj *gql.Object) ([]*gql.Fie
 	return g.genTypeMutationFields(obj, filter)
 }
 
+// genTypeMutationInputs generates the typed input objects of the mutations of the given
+// object:
+//
+//	input {Type.Name}CreateInput { ... }
+//	input {Type.Name}UpdateInput { ... }
+//	input {Type.Name}CreateOrConnectInput { connect: ID, create: {Type.Name}CreateInput }
+//
+// Input objects may not be empty, so none are generated for objects without any fields.
+func (g *Generator) genTypeMutationInputs(obj *gql.Object) []*gql.InputObject {
+	hasFields := false
+	for f := range obj.Fields() {
+		if _, ok := request.ReservedFields[f]; !ok {
+			hasFields = true
+			break
+		}
+	}
+	if !hasFields {
+		return nil
+	}
+
+	createInput := gql.NewInputObject(gql.InputObjectConfig{
+		Name:        genTypeName(obj, "CreateInput"),
+		Description: createInputDescription,
+		Fields:      g.genTypeMutationInputFields(obj),
+	})
+	updateInput := gql.NewInputObject(gql.InputObjectConfig{
+		Name:        genTypeName(obj, "UpdateInput"),
+		Description: updateInputDescription,
+		Fields:      g.genTypeMutationInputFields(obj),
+	})
+	createOrConnectInput := gql.NewInputObject(gql.InputObjectConfig{
+		Name:        genTypeName(obj, "CreateOrConnectInput"),
+		Description: createOrConnectInputDescription,
+		Fields: gql.InputObjectConfigFieldMapThunk(func() (gql.InputObjectConfigFieldMap, error) {
+			return gql.InputObjectConfigFieldMap{
+				request.Connect: &gql.InputObjectFieldConfig{
+					Description: connectArgDescription,
+					Type:        gql.ID,
+				},
+				request.Create: &gql.InputObjectFieldConfig{
+					Description: createArgDescription,
+					Type:        createInput,
+				},
+			}, nil
+		}),
+	})
+	return []*gql.InputObject{createInput, updateInput, createOrConnectInput}
+}
+
+// genTypeMutationInputFields returns the fields of the typed mutation inputs of the given
+// object. Relation fields take the {Type.Name}CreateOrConnectInput of the related object.
+func (g *Generator) genTypeMutationInputFields(obj *gql.Object) gql.InputObjectConfigFieldMapThunk {
+	return func() (gql.InputObjectConfigFieldMap, error) {
+		fields := gql.InputObjectConfigFieldMap{}
+
+		for f, field := range obj.Fields() {
+			if _, ok := request.ReservedFields[f]; ok {
+				continue
+			}
+
+			if gql.IsLeafType(field.Type) {
+				inputType, ok := field.Type.(gql.Input)
+				if !ok {
+					continue
+				}
+				fields[field.Name] = &gql.InputObjectFieldConfig{
+					Type: inputType,
+				}
+				continue
+			}
+
+			relatedType, ok := gql.GetNamed(field.Type).(*gql.Object)
+			if !ok {
+				continue
+			}
+			relationInputName := genTypeName(relatedType, "CreateOrConnectInput")
+			relationInput, ok := g.manager.schema.TypeMap()[relationInputName]
+			if !ok {
+				return nil, NewErrTypeNotFound(relationInputName)
+			}
+			if _, isList := field.Type.(*gql.List); isList {
+				relationInput = gql.NewList(relationInput)
+			}
+			fields[field.Name] = &gql.InputObjectFieldConfig{
+				Type: relationInput,
+			}
+		}
+
+		return fields, nil
+	}
+}
+
 func (g *Generator) genTypeMutationFields(
 	obj *gql.Object,
 	filterInput *gql.InputObject,
@@ -918,18 +1097,29 @@ func (g *Generator) genTypeMutationFields(
 	if err != nil {
 		return nil, err
 	}
-	return []*gql.Field{create, update, delete}, nil
+	revert, err := g.genTypeMutationRevertField(obj)
+	if err != nil {
+		return nil, err
+	}
+	upsert, err := g.genTypeMutationUpsertField(obj, filterInput)
+	if err != nil {
+		return nil, err
+	}
+	return []*gql.Field{create, update, delete, revert, upsert}, nil
 }
 
 func (g *Generator) genTypeMutationCreateField(obj *gql.Object) (*gql.Field, error) {
 	field := &gql.Field{
 		Name:        "create_" + obj.Name(),
 		Description: createDocumentDescription,
-		Type:        obj,
+		Type:        gql.NewList(obj),
 		Args: gql.FieldConfigArgument{
-			"data": schemaTypes.NewArgConfig(gql.String, createDataArgDescription),
+			"data": schemaTypes.NewArgConfig(gql.NewList(gql.String), createDataArgDescription),
 		},
 	}
+	if input, ok := g.manager.schema.TypeMap()[genTypeName(obj, "CreateInput")]; ok {
+		field.Args["input"] = schemaTypes.NewArgConfig(gql.NewList(input), createInputArgDescription)
+	}
 	return field, nil
 }
 
@@ -948,6 +1138,9 @@ func (g *Generator) genTypeMutationUpdateField(
 			"data":   schemaTypes.NewArgConfig(gql.String, updateDataArgDescription),
 		},
 	}
+	if input, ok := g.manager.schema.TypeMap()[genTypeName(obj, "UpdateInput")]; ok {
+		field.Args["input"] = schemaTypes.NewArgConfig(input, updateInputArgDescription)
+	}
 	return field, nil
 }
 
@@ -968,6 +1161,36 @@ func (g *Generator) genTypeMutationDeleteField(
 	return field, nil
 }
 
+func (g *Generator) genTypeMutationRevertField(obj *gql.Object) (*gql.Field, error) {
+	field := &gql.Field{
+		Name:        "revert_" + obj.Name(),
+		Description: revertDocumentDescription,
+		Type:        obj,
+		Args: gql.FieldConfigArgument{
+			"id":  schemaTypes.NewArgConfig(gql.NewNonNull(gql.ID), revertIDArgDescription),
+			"cid": schemaTypes.NewArgConfig(gql.NewNonNull(gql.String), revertCidArgDescription),
+		},
+	}
+	return field, nil
+}
+
+func (g *Generator) genTypeMutationUpsertField(
+	obj *gql.Object,
+	filter *gql.InputObject,
+) (*gql.Field, error) {
+	field := &gql.Field{
+		Name:        "upsert_" + obj.Name(),
+		Description: upsertDocumentDescription,
+		Type:        obj,
+		Args: gql.FieldConfigArgument{
+			"filter": schemaTypes.NewArgConfig(gql.NewNonNull(filter), upsertFilterArgDescription),
+			"create": schemaTypes.NewArgConfig(gql.NewNonNull(gql.String), upsertCreateArgDescription),
+			"update": schemaTypes.NewArgConfig(gql.NewNonNull(gql.String), upsertUpdateArgDescription),
+		},
+	}
+	return field, nil
+}
+
 func (g *Generator) genTypeFieldsEnum(obj *gql.Object) *gql.Enum {
 	enumFieldsCfg := gql.EnumConfig{
 		Name:   genTypeName(obj, "Fields"),
@@ -1117,7 +1340,11 @@ func (g *Generator) genTypeOrderArgInput(obj *gql.Object) *gql.InputObject {
 				}
 				typeMap := g.manager.schema.TypeMap()
 				configType, isOrderable := typeMap[genTypeName(field.Type, "OrderArg")]
-				if gql.IsLeafType(field.Type) { // only Scalars, and enums
+				if gql.GetNullable(field.Type) == schemaTypes.GeoPointScalarType { // by distance
+					fields[field.Name] = &gql.InputObjectFieldConfig{
+						Type: schemaTypes.GeoPointOrderArg,
+					}
+				} else if gql.IsLeafType(field.Type) { // only Scalars, and enums
 					fields[field.Name] = &gql.InputObjectFieldConfig{
 						Type: typeMap["Ordering"],
 					}
@@ -1169,6 +1396,7 @@ func (g *Generator) genTypeQueryableFieldList(
 			),
 			"order":              schemaTypes.NewArgConfig(config.order, schemaTypes.OrderArgDescription),
 			request.ShowDeleted:  schemaTypes.NewArgConfig(gql.Boolean, showDeletedArgDescription),
+			request.AsOfClause:   schemaTypes.NewArgConfig(schemaTypes.AsOfInput, schemaTypes.AsOfArgDescription),
 			request.LimitClause:  schemaTypes.NewArgConfig(gql.Int, schemaTypes.LimitArgDescription),
 			request.OffsetClause: schemaTypes.NewArgConfig(gql.Int, schemaTypes.OffsetArgDescription),
 		},
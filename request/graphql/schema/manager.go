@@ -123,10 +123,14 @@ func inlineArrayTypes() []gql.Type {
 		gql.Float,
 		gql.Int,
 		gql.String,
+		gql.DateTime,
+		schemaTypes.BlobScalarType,
 		gql.NewNonNull(gql.Boolean),
 		gql.NewNonNull(gql.Float),
 		gql.NewNonNull(gql.Int),
 		gql.NewNonNull(gql.String),
+		gql.NewNonNull(gql.DateTime),
+		gql.NewNonNull(schemaTypes.BlobScalarType),
 	}
 }
 
@@ -143,6 +147,10 @@ func defaultTypes() []gql.Type {
 
 		// Custom Scalar types
 		schemaTypes.BlobScalarType,
+		schemaTypes.JSONScalarType,
+		schemaTypes.DecimalScalarType,
+		schemaTypes.BigIntScalarType,
+		schemaTypes.GeoPointScalarType,
 
 		// Base Query types
 
@@ -153,6 +161,9 @@ func defaultTypes() []gql.Type {
 		schemaTypes.BooleanOperatorBlock,
 		schemaTypes.NotNullBooleanOperatorBlock,
 		schemaTypes.DateTimeOperatorBlock,
+		schemaTypes.NotNullDateTimeOperatorBlock,
+		schemaTypes.BlobOperatorBlock,
+		schemaTypes.NotNullBlobOperatorBlock,
 		schemaTypes.FloatOperatorBlock,
 		schemaTypes.NotNullFloatOperatorBlock,
 		schemaTypes.IdOperatorBlock,
@@ -160,11 +171,23 @@ func defaultTypes() []gql.Type {
 		schemaTypes.NotNullIntOperatorBlock,
 		schemaTypes.StringOperatorBlock,
 		schemaTypes.NotNullstringOperatorBlock,
+		schemaTypes.JSONOperatorBlock,
+		schemaTypes.DecimalOperatorBlock,
+		schemaTypes.BigIntOperatorBlock,
+		schemaTypes.GeoNearInput,
+		schemaTypes.GeoRadiusInput,
+		schemaTypes.GeoBoxInput,
+		schemaTypes.GeoPointOperatorBlock,
+		schemaTypes.GeoPointOrderArg,
 
 		schemaTypes.CommitsOrderArg,
 		schemaTypes.CommitLinkObject,
 		schemaTypes.CommitObject,
 
+		schemaTypes.FieldDiffObject,
+
+		schemaTypes.AsOfInput,
+
 		schemaTypes.ExplainEnum,
 	}
 }
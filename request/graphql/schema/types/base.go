@@ -12,6 +12,8 @@ package types
 
 import (
 	gql "github.com/sourcenetwork/graphql-go"
+
+	"github.com/sourcenetwork/defradb/client/request"
 )
 
 // BooleanOperatorBlock filter block for boolean types.
@@ -102,6 +104,94 @@ var DateTimeOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
 	},
 })
 
+// NotNullDateTimeOperatorBlock filter block for DateTime! types.
+var NotNullDateTimeOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
+	Name:        "NotNullDateTimeOperatorBlock",
+	Description: notNullDateTimeOperatorBlockDescription,
+	Fields: gql.InputObjectConfigFieldMap{
+		"_eq": &gql.InputObjectFieldConfig{
+			Description: eqOperatorDescription,
+			Type:        gql.DateTime,
+		},
+		"_ne": &gql.InputObjectFieldConfig{
+			Description: neOperatorDescription,
+			Type:        gql.DateTime,
+		},
+		"_gt": &gql.InputObjectFieldConfig{
+			Description: gtOperatorDescription,
+			Type:        gql.DateTime,
+		},
+		"_ge": &gql.InputObjectFieldConfig{
+			Description: geOperatorDescription,
+			Type:        gql.DateTime,
+		},
+		"_lt": &gql.InputObjectFieldConfig{
+			Description: ltOperatorDescription,
+			Type:        gql.DateTime,
+		},
+		"_le": &gql.InputObjectFieldConfig{
+			Description: leOperatorDescription,
+			Type:        gql.DateTime,
+		},
+		"_in": &gql.InputObjectFieldConfig{
+			Description: inOperatorDescription,
+			Type:        gql.NewList(gql.NewNonNull(gql.DateTime)),
+		},
+		"_nin": &gql.InputObjectFieldConfig{
+			Description: ninOperatorDescription,
+			Type:        gql.NewList(gql.NewNonNull(gql.DateTime)),
+		},
+	},
+})
+
+// BlobOperatorBlock filter block for Blob types.
+var BlobOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
+	Name:        "BlobOperatorBlock",
+	Description: blobOperatorBlockDescription,
+	Fields: gql.InputObjectConfigFieldMap{
+		"_eq": &gql.InputObjectFieldConfig{
+			Description: eqOperatorDescription,
+			Type:        BlobScalarType,
+		},
+		"_ne": &gql.InputObjectFieldConfig{
+			Description: neOperatorDescription,
+			Type:        BlobScalarType,
+		},
+		"_in": &gql.InputObjectFieldConfig{
+			Description: inOperatorDescription,
+			Type:        gql.NewList(BlobScalarType),
+		},
+		"_nin": &gql.InputObjectFieldConfig{
+			Description: ninOperatorDescription,
+			Type:        gql.NewList(BlobScalarType),
+		},
+	},
+})
+
+// NotNullBlobOperatorBlock filter block for Blob! types.
+var NotNullBlobOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
+	Name:        "NotNullBlobOperatorBlock",
+	Description: notNullBlobOperatorBlockDescription,
+	Fields: gql.InputObjectConfigFieldMap{
+		"_eq": &gql.InputObjectFieldConfig{
+			Description: eqOperatorDescription,
+			Type:        BlobScalarType,
+		},
+		"_ne": &gql.InputObjectFieldConfig{
+			Description: neOperatorDescription,
+			Type:        BlobScalarType,
+		},
+		"_in": &gql.InputObjectFieldConfig{
+			Description: inOperatorDescription,
+			Type:        gql.NewList(gql.NewNonNull(BlobScalarType)),
+		},
+		"_nin": &gql.InputObjectFieldConfig{
+			Description: ninOperatorDescription,
+			Type:        gql.NewList(gql.NewNonNull(BlobScalarType)),
+		},
+	},
+})
+
 // FloatOperatorBlock filter block for Float types.
 var FloatOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
 	Name:        "FloatOperatorBlock",
@@ -349,3 +439,261 @@ var IdOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
 		},
 	},
 })
+
+// DecimalOperatorBlock filter block for Decimal types.
+var DecimalOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
+	Name:        "DecimalOperatorBlock",
+	Description: decimalOperatorBlockDescription,
+	Fields: gql.InputObjectConfigFieldMap{
+		"_eq": &gql.InputObjectFieldConfig{
+			Description: eqOperatorDescription,
+			Type:        DecimalScalarType,
+		},
+		"_ne": &gql.InputObjectFieldConfig{
+			Description: neOperatorDescription,
+			Type:        DecimalScalarType,
+		},
+		"_gt": &gql.InputObjectFieldConfig{
+			Description: gtOperatorDescription,
+			Type:        DecimalScalarType,
+		},
+		"_ge": &gql.InputObjectFieldConfig{
+			Description: geOperatorDescription,
+			Type:        DecimalScalarType,
+		},
+		"_lt": &gql.InputObjectFieldConfig{
+			Description: ltOperatorDescription,
+			Type:        DecimalScalarType,
+		},
+		"_le": &gql.InputObjectFieldConfig{
+			Description: leOperatorDescription,
+			Type:        DecimalScalarType,
+		},
+		"_in": &gql.InputObjectFieldConfig{
+			Description: inOperatorDescription,
+			Type:        gql.NewList(DecimalScalarType),
+		},
+		"_nin": &gql.InputObjectFieldConfig{
+			Description: ninOperatorDescription,
+			Type:        gql.NewList(DecimalScalarType),
+		},
+	},
+})
+
+// BigIntOperatorBlock filter block for BigInt types.
+var BigIntOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
+	Name:        "BigIntOperatorBlock",
+	Description: bigIntOperatorBlockDescription,
+	Fields: gql.InputObjectConfigFieldMap{
+		"_eq": &gql.InputObjectFieldConfig{
+			Description: eqOperatorDescription,
+			Type:        BigIntScalarType,
+		},
+		"_ne": &gql.InputObjectFieldConfig{
+			Description: neOperatorDescription,
+			Type:        BigIntScalarType,
+		},
+		"_gt": &gql.InputObjectFieldConfig{
+			Description: gtOperatorDescription,
+			Type:        BigIntScalarType,
+		},
+		"_ge": &gql.InputObjectFieldConfig{
+			Description: geOperatorDescription,
+			Type:        BigIntScalarType,
+		},
+		"_lt": &gql.InputObjectFieldConfig{
+			Description: ltOperatorDescription,
+			Type:        BigIntScalarType,
+		},
+		"_le": &gql.InputObjectFieldConfig{
+			Description: leOperatorDescription,
+			Type:        BigIntScalarType,
+		},
+		"_in": &gql.InputObjectFieldConfig{
+			Description: inOperatorDescription,
+			Type:        gql.NewList(BigIntScalarType),
+		},
+		"_nin": &gql.InputObjectFieldConfig{
+			Description: ninOperatorDescription,
+			Type:        gql.NewList(BigIntScalarType),
+		},
+	},
+})
+
+// GeoNearInput is the condition of the near operator of geo points.
+var GeoNearInput = gql.NewInputObject(gql.InputObjectConfig{
+	Name:        "GeoNearInput",
+	Description: geoNearInputDescription,
+	Fields: gql.InputObjectConfigFieldMap{
+		request.GeoNearPoint: &gql.InputObjectFieldConfig{
+			Description: geoNearPointDescription,
+			Type:        gql.NewNonNull(GeoPointScalarType),
+		},
+		request.GeoNearMaxDistance: &gql.InputObjectFieldConfig{
+			Description: geoNearMaxDistanceDescription,
+			Type:        gql.Float,
+		},
+		request.GeoNearMinDistance: &gql.InputObjectFieldConfig{
+			Description: geoNearMinDistanceDescription,
+			Type:        gql.Float,
+		},
+	},
+})
+
+// GeoRadiusInput is the condition of the within radius operator of geo points.
+var GeoRadiusInput = gql.NewInputObject(gql.InputObjectConfig{
+	Name:        "GeoRadiusInput",
+	Description: geoRadiusInputDescription,
+	Fields: gql.InputObjectConfigFieldMap{
+		request.GeoRadiusCenter: &gql.InputObjectFieldConfig{
+			Description: geoRadiusCenterDescription,
+			Type:        gql.NewNonNull(GeoPointScalarType),
+		},
+		request.GeoRadiusRadius: &gql.InputObjectFieldConfig{
+			Description: geoRadiusRadiusDescription,
+			Type:        gql.NewNonNull(gql.Float),
+		},
+	},
+})
+
+// GeoBoxInput is the condition of the within box operator of geo points.
+var GeoBoxInput = gql.NewInputObject(gql.InputObjectConfig{
+	Name:        "GeoBoxInput",
+	Description: geoBoxInputDescription,
+	Fields: gql.InputObjectConfigFieldMap{
+		request.GeoBoxSouthWest: &gql.InputObjectFieldConfig{
+			Description: geoBoxSouthWestDescription,
+			Type:        gql.NewNonNull(GeoPointScalarType),
+		},
+		request.GeoBoxNorthEast: &gql.InputObjectFieldConfig{
+			Description: geoBoxNorthEastDescription,
+			Type:        gql.NewNonNull(GeoPointScalarType),
+		},
+	},
+})
+
+// GeoPointOperatorBlock filter block for GeoPoint types.
+var GeoPointOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
+	Name:        "GeoPointOperatorBlock",
+	Description: geoPointOperatorBlockDescription,
+	Fields: gql.InputObjectConfigFieldMap{
+		"_eq": &gql.InputObjectFieldConfig{
+			Description: eqOperatorDescription,
+			Type:        GeoPointScalarType,
+		},
+		"_ne": &gql.InputObjectFieldConfig{
+			Description: neOperatorDescription,
+			Type:        GeoPointScalarType,
+		},
+		request.FilterOpNear: &gql.InputObjectFieldConfig{
+			Description: nearOperatorDescription,
+			Type:        GeoNearInput,
+		},
+		request.FilterOpWithinRadius: &gql.InputObjectFieldConfig{
+			Description: withinRadiusOperatorDescription,
+			Type:        GeoRadiusInput,
+		},
+		request.FilterOpWithinBox: &gql.InputObjectFieldConfig{
+			Description: withinBoxOperatorDescription,
+			Type:        GeoBoxInput,
+		},
+	},
+})
+
+// GeoPointOrderArg orders geo points by their distance from a point.
+var GeoPointOrderArg = gql.NewInputObject(gql.InputObjectConfig{
+	Name:        "GeoPointOrderArg",
+	Description: geoPointOrderArgDescription,
+	Fields: gql.InputObjectConfigFieldMap{
+		request.OrderDistanceFrom: &gql.InputObjectFieldConfig{
+			Description: distanceFromOrderDescription,
+			Type:        gql.NewNonNull(GeoPointScalarType),
+		},
+		request.OrderDirectionArg: &gql.InputObjectFieldConfig{
+			Description: directionOrderDescription,
+			Type:        OrderingEnum,
+		},
+	},
+})
+
+// JSONOperatorBlock filter block for JSON types.
+var JSONOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
+	Name:        "JSONOperatorBlock",
+	Description: jsonOperatorBlockDescription,
+	Fields: gql.InputObjectConfigFieldMap{
+		"_path": &gql.InputObjectFieldConfig{
+			Description: pathOperatorDescription,
+			Type:        gql.NewList(gql.NewNonNull(gql.String)),
+		},
+		"_exists": &gql.InputObjectFieldConfig{
+			Description: existsOperatorDescription,
+			Type:        gql.Boolean,
+		},
+		"_eq": &gql.InputObjectFieldConfig{
+			Description: eqOperatorDescription,
+			Type:        JSONScalarType,
+		},
+		"_ne": &gql.InputObjectFieldConfig{
+			Description: neOperatorDescription,
+			Type:        JSONScalarType,
+		},
+		"_gt": &gql.InputObjectFieldConfig{
+			Description: gtOperatorDescription,
+			Type:        JSONScalarType,
+		},
+		"_ge": &gql.InputObjectFieldConfig{
+			Description: geOperatorDescription,
+			Type:        JSONScalarType,
+		},
+		"_lt": &gql.InputObjectFieldConfig{
+			Description: ltOperatorDescription,
+			Type:        JSONScalarType,
+		},
+		"_le": &gql.InputObjectFieldConfig{
+			Description: leOperatorDescription,
+			Type:        JSONScalarType,
+		},
+		"_in": &gql.InputObjectFieldConfig{
+			Description: inOperatorDescription,
+			Type:        gql.NewList(JSONScalarType),
+		},
+		"_nin": &gql.InputObjectFieldConfig{
+			Description: ninOperatorDescription,
+			Type:        gql.NewList(JSONScalarType),
+		},
+		"_like": &gql.InputObjectFieldConfig{
+			Description: likeStringOperatorDescription,
+			Type:        gql.String,
+		},
+		"_nlike": &gql.InputObjectFieldConfig{
+			Description: nlikeStringOperatorDescription,
+			Type:        gql.String,
+		},
+	},
+})
+
+// NewEnumOperatorBlock returns the filter block for the given enum type.
+func NewEnumOperatorBlock(enum *gql.Enum) *gql.InputObject {
+	return gql.NewInputObject(gql.InputObjectConfig{
+		Name:        enum.Name() + "OperatorBlock",
+		Description: enumOperatorBlockDescription,
+		Fields: gql.InputObjectConfigFieldMap{
+			"_eq": &gql.InputObjectFieldConfig{
+				Description: eqOperatorDescription,
+				Type:        enum,
+			},
+			"_ne": &gql.InputObjectFieldConfig{
+				Description: neOperatorDescription,
+				Type:        enum,
+			},
+			"_in": &gql.InputObjectFieldConfig{
+				Description: inOperatorDescription,
+				Type:        gql.NewList(gql.NewNonNull(enum)),
+			},
+			"_nin": &gql.InputObjectFieldConfig{
+				Description: ninOperatorDescription,
+				Type:        gql.NewList(gql.NewNonNull(enum)),
+			},
+		},
+	})
+}
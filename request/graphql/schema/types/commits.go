@@ -37,6 +37,7 @@ var (
 	// 	CollectionID: Int
 	// 	SchemaVersionID: String
 	// 	Delta: String
+	// 	Signer: String
 	// 	Previous: [Commit]
 	//  Links: [Commit]
 	// }
@@ -79,6 +80,10 @@ var (
 				Description: commitDeltaFieldDescription,
 				Type:        gql.String,
 			},
+			"signer": &gql.Field{
+				Description: commitSignerFieldDescription,
+				Type:        gql.String,
+			},
 			"links": &gql.Field{
 				Description: commitLinksDescription,
 				Type:        gql.NewList(CommitLinkObject),
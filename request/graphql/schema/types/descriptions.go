@@ -22,6 +22,25 @@ An optional set of fields for which to group the contents of this field by.
  the '_group' selector within the immediate child selector. If an empty set
  is provided, the restrictions mentioned still apply, although all results
  will appear within the same group.
+`
+	AsOfArgDescription string = `
+An optional point in history at which the documents should be read. Each
+ document is returned at the state it was in at that point, filters and
+ aggregates are applied to that state. Related objects are read at their
+ current state. May not be used alongside the 'cid' argument.
+`
+	asOfDescription string = `
+A point in the history of a collection. Exactly one of 'height' or 'time'
+ must be provided.
+`
+	asOfHeightDescription string = `
+The maximum commit height of the document versions to read. Documents without
+ any commits at or below this height are not returned.
+`
+	asOfTimeDescription string = `
+The latest time at which the document versions to read were recorded by this
+ node. Documents without any versions recorded at or before this time are not
+ returned.
 `
 	LimitArgDescription string = `
 An optional value that caps the number of results to the number provided.
@@ -91,12 +110,30 @@ The id of the field that this commit was committed against. If this is a composi
 `
 	commitDeltaFieldDescription string = `
 The CBOR encoded representation of the value that is saved as part of this commit.
+`
+	commitSignerFieldDescription string = `
+The peer ID derived from the public key that signed this commit. If the commit is not
+ signed the value will be null.
 `
 	commitLinkNameFieldDescription string = `
 The Name of the field that this linked commit mutated.
 `
 	commitLinkCIDFieldDescription string = `
 The CID of this linked commit.
+`
+	fieldDiffDescription string = `
+FieldDiff describes the change in value of a single field between two versions
+ of a document.
+`
+	fieldDiffFieldFieldDescription string = `
+The name of the field that changed, '_deleted' if the change was to whether the
+ document is deleted.
+`
+	fieldDiffBeforeFieldDescription string = `
+The value of the field in the earlier version, null if it was not set.
+`
+	fieldDiffAfterFieldDescription string = `
+The value of the field in the later version, null if it is not set.
 `
 	commitFieldsEnumDescription string = `
 These are the set of fields supported for grouping by in a commits query.
@@ -136,6 +173,18 @@ These are the set of filter operators available for use when filtering on Boolea
 	dateTimeOperatorBlockDescription string = `
 These are the set of filter operators available for use when filtering on DateTime
  values.
+`
+	notNullDateTimeOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on DateTime!
+ values.
+`
+	blobOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on Blob
+ values.
+`
+	notNullBlobOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on Blob!
+ values.
 `
 	floatOperatorBlockDescription string = `
 These are the set of filter operators available for use when filtering on Float
@@ -164,6 +213,87 @@ These are the set of filter operators available for use when filtering on String
 	idOperatorBlockDescription string = `
 These are the set of filter operators available for use when filtering on ID
  values.
+`
+	enumOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on enum
+ values.
+`
+	decimalOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on Decimal
+ values.
+`
+	bigIntOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on BigInt
+ values.
+`
+	geoPointOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on GeoPoint
+ values. Distances are given in meters.
+`
+	nearOperatorDescription string = `
+The near operator - if the distance of the target point from the given point is within the
+ given minimum and maximum distances the check will pass.
+`
+	withinRadiusOperatorDescription string = `
+The within radius operator - if the target point is within the given radius of the given
+ center the check will pass.
+`
+	withinBoxOperatorDescription string = `
+The within box operator - if the target point is within the box of the given south-west and
+ north-east corners the check will pass. Boxes whose south-west longitude is greater than their
+ north-east longitude cross the antimeridian.
+`
+	geoNearInputDescription string = `
+The point, and optional minimum and maximum distances in meters, of a near condition.
+`
+	geoNearPointDescription string = `
+The point the distance of the target point is measured from.
+`
+	geoNearMaxDistanceDescription string = `
+The maximum distance in meters of the target point, inclusive.
+`
+	geoNearMinDistanceDescription string = `
+The minimum distance in meters of the target point, inclusive.
+`
+	geoRadiusInputDescription string = `
+The center and radius in meters of a within radius condition.
+`
+	geoRadiusCenterDescription string = `
+The center of the circle the target point must be within.
+`
+	geoRadiusRadiusDescription string = `
+The radius in meters of the circle the target point must be within.
+`
+	geoBoxInputDescription string = `
+The south-west and north-east corners of a within box condition.
+`
+	geoBoxSouthWestDescription string = `
+The south-west corner of the box the target point must be within.
+`
+	geoBoxNorthEastDescription string = `
+The north-east corner of the box the target point must be within.
+`
+	geoPointOrderArgDescription string = `
+Sort the results by the distance of the GeoPoint values from the given point, null values
+ first in ascending order.
+`
+	distanceFromOrderDescription string = `
+The point the distance of the GeoPoint values is measured from.
+`
+	directionOrderDescription string = `
+The direction of the sort, ascending if omitted.
+`
+	jsonOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on JSON
+ values. The other operators apply to the value found at the given path, if any.
+`
+	pathOperatorDescription string = `
+The path operator - the list of object keys, or array indexes, leading to the value the
+ other operators of the block apply to.
+`
+	existsOperatorDescription string = `
+The exists operator - if the target value exists, respectively does not exist, the check will
+ pass.
 `
 	eqOperatorDescription string = `
 The equality operator - if the target matches the value the check will pass.
@@ -227,5 +357,18 @@ Allows the explicit definition of relationship attributes instead of using the s
 `
 	relationDirectiveNameArgDescription string = `
 Explicitly define the name of the relationship instead of using the system generated defaults.
+`
+	encryptedDirectiveDescription string = `
+Encrypt the values of the field with the key of the collection before they are written to the DAG.
+ Encrypted fields cannot be filtered on or indexed.
+`
+	constraintDirectiveDescription string = `
+Validate the values of the field on every write. The minLength, maxLength and pattern arguments
+ apply to strings, min and max to numbers, oneOf lists the allowed strings or numbers, and
+ minItems and maxItems apply to arrays.
+`
+	defaultDirectiveDescription string = `
+Set the value of the field on create if the document does not hold one. The value argument
+ is a literal of the kind of the field, e.g. @default(value: 10) on an Int field.
 `
 )
@@ -0,0 +1,46 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package types
+
+import (
+	gql "github.com/sourcenetwork/graphql-go"
+
+	"github.com/sourcenetwork/defradb/client/request"
+)
+
+var (
+	// FieldDiffObject is an object describing the change in value of a single field
+	// between two versions of a document.
+	//
+	// type FieldDiff {
+	//   field: String
+	//   before: JSON
+	//   after: JSON
+	// }
+	FieldDiffObject = gql.NewObject(gql.ObjectConfig{
+		Name:        request.DiffTypeName,
+		Description: fieldDiffDescription,
+		Fields: gql.Fields{
+			request.DiffFieldFieldName: &gql.Field{
+				Description: fieldDiffFieldFieldDescription,
+				Type:        gql.String,
+			},
+			request.DiffBeforeFieldName: &gql.Field{
+				Description: fieldDiffBeforeFieldDescription,
+				Type:        JSONScalarType,
+			},
+			request.DiffAfterFieldName: &gql.Field{
+				Description: fieldDiffAfterFieldDescription,
+				Type:        JSONScalarType,
+			},
+		},
+	})
+)
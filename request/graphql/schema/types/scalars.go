@@ -13,9 +13,12 @@ package types
 import (
 	"encoding/hex"
 	"regexp"
+	"strconv"
 
 	"github.com/sourcenetwork/graphql-go"
 	"github.com/sourcenetwork/graphql-go/language/ast"
+
+	"github.com/sourcenetwork/defradb/client"
 )
 
 // BlobPattern is a regex for validating blob hex strings
@@ -63,3 +66,161 @@ var BlobScalarType = graphql.NewScalar(graphql.ScalarConfig{
 		}
 	},
 })
+
+// parseJSONLiteral converts the given ast value into its Go equivalent.
+// If the value cannot be converted nil is returned.
+func parseJSONLiteral(valueAST ast.Value) any {
+	switch valueAST := valueAST.(type) {
+	case *ast.StringValue:
+		return valueAST.Value
+	case *ast.BooleanValue:
+		return valueAST.Value
+	case *ast.IntValue:
+		value, err := strconv.ParseInt(valueAST.Value, 10, 64)
+		if err != nil {
+			return nil
+		}
+		return value
+	case *ast.FloatValue:
+		value, err := strconv.ParseFloat(valueAST.Value, 64)
+		if err != nil {
+			return nil
+		}
+		return value
+	case *ast.ListValue:
+		values := make([]any, len(valueAST.Values))
+		for i, value := range valueAST.Values {
+			values[i] = parseJSONLiteral(value)
+		}
+		return values
+	case *ast.ObjectValue:
+		values := make(map[string]any, len(valueAST.Fields))
+		for _, field := range valueAST.Fields {
+			values[field.Name.Value] = parseJSONLiteral(field.Value)
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+var JSONScalarType = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "JSON",
+	Description: "The `JSON` scalar type represents an arbitrary JSON value.",
+	// Serialize returns the value as is, leaving it to be encoded with the response
+	Serialize: func(value any) any {
+		return value
+	},
+	// ParseValue returns the (already decoded) value as is
+	ParseValue: func(value any) any {
+		return value
+	},
+	// ParseLiteral converts the ast value to its Go equivalent
+	ParseLiteral: parseJSONLiteral,
+})
+
+// coerceDecimal converts the given value into a decimal.
+// If the value cannot be converted nil is returned.
+func coerceDecimal(value any) any {
+	decimal, err := client.NewDecimalFromValue(value)
+	if err != nil {
+		return nil
+	}
+	return decimal
+}
+
+var DecimalScalarType = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "Decimal",
+	Description: "The `Decimal` scalar type represents an exact decimal number of arbitrary precision.",
+	// Serialize converts the value to a decimal string
+	Serialize: func(value any) any {
+		decimal, err := client.NewDecimalFromValue(value)
+		if err != nil {
+			return nil
+		}
+		return decimal.String()
+	},
+	// ParseValue converts the value to a decimal
+	ParseValue: coerceDecimal,
+	// ParseLiteral converts the ast value to a decimal, from its exact text
+	ParseLiteral: func(valueAST ast.Value) any {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceDecimal(valueAST.Value)
+		case *ast.IntValue:
+			return coerceDecimal(valueAST.Value)
+		case *ast.FloatValue:
+			return coerceDecimal(valueAST.Value)
+		default:
+			// return nil if the value cannot be parsed
+			return nil
+		}
+	},
+})
+
+// coerceBigInt converts the given value into a big integer.
+// If the value cannot be converted nil is returned.
+func coerceBigInt(value any) any {
+	i, err := client.NewBigIntFromValue(value)
+	if err != nil {
+		return nil
+	}
+	return i
+}
+
+var BigIntScalarType = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "BigInt",
+	Description: "The `BigInt` scalar type represents an integer of arbitrary size.",
+	// Serialize converts the value to a base ten string
+	Serialize: func(value any) any {
+		i, err := client.NewBigIntFromValue(value)
+		if err != nil {
+			return nil
+		}
+		return i.String()
+	},
+	// ParseValue converts the value to a big integer
+	ParseValue: coerceBigInt,
+	// ParseLiteral converts the ast value to a big integer, from its exact text
+	ParseLiteral: func(valueAST ast.Value) any {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return coerceBigInt(valueAST.Value)
+		case *ast.IntValue:
+			return coerceBigInt(valueAST.Value)
+		default:
+			// return nil if the value cannot be parsed
+			return nil
+		}
+	},
+})
+
+// coerceGeoPoint converts the given value into a geo point.
+// If the value cannot be converted nil is returned.
+func coerceGeoPoint(value any) any {
+	point, err := client.NewGeoPointFromValue(value)
+	if err != nil {
+		return nil
+	}
+	return point
+}
+
+var GeoPointScalarType = graphql.NewScalar(graphql.ScalarConfig{
+	Name: "GeoPoint",
+	Description: "The `GeoPoint` scalar type represents a geographic point, given as an object " +
+		"of its `latitude` and `longitude` in degrees.",
+	// Serialize converts the value to a geo point
+	Serialize: coerceGeoPoint,
+	// ParseValue converts the value to a geo point
+	ParseValue: coerceGeoPoint,
+	// ParseLiteral converts the ast object value to a geo point
+	ParseLiteral: func(valueAST ast.Value) any {
+		switch valueAST := valueAST.(type) {
+		case *ast.ObjectValue:
+			return coerceGeoPoint(parseJSONLiteral(valueAST))
+		default:
+			// return nil if the value cannot be parsed
+			return nil
+		}
+	},
+})
@@ -15,19 +15,25 @@ import (
 )
 
 const (
-	ExplainLabel  string = "explain"
-	PrimaryLabel  string = "primary"
-	RelationLabel string = "relation"
+	ExplainLabel    string = "explain"
+	PrimaryLabel    string = "primary"
+	RelationLabel   string = "relation"
+	EncryptedLabel  string = "encrypted"
+	DefaultLabel    string = "default"
+	ConstraintLabel string = "constraint"
 
 	ExplainArgNameType string = "type"
 	ExplainArgSimple   string = "simple"
 	ExplainArgExecute  string = "execute"
 	ExplainArgDebug    string = "debug"
 
+	DefaultDirectivePropValue = "value"
+
 	IndexDirectiveLabel          = "index"
 	IndexDirectivePropName       = "name"
 	IndexDirectivePropFields     = "fields"
 	IndexDirectivePropDirections = "directions"
+	IndexDirectivePropPath       = "path"
 )
 
 var (
@@ -46,6 +52,22 @@ var (
 		},
 	})
 
+	// AsOfInput is the input object for the asOf argument.
+	AsOfInput = gql.NewInputObject(gql.InputObjectConfig{
+		Name:        "AsOf",
+		Description: asOfDescription,
+		Fields: gql.InputObjectConfigFieldMap{
+			"height": &gql.InputObjectFieldConfig{
+				Description: asOfHeightDescription,
+				Type:        gql.Int,
+			},
+			"time": &gql.InputObjectFieldConfig{
+				Description: asOfTimeDescription,
+				Type:        gql.DateTime,
+			},
+		},
+	})
+
 	ExplainEnum = gql.NewEnum(gql.EnumConfig{
 		Name:        "ExplainType",
 		Description: "ExplainType is an enum selecting the type of explanation done by the @explain directive.",
@@ -110,6 +132,9 @@ var (
 			IndexDirectivePropName: &gql.ArgumentConfig{
 				Type: gql.String,
 			},
+			IndexDirectivePropPath: &gql.ArgumentConfig{
+				Type: gql.NewList(gql.NewNonNull(gql.String)),
+			},
 		},
 		Locations: []string{
 			gql.DirectiveLocationField,
@@ -143,6 +168,36 @@ var (
 			gql.DirectiveLocationFieldDefinition,
 		},
 	})
+
+	// EncryptedDirective @encrypted is used to indicate that the values
+	// of a field must be encrypted before being written to the DAG.
+	EncryptedDirective = gql.NewDirective(gql.DirectiveConfig{
+		Name:        EncryptedLabel,
+		Description: encryptedDirectiveDescription,
+		Locations: []string{
+			gql.DirectiveLocationFieldDefinition,
+		},
+	})
+
+	// ConstraintDirective @constraint is used to declare the validation
+	// rules of the values of a field.
+	ConstraintDirective = gql.NewDirective(gql.DirectiveConfig{
+		Name:        ConstraintLabel,
+		Description: constraintDirectiveDescription,
+		Locations: []string{
+			gql.DirectiveLocationFieldDefinition,
+		},
+	})
+
+	// DefaultDirective @default is used to set the value of a field
+	// on create if the document does not hold one.
+	DefaultDirective = gql.NewDirective(gql.DirectiveConfig{
+		Name:        DefaultLabel,
+		Description: defaultDirectiveDescription,
+		Locations: []string{
+			gql.DirectiveLocationFieldDefinition,
+		},
+	})
 )
 
 func NewArgConfig(t gql.Type, description string) *gql.ArgumentConfig {
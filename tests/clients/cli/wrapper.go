@@ -13,11 +13,16 @@ package cli
 import (
 	"bufio"
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	blockstore "github.com/ipfs/boxo/blockstore"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -162,12 +167,89 @@ func (w *Wrapper) BasicExport(ctx context.Context, config *client.BackupConfig)
 	if config.Pretty {
 		args = append(args, "--pretty")
 	}
+	args = append(args, backupExportArgs(config)...)
 	args = append(args, config.Filepath)
 
 	_, err := w.cmd.execute(ctx, args)
 	return err
 }
 
+func (w *Wrapper) BasicImportFrom(ctx context.Context, config *client.BackupConfig, r io.Reader) error {
+	f, err := os.CreateTemp("", "defradb-import-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name()) //nolint:errcheck
+
+	_, err = io.Copy(f, r)
+	if err != nil {
+		f.Close() //nolint:errcheck
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	args := []string{"client", "backup", "import", "--stream"}
+	if config.Format != "" {
+		args = append(args, "--format", config.Format)
+	}
+	if config.Compression != "" {
+		args = append(args, "--compression", config.Compression)
+	}
+	args = append(args, f.Name())
+
+	_, err = w.cmd.execute(ctx, args)
+	return err
+}
+
+func (w *Wrapper) BasicExportTo(ctx context.Context, config *client.BackupConfig, wr io.Writer) error {
+	dir, err := os.MkdirTemp("", "defradb-export-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir) //nolint:errcheck
+	path := filepath.Join(dir, "export")
+
+	args := []string{"client", "backup", "export", "--stream"}
+	if len(config.Collections) > 0 {
+		args = append(args, "--collections", strings.Join(config.Collections, ","))
+	}
+	if config.Format != "" {
+		args = append(args, "--format", config.Format)
+	}
+	if config.Pretty {
+		args = append(args, "--pretty")
+	}
+	args = append(args, backupExportArgs(config)...)
+	args = append(args, path)
+
+	_, err = w.cmd.execute(ctx, args)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	_, err = wr.Write(data)
+	return err
+}
+
+func backupExportArgs(config *client.BackupConfig) []string {
+	var args []string
+	if config.Compression != "" {
+		args = append(args, "--compression", config.Compression)
+	}
+	if config.Filter != "" {
+		args = append(args, "--filter", config.Filter)
+	}
+	if !config.ChangedSince.IsZero() {
+		args = append(args, "--changed-since", config.ChangedSince.Format(time.RFC3339Nano))
+	}
+	return args
+}
+
 func (w *Wrapper) AddSchema(ctx context.Context, schema string) ([]client.CollectionDescription, error) {
 	args := []string{"client", "schema", "add"}
 	args = append(args, schema)
@@ -400,6 +482,28 @@ func (w *Wrapper) ExecRequest(ctx context.Context, query string) *client.Request
 	return result
 }
 
+func (w *Wrapper) ChangesSince(
+	ctx context.Context,
+	collectionName string,
+	cursor uint64,
+	limit uint64,
+) (client.ChangesResult, error) {
+	args := []string{"client", "collection", "changes"}
+	args = append(args, "--name", collectionName)
+	args = append(args, "--cursor", strconv.FormatUint(cursor, 10))
+	args = append(args, "--limit", strconv.FormatUint(limit, 10))
+
+	data, err := w.cmd.execute(ctx, args)
+	if err != nil {
+		return client.ChangesResult{}, err
+	}
+	var result client.ChangesResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return client.ChangesResult{}, err
+	}
+	return result, nil
+}
+
 func (w *Wrapper) execRequestSubscription(ctx context.Context, r io.Reader) *events.Publisher[events.Update] {
 	pubCh := events.New[events.Update](0, 0)
 	pub, err := events.NewPublisher[events.Update](pubCh, 0)
@@ -425,6 +529,129 @@ func (w *Wrapper) execRequestSubscription(ctx context.Context, r io.Reader) *eve
 	return pub
 }
 
+func (w *Wrapper) AddWebhook(ctx context.Context, hook client.Webhook) (client.Webhook, error) {
+	args := []string{"client", "webhook", "add"}
+	args = append(args, "--collection", hook.Collection)
+	if hook.Filter != "" {
+		args = append(args, "--filter", hook.Filter)
+	}
+	if hook.Secret != "" {
+		args = append(args, "--secret", hook.Secret)
+	}
+	args = append(args, hook.URL)
+
+	data, err := w.cmd.execute(ctx, args)
+	if err != nil {
+		return client.Webhook{}, err
+	}
+	var res client.Webhook
+	if err := json.Unmarshal(data, &res); err != nil {
+		return client.Webhook{}, err
+	}
+	return res, nil
+}
+
+func (w *Wrapper) DeleteWebhook(ctx context.Context, id string) error {
+	args := []string{"client", "webhook", "delete"}
+	args = append(args, id)
+
+	_, err := w.cmd.execute(ctx, args)
+	return err
+}
+
+func (w *Wrapper) GetAllWebhooks(ctx context.Context) ([]client.Webhook, error) {
+	args := []string{"client", "webhook", "getall"}
+
+	data, err := w.cmd.execute(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	var hooks []client.Webhook
+	if err := json.Unmarshal(data, &hooks); err != nil {
+		return nil, err
+	}
+	return hooks, nil
+}
+
+func (w *Wrapper) GetAllWebhookDeadLetters(ctx context.Context) ([]client.WebhookDeadLetter, error) {
+	args := []string{"client", "webhook", "deadletters"}
+
+	data, err := w.cmd.execute(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	var letters []client.WebhookDeadLetter
+	if err := json.Unmarshal(data, &letters); err != nil {
+		return nil, err
+	}
+	return letters, nil
+}
+
+func (w *Wrapper) SetPolicy(ctx context.Context, policy client.Policy) error {
+	args := []string{"client", "policy", "set"}
+
+	data, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	args = append(args, string(data))
+
+	_, err = w.cmd.execute(ctx, args)
+	return err
+}
+
+func (w *Wrapper) GetPolicy(ctx context.Context) (client.Policy, error) {
+	args := []string{"client", "policy", "get"}
+
+	data, err := w.cmd.execute(ctx, args)
+	if err != nil {
+		return client.Policy{}, err
+	}
+	var policy client.Policy
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return client.Policy{}, err
+	}
+	return policy, nil
+}
+
+func (w *Wrapper) SetEncryptionKey(ctx context.Context, collectionName string, key []byte) error {
+	args := []string{"client", "encryption-key", "set", collectionName, hex.EncodeToString(key)}
+
+	_, err := w.cmd.execute(ctx, args)
+	return err
+}
+
+func (w *Wrapper) GetEncryptionKey(ctx context.Context, collectionName string) ([]byte, error) {
+	args := []string{"client", "encryption-key", "get", collectionName}
+
+	data, err := w.cmd.execute(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	var key string
+	if err := json.Unmarshal(data, &key); err != nil {
+		return nil, err
+	}
+	if key == "" {
+		return nil, nil
+	}
+	return hex.DecodeString(key)
+}
+
+func (w *Wrapper) CreateSnapshot(ctx context.Context, filepath string) (client.SnapshotInfo, error) {
+	args := []string{"client", "backup", "snapshot", filepath}
+
+	data, err := w.cmd.execute(ctx, args)
+	if err != nil {
+		return client.SnapshotInfo{}, err
+	}
+	var info client.SnapshotInfo
+	if err := json.Unmarshal(data, &info); err != nil {
+		return client.SnapshotInfo{}, err
+	}
+	return info, nil
+}
+
 func (w *Wrapper) NewTxn(ctx context.Context, readOnly bool) (datastore.Txn, error) {
 	args := []string{"client", "tx", "create"}
 	if readOnly {
@@ -14,6 +14,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"strings"
 
 	"github.com/sourcenetwork/defradb/client"
@@ -235,6 +237,48 @@ func (c *Collection) UpdateWithKeys(
 	return c.updateWith(ctx, args)
 }
 
+func (c *Collection) Upsert(
+	ctx context.Context,
+	filter any,
+	create *client.Document,
+	updater string,
+) (*client.UpsertResult, error) {
+	args := []string{"client", "collection", "upsert"}
+	args = append(args, "--name", c.Description().Name)
+	args = append(args, "--updater", updater)
+
+	filterJSON, err := json.Marshal(filter)
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, "--filter", string(filterJSON))
+
+	// We must call this here, else the doc key on the given object will not match
+	// that of the document saved in the database
+	err = create.RemapAliasFieldsAndDockey(c.Schema().Fields)
+	if err != nil {
+		return nil, err
+	}
+	document, err := create.String()
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, document)
+
+	data, err := c.cmd.execute(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	var res client.UpsertResult
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	if res.Created {
+		create.Clean()
+	}
+	return &res, nil
+}
+
 func (c *Collection) DeleteWith(ctx context.Context, target any) (*client.DeleteResult, error) {
 	switch t := target.(type) {
 	case string, map[string]any, *request.Filter:
@@ -317,6 +361,90 @@ func (c *Collection) Get(ctx context.Context, key client.DocKey, showDeleted boo
 	return client.NewDocFromMap(docMap)
 }
 
+func (c *Collection) Diff(
+	ctx context.Context,
+	key client.DocKey,
+	fromCID string,
+	toCID string,
+) ([]client.FieldDiff, error) {
+	args := []string{"client", "collection", "diff"}
+	args = append(args, "--name", c.Description().Name)
+	args = append(args, key.String())
+
+	if fromCID != "" {
+		args = append(args, "--from", fromCID)
+	}
+	if toCID != "" {
+		args = append(args, "--to", toCID)
+	}
+
+	data, err := c.cmd.execute(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	var diff []client.FieldDiff
+	if err := json.Unmarshal(data, &diff); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+func (c *Collection) Revert(ctx context.Context, key client.DocKey, cid string) error {
+	args := []string{"client", "collection", "revert"}
+	args = append(args, "--name", c.Description().Name)
+	args = append(args, key.String(), cid)
+
+	_, err := c.cmd.execute(ctx, args)
+	return err
+}
+
+func (c *Collection) Import(
+	ctx context.Context,
+	config client.ImportConfig,
+	r io.Reader,
+) (client.ImportResult, error) {
+	f, err := os.CreateTemp("", "defradb-import-*")
+	if err != nil {
+		return client.ImportResult{}, err
+	}
+	defer os.Remove(f.Name()) //nolint:errcheck
+
+	_, err = io.Copy(f, r)
+	if err != nil {
+		f.Close() //nolint:errcheck
+		return client.ImportResult{}, err
+	}
+	if err := f.Close(); err != nil {
+		return client.ImportResult{}, err
+	}
+
+	args := []string{"client", "collection", "import"}
+	args = append(args, "--name", c.Description().Name)
+	if config.Format != "" {
+		args = append(args, "--format", config.Format)
+	}
+	if config.BatchSize > 0 {
+		args = append(args, "--batch-size", fmt.Sprint(config.BatchSize))
+	}
+	for column, field := range config.Mapping {
+		args = append(args, "--map", column+":"+field)
+	}
+	for field, naturalKey := range config.Relations {
+		args = append(args, "--relation", field+":"+naturalKey)
+	}
+	args = append(args, f.Name())
+
+	data, err := c.cmd.execute(ctx, args)
+	if err != nil {
+		return client.ImportResult{}, err
+	}
+	var result client.ImportResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return client.ImportResult{}, err
+	}
+	return result, nil
+}
+
 func (c *Collection) WithTxn(tx datastore.Txn) client.Collection {
 	return &Collection{
 		cmd: c.cmd.withTxn(tx),
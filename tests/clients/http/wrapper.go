@@ -12,6 +12,7 @@ package http
 
 import (
 	"context"
+	"io"
 	"net/http/httptest"
 
 	blockstore "github.com/ipfs/boxo/blockstore"
@@ -91,6 +92,14 @@ func (w *Wrapper) BasicExport(ctx context.Context, config *client.BackupConfig)
 	return w.client.BasicExport(ctx, config)
 }
 
+func (w *Wrapper) BasicImportFrom(ctx context.Context, config *client.BackupConfig, r io.Reader) error {
+	return w.client.BasicImportFrom(ctx, config, r)
+}
+
+func (w *Wrapper) BasicExportTo(ctx context.Context, config *client.BackupConfig, wr io.Writer) error {
+	return w.client.BasicExportTo(ctx, config, wr)
+}
+
 func (w *Wrapper) AddSchema(ctx context.Context, schema string) ([]client.CollectionDescription, error) {
 	return w.client.AddSchema(ctx, schema)
 }
@@ -151,6 +160,51 @@ func (w *Wrapper) ExecRequest(ctx context.Context, query string) *client.Request
 	return w.client.ExecRequest(ctx, query)
 }
 
+func (w *Wrapper) ChangesSince(
+	ctx context.Context,
+	collectionName string,
+	cursor uint64,
+	limit uint64,
+) (client.ChangesResult, error) {
+	return w.client.ChangesSince(ctx, collectionName, cursor, limit)
+}
+
+func (w *Wrapper) AddWebhook(ctx context.Context, hook client.Webhook) (client.Webhook, error) {
+	return w.client.AddWebhook(ctx, hook)
+}
+
+func (w *Wrapper) DeleteWebhook(ctx context.Context, id string) error {
+	return w.client.DeleteWebhook(ctx, id)
+}
+
+func (w *Wrapper) GetAllWebhooks(ctx context.Context) ([]client.Webhook, error) {
+	return w.client.GetAllWebhooks(ctx)
+}
+
+func (w *Wrapper) GetAllWebhookDeadLetters(ctx context.Context) ([]client.WebhookDeadLetter, error) {
+	return w.client.GetAllWebhookDeadLetters(ctx)
+}
+
+func (w *Wrapper) SetPolicy(ctx context.Context, policy client.Policy) error {
+	return w.client.SetPolicy(ctx, policy)
+}
+
+func (w *Wrapper) GetPolicy(ctx context.Context) (client.Policy, error) {
+	return w.client.GetPolicy(ctx)
+}
+
+func (w *Wrapper) SetEncryptionKey(ctx context.Context, collectionName string, key []byte) error {
+	return w.client.SetEncryptionKey(ctx, collectionName, key)
+}
+
+func (w *Wrapper) GetEncryptionKey(ctx context.Context, collectionName string) ([]byte, error) {
+	return w.client.GetEncryptionKey(ctx, collectionName)
+}
+
+func (w *Wrapper) CreateSnapshot(ctx context.Context, filepath string) (client.SnapshotInfo, error) {
+	return w.client.CreateSnapshot(ctx, filepath)
+}
+
 func (w *Wrapper) NewTxn(ctx context.Context, readOnly bool) (datastore.Txn, error) {
 	client, err := w.client.NewTxn(ctx, readOnly)
 	if err != nil {
@@ -105,3 +105,64 @@ func TestBackupExport_JustUserCollection_NoError(t *testing.T) {
 
 	executeTestCase(t, test)
 }
+
+func TestBackupExport_WithNDJSONFormat_NoError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc:          `{"name": "John", "age": 30}`,
+			},
+			testUtils.BackupExport{
+				Config: client.BackupConfig{
+					Format: client.BackupFormatNDJSON,
+				},
+				ExpectedContent: `{"collection":"User","doc":{"_key":"bae-e933420a-988a-56f8-8952-6c245aebd519","_newKey":"bae-e933420a-988a-56f8-8952-6c245aebd519","age":30,"name":"John"}}` + "\n",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestBackupExport_WithFilter_NoError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc:          `{"name": "John", "age": 30}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc:          `{"name": "Bob", "age": 40}`,
+			},
+			testUtils.BackupExport{
+				Config: client.BackupConfig{
+					Filter: `{age: {_lt: 35}}`,
+				},
+				ExpectedContent: `{"User":[{"_key":"bae-e933420a-988a-56f8-8952-6c245aebd519","_newKey":"bae-e933420a-988a-56f8-8952-6c245aebd519","age":30,"name":"John"}]}`,
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestBackupExport_WithInvalidCompression_ReturnError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc:          `{"name": "John", "age": 30}`,
+			},
+			testUtils.BackupExport{
+				Config: client.BackupConfig{
+					Compression: "lz4",
+				},
+				ExpectedError: "invalid backup compression",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
@@ -204,3 +204,31 @@ func TestBackupImport_WithMultipleNoKeysAndInvalidField_Errors(t *testing.T) {
 
 	executeTestCase(t, test)
 }
+
+func TestBackupImport_WithNDJSONFile_NoError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.BackupImport{
+				Filepath:      t.TempDir() + "/test.ndjson",
+				ImportContent: `{"collection":"User","doc":{"_key":"bae-e933420a-988a-56f8-8952-6c245aebd519","_newKey":"bae-e933420a-988a-56f8-8952-6c245aebd519","age":30,"name":"John"}}` + "\n",
+			},
+			testUtils.Request{
+				Request: `
+					query  {
+						User {
+							name
+							age
+						}
+					}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(30),
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
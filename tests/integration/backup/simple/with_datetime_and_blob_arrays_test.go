@@ -0,0 +1,84 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+var scheduleSchema = (`
+	type Schedule {
+		name: String
+		meetings: [DateTime!]
+		attachments: [Blob]
+	}
+`)
+
+func TestBackupExport_WithDateTimeAndBlobArrays_NoError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: scheduleSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Standup",
+					"meetings": ["2023-01-02T09:00:00Z", "2023-01-03T09:00:00Z"],
+					"attachments": ["00ff", null]
+				}`,
+			},
+			testUtils.BackupExport{
+				ExpectedContent: `{"Schedule":[{"_key":"bae-d10b8ef0-8bfe-5c46-adf8-7b9461529ecc","_newKey":"bae-d10b8ef0-8bfe-5c46-adf8-7b9461529ecc","attachments":["00ff",null],"meetings":["2023-01-02T09:00:00Z","2023-01-03T09:00:00Z"],"name":"Standup"}]}`,
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestBackupImport_WithDateTimeAndBlobArrays_NoError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: scheduleSchema,
+			},
+			testUtils.BackupImport{
+				ImportContent: `{"Schedule":[{"_key":"bae-d10b8ef0-8bfe-5c46-adf8-7b9461529ecc","_newKey":"bae-d10b8ef0-8bfe-5c46-adf8-7b9461529ecc","attachments":["00ff",null],"meetings":["2023-01-02T09:00:00Z","2023-01-03T09:00:00Z"],"name":"Standup"}]}`,
+			},
+			testUtils.Request{
+				Request: `
+					query  {
+						Schedule {
+							name
+							meetings
+							attachments
+						}
+					}`,
+				Results: []map[string]any{
+					{
+						"name":     "Standup",
+						"meetings": []string{"2023-01-02T09:00:00Z", "2023-01-03T09:00:00Z"},
+						"attachments": []immutable.Option[string]{
+							immutable.Some("00ff"),
+							immutable.None[string](),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,132 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package upsert
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration/collection"
+)
+
+func TestUpsert_WithNoMatch_CreatesDocument(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test upsert with no matching document",
+		Docs: map[string][]string{
+			"Users": {`{"name": "John", "age": 21}`},
+		},
+		CollectionCalls: map[string][]func(client.Collection) error{
+			"Users": []func(c client.Collection) error{
+				func(c client.Collection) error {
+					ctx := context.Background()
+
+					doc, err := client.NewDocFromJSON([]byte(`{"name": "Fred", "age": 33}`))
+					require.NoError(t, err)
+
+					res, err := c.Upsert(ctx, `{name: {_eq: "Fred"}}`, doc, `{"age": 34}`)
+					if err != nil {
+						return err
+					}
+					assert.True(t, res.Created)
+					assert.Equal(t, doc.Key().String(), res.DocKey)
+
+					d, err := c.Get(ctx, doc.Key(), false)
+					if err != nil {
+						return err
+					}
+					age, err := d.Get("age")
+					if err != nil {
+						return err
+					}
+					assert.Equal(t, int64(33), age)
+					return nil
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestUpsert_WithSingleMatch_UpdatesDocument(t *testing.T) {
+	docStr := `{"name": "John", "age": 21}`
+	doc, err := client.NewDocFromJSON([]byte(docStr))
+	require.NoError(t, err)
+
+	test := testUtils.TestCase{
+		Description: "Test upsert with a single matching document",
+		Docs: map[string][]string{
+			"Users": {docStr},
+		},
+		CollectionCalls: map[string][]func(client.Collection) error{
+			"Users": []func(c client.Collection) error{
+				func(c client.Collection) error {
+					ctx := context.Background()
+
+					create, err := client.NewDocFromJSON([]byte(`{"name": "John", "age": 40}`))
+					require.NoError(t, err)
+
+					res, err := c.Upsert(ctx, `{name: {_eq: "John"}}`, create, `{"age": 22}`)
+					if err != nil {
+						return err
+					}
+					assert.False(t, res.Created)
+					assert.Equal(t, doc.Key().String(), res.DocKey)
+
+					d, err := c.Get(ctx, doc.Key(), false)
+					if err != nil {
+						return err
+					}
+					age, err := d.Get("age")
+					if err != nil {
+						return err
+					}
+					assert.Equal(t, int64(22), age)
+					return nil
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestUpsert_WithMultipleMatches_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test upsert with multiple matching documents",
+		Docs: map[string][]string{
+			"Users": {
+				`{"name": "John", "age": 21}`,
+				`{"name": "John", "age": 40}`,
+			},
+		},
+		CollectionCalls: map[string][]func(client.Collection) error{
+			"Users": []func(c client.Collection) error{
+				func(c client.Collection) error {
+					ctx := context.Background()
+
+					create, err := client.NewDocFromJSON([]byte(`{"name": "John", "age": 50}`))
+					require.NoError(t, err)
+
+					_, err = c.Upsert(ctx, `{name: {_eq: "John"}}`, create, `{"age": 22}`)
+					return err
+				},
+			},
+		},
+		ExpectedError: "cannot upsert, more than one document matches the filter",
+	}
+
+	executeTestCase(t, test)
+}
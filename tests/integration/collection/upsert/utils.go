@@ -0,0 +1,28 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package upsert
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration/collection"
+)
+
+var userCollectionGQLSchema = (`
+	type Users {
+		name: String
+		age: Int
+	}
+`)
+
+func executeTestCase(t *testing.T, test testUtils.TestCase) {
+	testUtils.ExecuteRequestTestCase(t, userCollectionGQLSchema, test)
+}
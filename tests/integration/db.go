@@ -22,6 +22,7 @@ import (
 	"github.com/sourcenetwork/defradb/client"
 	badgerds "github.com/sourcenetwork/defradb/datastore/badger/v4"
 	"github.com/sourcenetwork/defradb/datastore/memory"
+	pebbleds "github.com/sourcenetwork/defradb/datastore/pebble"
 	"github.com/sourcenetwork/defradb/db"
 	changeDetector "github.com/sourcenetwork/defradb/tests/change_detector"
 )
@@ -33,18 +34,21 @@ const (
 	fileBadgerEnvName     = "DEFRA_BADGER_FILE"
 	fileBadgerPathEnvName = "DEFRA_BADGER_FILE_PATH"
 	inMemoryEnvName       = "DEFRA_IN_MEMORY"
+	filePebbleEnvName     = "DEFRA_PEBBLE_FILE"
 )
 
 const (
 	badgerIMType   DatabaseType = "badger-in-memory"
 	defraIMType    DatabaseType = "defra-memory-datastore"
 	badgerFileType DatabaseType = "badger-file-system"
+	pebbleFileType DatabaseType = "pebble-file-system"
 )
 
 var (
 	badgerInMemory bool
 	badgerFile     bool
 	inMemoryStore  bool
+	pebbleFile     bool
 	databaseDir    string
 )
 
@@ -54,13 +58,15 @@ func init() {
 	badgerFile, _ = strconv.ParseBool(os.Getenv(fileBadgerEnvName))
 	badgerInMemory, _ = strconv.ParseBool(os.Getenv(memoryBadgerEnvName))
 	inMemoryStore, _ = strconv.ParseBool(os.Getenv(inMemoryEnvName))
+	pebbleFile, _ = strconv.ParseBool(os.Getenv(filePebbleEnvName))
 
 	if changeDetector.Enabled {
 		// Change detector only uses badger file db type.
 		badgerFile = true
 		badgerInMemory = false
 		inMemoryStore = false
-	} else if !badgerInMemory && !badgerFile && !inMemoryStore {
+		pebbleFile = false
+	} else if !badgerInMemory && !badgerFile && !inMemoryStore && !pebbleFile {
 		// Default is to test all but filesystem db types.
 		badgerFile = false
 		badgerInMemory = true
@@ -121,14 +127,40 @@ func NewBadgerFileDB(ctx context.Context, t testing.TB, dbopts ...db.Option) (cl
 	return db, dbPath, err
 }
 
+func NewPebbleFileDB(ctx context.Context, t testing.TB, dbopts ...db.Option) (client.DB, string, error) {
+	var dbPath string
+	switch {
+	case databaseDir != "":
+		// restarting database
+		dbPath = databaseDir
+
+	default:
+		// default test case
+		dbPath = t.TempDir()
+	}
+
+	rootstore, err := pebbleds.NewDatastore(dbPath, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	db, err := db.NewDB(ctx, rootstore, dbopts...)
+	if err != nil {
+		return nil, "", err
+	}
+	return db, dbPath, err
+}
+
 // setupDatabase returns the database implementation for the current
 // testing state. The database type on the test state is used to
 // select the datastore implementation to use.
-func setupDatabase(s *state) (impl client.DB, path string, err error) {
+//
+// The given options are applied after the default ones.
+func setupDatabase(s *state, opts ...db.Option) (impl client.DB, path string, err error) {
 	dbopts := []db.Option{
 		db.WithUpdateEvents(),
 		db.WithLensPoolSize(lensPoolSize),
 	}
+	dbopts = append(dbopts, opts...)
 
 	switch s.dbt {
 	case badgerIMType:
@@ -140,6 +172,9 @@ func setupDatabase(s *state) (impl client.DB, path string, err error) {
 	case defraIMType:
 		impl, err = NewInMemoryDB(s.ctx, dbopts...)
 
+	case pebbleFileType:
+		impl, path, err = NewPebbleFileDB(s.ctx, s.t, dbopts...)
+
 	default:
 		err = fmt.Errorf("invalid database type: %v", s.dbt)
 	}
@@ -0,0 +1,85 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package test_explain_default
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+	explainUtils "github.com/sourcenetwork/defradb/tests/integration/explain"
+)
+
+var upsertPattern = dataMap{
+	"explain": dataMap{
+		"upsertNode": dataMap{
+			"selectTopNode": dataMap{
+				"selectNode": dataMap{
+					"scanNode": dataMap{},
+				},
+			},
+		},
+	},
+}
+
+func TestDefaultExplainMutationRequestWithUpsert(t *testing.T) {
+	test := testUtils.TestCase{
+
+		Description: "Explain (default) mutation request with upsert.",
+
+		Actions: []any{
+			explainUtils.SchemaForExplainTests,
+
+			testUtils.ExplainRequest{
+
+				Request: `mutation @explain {
+					upsert_Author(
+						filter: {
+							name: {
+								_eq: "Bob"
+							}
+						},
+						create: "{\"name\": \"Bob\", \"age\": 59}",
+						update: "{\"age\": 60}"
+					) {
+						_key
+						name
+						age
+					}
+				}`,
+
+				ExpectedPatterns: []dataMap{upsertPattern},
+
+				ExpectedTargets: []testUtils.PlanNodeTargetCase{
+					{
+						TargetNodeName:    "upsertNode",
+						IncludeChildNodes: false,
+						ExpectedAttributes: dataMap{
+							"data": dataMap{
+								"name": "Bob",
+								"age":  float64(59),
+							},
+							"filter": dataMap{
+								"name": dataMap{
+									"_eq": "Bob",
+								},
+							},
+							"update": dataMap{
+								"age": float64(60),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	explainUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,183 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func createJobsWithGeoIndex() []any {
+	return []any{
+		testUtils.SchemaUpdate{
+			Schema: `
+				type Job {
+					name: String
+					location: GeoPoint @index
+				}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{"name": "Louvre", "location": {"latitude": 48.8606, "longitude": 2.3376}}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{"name": "Eiffel", "location": {"latitude": 48.8584, "longitude": 2.2945}}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{"name": "Versailles", "location": {"latitude": 48.8049, "longitude": 2.1204}}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{"name": "London", "location": {"latitude": 51.5072, "longitude": -0.1276}}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{"name": "Nowhere"}`,
+		},
+	}
+}
+
+func TestQueryWithGeoIndex_WithWithinRadiusFilter_ShouldFetchCoveringCells(t *testing.T) {
+	req := `query {
+		Job(filter: {location: {_withinRadius: {
+			center: {latitude: 48.8566, longitude: 2.3522},
+			radius: 5000
+		}}}) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test geo index filtering with _withinRadius filter",
+		Actions: append(
+			createJobsWithGeoIndex(),
+			testUtils.Request{
+				Request: req,
+				Results: []map[string]any{
+					{"name": "Eiffel"},
+					{"name": "Louvre"},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithDocFetches(2).WithIndexFetches(2),
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithGeoIndex_WithNearFilter_ShouldMatchPointsExactly(t *testing.T) {
+	req := `query {
+		Job(filter: {location: {_near: {
+			point: {latitude: 48.8566, longitude: 2.3522},
+			minDistance: 2000,
+			maxDistance: 20000
+		}}}) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test geo index filtering with _near filter, the cells holding points out of range",
+		Actions: append(
+			createJobsWithGeoIndex(),
+			testUtils.Request{
+				Request: req,
+				Results: []map[string]any{
+					{"name": "Eiffel"},
+					{"name": "Versailles"},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(3),
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithGeoIndex_WithUnboundedNearFilter_ShouldNotUseIndex(t *testing.T) {
+	req := `query {
+		Job(filter: {location: {_near: {
+			point: {latitude: 48.8566, longitude: 2.3522},
+			minDistance: 300000
+		}}}) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test geo index is not used to filter on an unbounded _near filter",
+		Actions: append(
+			createJobsWithGeoIndex(),
+			testUtils.Request{
+				Request: req,
+				Results: []map[string]any{
+					{"name": "London"},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(0),
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithGeoIndex_AfterUpdateAndDelete_ShouldFetchNewLocations(t *testing.T) {
+	req := `query {
+		Job(filter: {location: {_withinBox: {
+			southWest: {latitude: 48.8, longitude: 2.2},
+			northEast: {latitude: 48.9, longitude: 2.4}
+		}}}) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test geo index filtering after the indexed points are updated and deleted",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Job {
+						name: String
+						location: GeoPoint @index
+					}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"name": "Louvre", "location": {"latitude": 48.8606, "longitude": 2.3376}}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"name": "Moving", "location": {"latitude": 51.5072, "longitude": -0.1276}}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 1,
+				Doc:   `{"location": {"latitude": 48.8584, "longitude": 2.2945}}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc:   `{"location": null}`,
+			},
+			testUtils.Request{
+				Request: req,
+				Results: []map[string]any{
+					{"name": "Moving"},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithIndexFetches(1),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,159 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryWithJSONPathIndex_WithEqualFilter_ShouldFetch(t *testing.T) {
+	req := `query {
+		Event(filter: {meta: {_path: ["user", "id"], _eq: 2}}) {
+			name
+			meta
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test JSON path index filtering with _eq filter",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Event {
+						name: String
+						meta: JSON @index(path: ["user", "id"])
+					}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"name": "Login", "meta": {"user": {"id": 1}}}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"name": "Logout", "meta": {"user": {"id": 2}}}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"name": "Ping", "meta": {"ip": "10.0.0.1"}}`,
+			},
+			testUtils.Request{
+				Request: req,
+				Results: []map[string]any{
+					{
+						"name": "Logout",
+						"meta": map[string]any{"user": map[string]any{"id": int64(2)}},
+					},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithDocFetches(1).WithFieldFetches(2).WithIndexFetches(1),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithJSONPathIndex_WithInFilter_ShouldFetch(t *testing.T) {
+	req := `query {
+		Event(filter: {meta: {_path: ["kind"], _in: ["a", "c"]}}) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test JSON path index filtering with _in filter",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Event {
+						name: String
+						meta: JSON @index(path: ["kind"])
+					}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"name": "First", "meta": {"kind": "a"}}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"name": "Second", "meta": {"kind": "b"}}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"name": "Third", "meta": {"kind": "c"}}`,
+			},
+			testUtils.Request{
+				Request: req,
+				Results: []map[string]any{
+					{"name": "First"},
+					{"name": "Third"},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithDocFetches(2).WithIndexFetches(2),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQueryWithJSONPathIndex_WithOtherPath_ShouldNotUseIndex(t *testing.T) {
+	req := `query {
+		Event(filter: {meta: {_path: ["other"], _eq: "a"}}) {
+			name
+		}
+	}`
+	test := testUtils.TestCase{
+		Description: "Test JSON path index is not used to filter on another path",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Event {
+						name: String
+						meta: JSON @index(path: ["kind"])
+					}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"name": "First", "meta": {"kind": "b", "other": "a"}}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"name": "Second", "meta": {"kind": "a"}}`,
+			},
+			testUtils.Request{
+				Request: req,
+				Results: []map[string]any{
+					{"name": "First"},
+				},
+			},
+			testUtils.Request{
+				Request:  makeExplainQuery(req),
+				Asserter: testUtils.NewExplainAsserter().WithDocFetches(2).WithIndexFetches(0),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestJSONIndex_WithoutPath_ShouldFail(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "JSON fields can only be indexed by path",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Event {
+						meta: JSON @index
+					}`,
+				ExpectedError: "JSON fields can only be indexed by path. Field: meta",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
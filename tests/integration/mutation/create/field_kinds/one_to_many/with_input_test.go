@@ -0,0 +1,147 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_many
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationCreateOneToMany_WithInputCreatingRelatedFromManySide(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many create mutation with input, creating the related doc from the many side",
+		Actions: []any{
+			testUtils.Request{
+				Request: `mutation {
+					create_Book(input: {name: "Painted House", author: {create: {name: "John Grisham"}}}) {
+						name
+						author {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Painted House",
+						"author": map[string]any{
+							"name": "John Grisham",
+						},
+					},
+				},
+			},
+		},
+	}
+	executeTestCase(t, test)
+}
+
+func TestMutationCreateOneToMany_WithInputConnectingRelatedFromManySide(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many create mutation with input, connecting the related doc from the many side",
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_Book(input: {
+						name: "Painted House",
+						author: {connect: "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"}
+					}) {
+						name
+						author {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Painted House",
+						"author": map[string]any{
+							"name": "John Grisham",
+						},
+					},
+				},
+			},
+		},
+	}
+	executeTestCase(t, test)
+}
+
+func TestMutationCreateOneToMany_WithInputRelatingFromSingleSide(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many create mutation with input, creating and connecting from the single side",
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "A Time for Mercy"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_Author(input: {
+						name: "John Grisham",
+						published: [
+							{create: {name: "Painted House", rating: 4.9}},
+							{connect: "bae-b79e1ebe-d819-5abf-9fd1-9009a532eb03"}
+						]
+					}) {
+						name
+						published {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John Grisham",
+						"published": []map[string]any{
+							{
+								"name": "Painted House",
+							},
+							{
+								"name": "A Time for Mercy",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	executeTestCase(t, test)
+}
+
+func TestMutationCreateOneToMany_WithInputBothConnectAndCreate_Error(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many create mutation with input, with both connect and create",
+		Actions: []any{
+			testUtils.Request{
+				Request: `mutation {
+					create_Book(input: {
+						name: "Painted House",
+						author: {
+							connect: "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed",
+							create: {name: "John Grisham"}
+						}
+					}) {
+						name
+					}
+				}`,
+				ExpectedError: "relation input requires exactly one of connect or create",
+			},
+		},
+	}
+	executeTestCase(t, test)
+}
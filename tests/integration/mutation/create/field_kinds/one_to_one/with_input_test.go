@@ -0,0 +1,89 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_one
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationCreateOneToOne_WithInputCreatingRelatedFromSecondarySide(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to one create mutation with input, creating the related doc from the secondary side",
+		Actions: []any{
+			testUtils.Request{
+				Request: `mutation {
+					create_Book(input: {name: "Painted House", author: {create: {name: "John Grisham"}}}) {
+						name
+						author {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Painted House",
+						"author": map[string]any{
+							"name": "John Grisham",
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Author {
+						name
+						published {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John Grisham",
+						"published": map[string]any{
+							"name": "Painted House",
+						},
+					},
+				},
+			},
+		},
+	}
+	executeTestCase(t, test)
+}
+
+func TestMutationCreateOneToOne_WithInputCreatingRelatedFromPrimarySide(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to one create mutation with input, creating the related doc from the primary side",
+		Actions: []any{
+			testUtils.Request{
+				Request: `mutation {
+					create_Author(input: {name: "John Grisham", published: {create: {name: "Painted House"}}}) {
+						name
+						published {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John Grisham",
+						"published": map[string]any{
+							"name": "Painted House",
+						},
+					},
+				},
+			},
+		},
+	}
+	executeTestCase(t, test)
+}
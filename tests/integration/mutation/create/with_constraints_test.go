@@ -0,0 +1,91 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package create
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationCreate_WithConstraints_RejectsInvalidValues(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create mutation with values violating field constraints",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String @constraint(minLength: 2, maxLength: 10, pattern: "^[A-Z]")
+						age: Int @constraint(min: 0, max: 150)
+						status: String @constraint(oneOf: ["active", "banned"])
+						scores: [Int!] @constraint(maxItems: 2, max: 10)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc:           `{"name": "J"}`,
+				ExpectedError: "value violates field constraint. Field: name, Constraint: minLength",
+			},
+			testUtils.CreateDoc{
+				Doc:           `{"name": "Johnathan Smith"}`,
+				ExpectedError: "value violates field constraint. Field: name, Constraint: maxLength",
+			},
+			testUtils.CreateDoc{
+				Doc:           `{"name": "john"}`,
+				ExpectedError: "value violates field constraint. Field: name, Constraint: pattern",
+			},
+			testUtils.CreateDoc{
+				Doc:           `{"age": -1}`,
+				ExpectedError: "value violates field constraint. Field: age, Constraint: min",
+			},
+			testUtils.CreateDoc{
+				Doc:           `{"status": "deleted"}`,
+				ExpectedError: "value violates field constraint. Field: status, Constraint: oneOf",
+			},
+			testUtils.CreateDoc{
+				Doc:           `{"scores": [1, 2, 3]}`,
+				ExpectedError: "value violates field constraint. Field: scores, Constraint: maxItems",
+			},
+			testUtils.CreateDoc{
+				Doc:           `{"scores": [1, 20]}`,
+				ExpectedError: "value violates field constraint. Field: scores, Constraint: max",
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 30,
+					"status": "active",
+					"scores": [1, 2]
+				}`,
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Users {
+							name
+							age
+							status
+						}
+					}
+				`,
+				Results: []map[string]any{
+					{
+						"name":   "John",
+						"age":    int64(30),
+						"status": "active",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
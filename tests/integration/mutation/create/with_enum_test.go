@@ -0,0 +1,63 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package create
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationCreate_WithEnum(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create mutation with enum values",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Tasks {
+						title: String
+						status: Status @default(value: OPEN)
+					}
+
+					enum Status {
+						OPEN
+						DONE
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc:           `{"title": "Read", "status": "CLOSED"}`,
+				ExpectedError: "value does not belong to the enum of the field. Field: status, Enum: Status, Value: CLOSED",
+			},
+			testUtils.CreateDoc{
+				Doc: `{"title": "Write"}`,
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Tasks {
+							title
+							status
+						}
+					}
+				`,
+				Results: []map[string]any{
+					{
+						"title":  "Write",
+						"status": "OPEN",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,178 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package create
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationCreate_WithInput_CreatesDocument(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+						points: Float
+						verified: Boolean
+						tags: [String!]
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_User(input: {name: "John", age: 27, points: 42.1, verified: true, tags: ["a", "b"]}) {
+						name
+						age
+						points
+						verified
+						tags
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":     "John",
+						"age":      int64(27),
+						"points":   float64(42.1),
+						"verified": true,
+						"tags":     []string{"a", "b"},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithInputAndData_CreatesSameDocument(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_User(input: {name: "John", age: 27}) {
+						_key
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"_key": "bae-88b63198-7d38-5714-a9ff-21ba46374fd1",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_User(data: "{\"name\": \"John\",\"age\": 27}") {
+						_key
+					}
+				}`,
+				ExpectedError: "a document with the given dockey already exists",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithManyInputs_ReturnsDocsInOrder(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_User(input: [{name: "John", age: 27}, {name: "Islam", age: 32}]) {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(27),
+					},
+					{
+						"name": "Islam",
+						"age":  int64(32),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithInputOfWrongType_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_User(input: {name: "John", age: "27"}) {
+						name
+					}
+				}`,
+				ExpectedError: "Argument \"input\" has invalid value {name: \"John\", age: \"27\"}.",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithInputAndDataArgs_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_User(input: {name: "John"}, data: "{\"name\": \"John\"}") {
+						name
+					}
+				}`,
+				ExpectedError: "data can not be used alongside input",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
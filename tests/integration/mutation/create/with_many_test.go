@@ -0,0 +1,130 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package create
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationCreate_WithManyDocs_ReturnsDocsInOrder(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_User(data: [
+						"{\"name\": \"John\", \"age\": 27}",
+						"{\"name\": \"Islam\", \"age\": 32}",
+						"{\"name\": \"Fred\", \"age\": 44}"
+					]) {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(27),
+					},
+					{
+						"name": "Islam",
+						"age":  int64(32),
+					},
+					{
+						"name": "Fred",
+						"age":  int64(44),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					_count(User: {})
+				}`,
+				Results: []map[string]any{
+					{
+						"_count": 3,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithManyDocsAndInvalidDoc_CreatesNothing(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_User(data: [
+						"{\"name\": \"John\", \"age\": 27}",
+						"{\"name\": \"Islam\", \"fakeField\": 32}"
+					]) {
+						name
+					}
+				}`,
+				ExpectedError: "The given field does not exist. Name: fakeField",
+			},
+			testUtils.Request{
+				Request: `query {
+					User {
+						name
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithEmptyDocList_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_User(data: []) {
+						name
+					}
+				}`,
+				ExpectedError: "given data payload is empty",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
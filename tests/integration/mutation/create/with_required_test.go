@@ -0,0 +1,149 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package create
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationCreate_WithRequiredFieldMissing_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create mutation without a value for a required field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String!
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"age": 27
+				}`,
+				ExpectedError: "required field is missing a value. Field: name",
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": null,
+					"age": 27
+				}`,
+				ExpectedError: "required field is missing a value. Field: name",
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Users {
+							name
+						}
+					}
+				`,
+				Results: []map[string]any{},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithDefaultValues_SetsMissingValues(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create mutation with default values",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String!
+						age: Int! @default(value: 18)
+						points: Float @default(value: 1.5)
+						verified: Boolean @default(value: false)
+						tags: [String!] @default(value: ["new"])
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Bob",
+					"age": 40,
+					"verified": true
+				}`,
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Users {
+							name
+							age
+							points
+							verified
+							tags
+						}
+					}
+				`,
+				Results: []map[string]any{
+					{
+						"name":     "Bob",
+						"age":      int64(40),
+						"points":   1.5,
+						"verified": true,
+						"tags":     []string{"new"},
+					},
+					{
+						"name":     "John",
+						"age":      int64(18),
+						"points":   1.5,
+						"verified": false,
+						"tags":     []string{"new"},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationCreate_WithRequiredRelationMissing_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create mutation without a required relation",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						author: Author!
+					}
+					type Author {
+						name: String
+						books: [Book]
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House"
+				}`,
+				ExpectedError: "required field is missing a value. Field: author_id",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
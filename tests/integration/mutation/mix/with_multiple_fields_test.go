@@ -0,0 +1,197 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package mix
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationWithMultipleFields_ReturnsResultsByFieldNameInRequestOrder(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create, update and delete in one mutation request",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Fred",
+					"age": 44
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Shahzad",
+					"age": 28
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_User(data: "{\"name\": \"John\",\"age\": 27}") {
+						name
+						age
+					}
+					update_User(filter: {name: {_eq: "Fred"}}, data: "{\"age\": 45}") {
+						name
+						age
+					}
+					delete_User(filter: {name: {_eq: "Shahzad"}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"create_User": []map[string]any{
+							{
+								"name": "John",
+								"age":  int64(27),
+							},
+						},
+					},
+					{
+						"update_User": []map[string]any{
+							{
+								"name": "Fred",
+								"age":  int64(45),
+							},
+						},
+					},
+					{
+						"delete_User": []map[string]any{
+							{
+								"name": "Shahzad",
+							},
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					User {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(27),
+					},
+					{
+						"name": "Fred",
+						"age":  int64(45),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationWithMultipleFields_WithAliases_ReturnsResultsByAliasInRequestOrder(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Several mutations of the same field in one mutation request",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					john: create_User(data: "{\"name\": \"John\"}") {
+						name
+					}
+					fred: create_User(data: "{\"name\": \"Fred\"}") {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"john": []map[string]any{
+							{
+								"name": "John",
+							},
+						},
+					},
+					{
+						"fred": []map[string]any{
+							{
+								"name": "Fred",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationWithMultipleFields_WithErrorInLaterField_RollsBackEarlierFields(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "A failing mutation field discards the mutations before it",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Fred",
+					"age": 44
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_User(data: "{\"name\": \"John\",\"age\": 27}") {
+						name
+					}
+					update_User(filter: {name: {_eq: "Fred"}}, data: "{\"fakeField\": 45}") {
+						name
+					}
+				}`,
+				ExpectedError: "The given field does not exist",
+			},
+			testUtils.Request{
+				Request: `query {
+					User {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Fred",
+						"age":  int64(44),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
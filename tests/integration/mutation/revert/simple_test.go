@@ -0,0 +1,196 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package revert
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationRevert_AfterUpdate_RevertsFields(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"age": 22
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_User(
+						id: "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7",
+						cid: "bafybeigbebvn5h3iy4sycrhbkrnsutctdds6z3v2pdmdazqscn7t537stq"
+					) {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(21),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					User {
+						name
+						age
+						_version {
+							height
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(21),
+						"_version": []map[string]any{
+							{
+								"height": int64(3),
+							},
+							{
+								"height": int64(2),
+							},
+							{
+								"height": int64(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationRevert_AfterDelete_RestoresDocument(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"age": 22
+				}`,
+			},
+			testUtils.DeleteDoc{
+				DocID: 0,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_User(
+						id: "bae-f54b9689-e06e-5e3a-89b3-f3aee8e64ca7",
+						cid: "bafybeigbebvn5h3iy4sycrhbkrnsutctdds6z3v2pdmdazqscn7t537stq"
+					) {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(21),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					User {
+						_deleted
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"_deleted": false,
+						"name":     "John",
+						"age":      int64(21),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationRevert_WithVersionOfOtherDocument_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Andy",
+					"age": 30
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					revert_User(
+						id: "bae-d9d99681-1567-5d3e-b817-0b4f84f64666",
+						cid: "bafybeigbebvn5h3iy4sycrhbkrnsutctdds6z3v2pdmdazqscn7t537stq"
+					) {
+						name
+					}
+				}`,
+				ExpectedError: "version is not a composite commit of the document",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
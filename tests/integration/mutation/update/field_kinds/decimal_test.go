@@ -0,0 +1,108 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package field_kinds
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationUpdate_WithDecimalAndBigIntFields(t *testing.T) {
+	balance, err := client.ParseDecimal("99999999999999999.99")
+	if err != nil {
+		t.Fatal(err)
+	}
+	points, ok := new(big.Int).SetString("-99999999999999999999", 10)
+	if !ok {
+		t.Fatal("invalid big integer")
+	}
+
+	test := testUtils.TestCase{
+		Description: "Simple update of Decimal and BigInt fields",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						balance: Decimal
+						points: BigInt
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"balance": "10.5",
+					"points": 1
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"balance": "99999999999999999.99",
+					"points": -99999999999999999999
+				}`,
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Users {
+							name
+							balance
+							points
+						}
+					}
+				`,
+				Results: []map[string]any{
+					{
+						"name":    "John",
+						"balance": balance,
+						"points":  points,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationUpdate_WithInvalidDecimal_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple update of Decimal field with an invalid value",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						balance: Decimal
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"balance": "10.5"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"balance": "ten"
+				}`,
+				ExpectedError: "invalid decimal value",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package field_kinds
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationUpdate_WithJSONField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple update of JSON field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						attributes: JSON @index(path: ["color"])
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"attributes": {"color": "red", "size": 1.5}
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"attributes": {"color": "blue", "tags": ["a", null]}
+				}`,
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Users(filter: {attributes: {_path: ["color"], _eq: "blue"}}) {
+							attributes
+						}
+					}
+				`,
+				Results: []map[string]any{
+					{
+						"attributes": map[string]any{
+							"color": "blue",
+							"tags":  []any{"a", nil},
+						},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Users(filter: {attributes: {_path: ["color"], _eq: "red"}}) {
+							name
+						}
+					}
+				`,
+				Results: []map[string]any{},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,94 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_many
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationUpdateOneToMany_WithInputConnectingFromManySide(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many update mutation with input, connecting the related doc from the many side",
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Book(input: {author: {connect: "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"}}) {
+						name
+						author {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Painted House",
+						"author": map[string]any{
+							"name": "John Grisham",
+						},
+					},
+				},
+			},
+		},
+	}
+	executeTestCase(t, test)
+}
+
+func TestMutationUpdateOneToMany_WithInputCreatingFromSingleSide(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "One to many update mutation with input, creating related docs from the single side",
+		Actions: []any{
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"name": "John Grisham",
+					"age": 65
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Author(input: {age: 66, published: [{create: {name: "Painted House"}}]}) {
+						name
+						age
+						published {
+							name
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John Grisham",
+						"age":  int64(66),
+						"published": []map[string]any{
+							{
+								"name": "Painted House",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	executeTestCase(t, test)
+}
@@ -0,0 +1,68 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package update
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationUpdate_WithConstraintViolation_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Update mutation with a value violating a field constraint",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+						age: Int @constraint(min: 0)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 27
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": -5
+				}`,
+				ExpectedError: "value violates field constraint. Field: age, Constraint: min",
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": null
+				}`,
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Users {
+							name
+							age
+						}
+					}
+				`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  nil,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,82 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package update
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationUpdate_WithInput_UpdatesDocuments(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+						points: Float
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 27,
+					"points": 42.1
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_User(filter: {name: {_eq: "John"}}, input: {age: 28, points: 10.5}) {
+						name
+						age
+						points
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":   "John",
+						"age":    int64(28),
+						"points": float64(10.5),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationUpdate_WithInputAndDataArgs_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_User(input: {name: "John"}, data: "{\"name\": \"John\"}") {
+						name
+					}
+				}`,
+				ExpectedError: "data can not be used alongside input",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
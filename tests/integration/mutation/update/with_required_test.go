@@ -0,0 +1,67 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package update
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationUpdate_WithRequiredFieldSetToNull_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Update mutation setting a required field to null",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String!
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"age": 27
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"name": null
+				}`,
+				ExpectedError: "required field is missing a value. Field: name",
+			},
+			testUtils.Request{
+				Request: `
+					query {
+						Users {
+							name
+							age
+						}
+					}
+				`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(27),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,224 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package upsert
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationUpsert_WithNoMatch_CreatesDocument(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					upsert_User(
+						filter: {name: {_eq: "Fred"}},
+						create: "{\"name\": \"Fred\", \"age\": 33}",
+						update: "{\"age\": 34}"
+					) {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Fred",
+						"age":  int64(33),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					User {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Fred",
+						"age":  int64(33),
+					},
+					{
+						"name": "John",
+						"age":  int64(21),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationUpsert_WithSingleMatch_UpdatesDocument(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Fred",
+					"age": 33
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					upsert_User(
+						filter: {name: {_eq: "John"}},
+						create: "{\"name\": \"John\", \"age\": 50}",
+						update: "{\"age\": 22}"
+					) {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(22),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					User {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Fred",
+						"age":  int64(33),
+					},
+					{
+						"name": "John",
+						"age":  int64(22),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationUpsert_WithUpdateOfFilteredField_ReturnsUpdatedDocument(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					upsert_User(
+						filter: {name: {_eq: "John"}},
+						create: "{\"name\": \"John\", \"age\": 50}",
+						update: "{\"name\": \"Johnny\"}"
+					) {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Johnny",
+						"age":  int64(21),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestMutationUpsert_WithMultipleMatches_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 40
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					upsert_User(
+						filter: {name: {_eq: "John"}},
+						create: "{\"name\": \"John\", \"age\": 50}",
+						update: "{\"age\": 22}"
+					) {
+						name
+						age
+					}
+				}`,
+				ExpectedError: "cannot upsert, more than one document matches the filter",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -16,6 +16,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -72,8 +73,13 @@ type P2PTestCase struct {
 func setupDefraNode(t *testing.T, cfg *config.Config, seeds []string) (*net.Node, []client.DocKey, error) {
 	ctx := context.Background()
 
+	key, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	log.Info(ctx, "Building new memory store")
-	db, err := testutils.NewBadgerMemoryDB(ctx, coreDB.WithUpdateEvents())
+	db, err := testutils.NewBadgerMemoryDB(ctx, coreDB.WithUpdateEvents(), coreDB.WithSigningKey(key))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -97,6 +103,7 @@ func setupDefraNode(t *testing.T, cfg *config.Config, seeds []string) (*net.Node
 		ctx,
 		db,
 		net.WithConfig(cfg),
+		net.WithPrivateKey(key),
 	)
 	if err != nil {
 		return nil, nil, errors.Wrap("failed to start P2P node", err)
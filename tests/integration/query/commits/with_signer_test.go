@@ -0,0 +1,53 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package commits
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryCommitsWithSignerProperty_WithoutSigningKey_ReturnsNil(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple commits query with signer property and no signing key",
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+						"name":	"John",
+						"age":	21
+					}`,
+			},
+			testUtils.Request{
+				Request: `query {
+						commits {
+							signer
+						}
+					}`,
+				Results: []map[string]any{
+					{
+						"signer": nil,
+					},
+					{
+						"signer": nil,
+					},
+					{
+						"signer": nil,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -531,3 +531,121 @@ func TestQueryInlineArrayWithNillableString(t *testing.T) {
 
 	executeTestCase(t, test)
 }
+
+func TestQueryInlineArrayWithDateTimes(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple inline array with no filter, date times",
+		Request: `query {
+					Users {
+						name
+						meetingTimes
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"name": "John",
+					"meetingTimes": ["2017-07-23T03:46:56.647Z", "2018-02-01T10:00:00Z"]
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"name":         "John",
+				"meetingTimes": []string{"2017-07-23T03:46:56.647Z", "2018-02-01T10:00:00Z"},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryInlineArrayWithNillableDateTimes(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple inline array with no filter, nillable date times",
+		Request: `query {
+					Users {
+						name
+						holidays
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"name": "John",
+					"holidays": ["2017-12-25T00:00:00Z", null]
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"name": "John",
+				"holidays": []immutable.Option[string]{
+					immutable.Some("2017-12-25T00:00:00Z"),
+					immutable.None[string](),
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryInlineArrayWithBlobs(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple inline array with no filter, blobs",
+		Request: `query {
+					Users {
+						name
+						attachmentHashes
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"name": "John",
+					"attachmentHashes": ["00ff", "a1b2c3"]
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"name":             "John",
+				"attachmentHashes": []string{"00ff", "a1b2c3"},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryInlineArrayWithNillableBlobs(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple inline array with no filter, nillable blobs",
+		Request: `query {
+					Users {
+						name
+						thumbnails
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"name": "John",
+					"thumbnails": [null, "00ff"]
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"name": "John",
+				"thumbnails": []immutable.Option[string]{
+					immutable.None[string](),
+					immutable.Some("00ff"),
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
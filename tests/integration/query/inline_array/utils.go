@@ -27,6 +27,10 @@ var userCollectionGQLSchema = (`
 		pageRatings: [Float]
 		preferredStrings: [String!]
 		pageHeaders: [String]
+		meetingTimes: [DateTime!]
+		holidays: [DateTime]
+		attachmentHashes: [Blob!]
+		thumbnails: [Blob]
 	}
 `)
 
@@ -266,3 +266,59 @@ func TestQueryInlineNillableStringArrayWithCountWithFilter(t *testing.T) {
 
 	executeTestCase(t, test)
 }
+
+func TestQueryInlineDateTimeArrayWithCountWithFilter(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple inline array, filtered count of date time array",
+		Request: `query {
+					Users {
+						name
+						_count(meetingTimes: {filter: {_gt: "2018-01-01T00:00:00Z"}})
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"name": "John",
+					"meetingTimes": ["2017-07-23T03:46:56Z", "2018-02-01T10:00:00Z", "2019-03-04T09:30:00Z"]
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"name":   "John",
+				"_count": 2,
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryInlineNillableBlobArrayWithCountWithFilter(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple inline array, filtered count of nillable blob array",
+		Request: `query {
+					Users {
+						name
+						_count(thumbnails: {filter: {_in: ["00ff", "a1b2"]}})
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"name": "John",
+					"thumbnails": ["00ff", "a1b2", null]
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"name":   "John",
+				"_count": 2,
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_many
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryOneToManyWithSumAndAverageOfDecimal(t *testing.T) {
+	price := func(s string) client.Decimal {
+		d, err := client.ParseDecimal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+
+	test := testUtils.TestCase{
+		Description: "One-to-many relation query from many side with exact sum and average of decimals",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Book {
+						name: String
+						price: Decimal
+						author: Author
+					}
+
+					type Author {
+						name: String
+						published: [Book]
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				// bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed
+				Doc: `{
+					"name": "John Grisham"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "Painted House",
+					"price": 4.9,
+					"author_id": "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"name": "A Time for Mercy",
+					"price": 4.2,
+					"author_id": "bae-2edb7fdd-cad7-5ad4-9c7d-6920245a96ed"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Author {
+						name
+						_sum(published: {field: price})
+						_avg(published: {field: price})
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John Grisham",
+						"_sum": price("9.1"),
+						"_avg": price("4.55"),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,226 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+var paymentCollectionGQLSchema = `
+	type Payments {
+		Name: String
+		Amount: Decimal
+		Units: BigInt
+	}
+`
+
+func mustParseDecimal(s string) client.Decimal {
+	d, err := client.ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func mustParseBigInt(s string) *big.Int {
+	i, err := client.ParseBigInt(s)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+func TestQuerySimple_WithDecimalAndBigInt(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with Decimal and BigInt fields",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: paymentCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Rent",
+					"Amount": "1234567890.123456789012345678",
+					"Units": 123456789012345678901234567890
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Payments {
+						Name
+						Amount
+						Units
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name":   "Rent",
+						"Amount": mustParseDecimal("1234567890.123456789012345678"),
+						"Units":  mustParseBigInt("123456789012345678901234567890"),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithDecimalAndBigIntFilterAndOrder(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with Decimal and BigInt filters and order",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: paymentCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"Name": "Rent", "Amount": "1000.10", "Units": "18446744073709551617"}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"Name": "Coffee", "Amount": 3.5, "Units": 2}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"Name": "Book", "Amount": "12.000000000000000001", "Units": 1}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Payments(filter: {Amount: {_gt: 12}}, order: {Amount: DESC}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Rent",
+					},
+					{
+						"Name": "Book",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Payments(filter: {Units: {_eq: 18446744073709551617}}) {
+						Name
+						Amount
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name":   "Rent",
+						"Amount": mustParseDecimal("1000.1"),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Payments(filter: {Amount: {_in: ["3.50", 12]}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Coffee",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithDecimalAndBigIntSumAndAverage(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with exact Decimal and BigInt sum and average",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: paymentCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"Name": "Coffee", "Amount": 0.1, "Units": "9223372036854775807"}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"Name": "Tea", "Amount": 0.2, "Units": 1}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"Name": "Water", "Amount": 0.2, "Units": 1}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_sum(Payments: {field: Amount})
+					_avg(Payments: {field: Amount})
+				}`,
+				Results: []map[string]any{
+					{
+						"_sum": mustParseDecimal("0.5"),
+						"_avg": mustParseDecimal("0.166666666666666667"),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					_sum(Payments: {field: Units})
+					_avg(Payments: {field: Units})
+				}`,
+				Results: []map[string]any{
+					{
+						"_sum": mustParseBigInt("9223372036854775809"),
+						"_avg": mustParseDecimal("3074457345618258603"),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithDecimalAndBigIntDefaults(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with Decimal and BigInt default values",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Payments {
+						Name: String
+						Amount: Decimal @default(value: "0.00")
+						Units: BigInt @default(value: 100000000000000000000)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"Name": "Rent"}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Payments {
+						Name
+						Amount
+						Units
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name":   "Rent",
+						"Amount": client.Decimal{},
+						"Units":  mustParseBigInt("100000000000000000000"),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
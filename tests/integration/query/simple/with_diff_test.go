@@ -0,0 +1,267 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimple_WithDiffWithoutVersions_ReturnsAllFields(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"age": 22
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+						User {
+							name
+							_diff {
+								field
+								before
+								after
+							}
+						}
+					}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"_diff": []map[string]any{
+							{
+								"field":  "age",
+								"before": nil,
+								"after":  int64(22),
+							},
+							{
+								"field":  "name",
+								"before": nil,
+								"after":  "John",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithDiffFromVersion_ReturnsChangedFields(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"age": 22
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+						User {
+							_diff(from: "bafybeigbebvn5h3iy4sycrhbkrnsutctdds6z3v2pdmdazqscn7t537stq") {
+								field
+								before
+								after
+							}
+						}
+					}`,
+				Results: []map[string]any{
+					{
+						"_diff": []map[string]any{
+							{
+								"field":  "age",
+								"before": int64(21),
+								"after":  int64(22),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithDiffToEarlierVersion_ReturnsReversedChanges(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"age": 22
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+						User {
+							_diff(
+								from: "bafybeigh6rboontwz4fng5bzq54rqyeq5l2kwqcsbbt3kllym7ccrezxti",
+								to: "bafybeigbebvn5h3iy4sycrhbkrnsutctdds6z3v2pdmdazqscn7t537stq"
+							) {
+								field
+								before
+								after
+							}
+						}
+					}`,
+				Results: []map[string]any{
+					{
+						"_diff": []map[string]any{
+							{
+								"field":  "age",
+								"before": int64(22),
+								"after":  int64(21),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithDiffAfterDelete_ReturnsDeletedField(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.DeleteDoc{
+				DocID: 0,
+			},
+			testUtils.Request{
+				Request: `query {
+						User(showDeleted: true) {
+							_diff(from: "bafybeigbebvn5h3iy4sycrhbkrnsutctdds6z3v2pdmdazqscn7t537stq") {
+								field
+								before
+								after
+							}
+						}
+					}`,
+				Results: []map[string]any{
+					{
+						"_diff": []map[string]any{
+							{
+								"field":  "_deleted",
+								"before": nil,
+								"after":  true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithDiffFromVersionOfOtherDocument_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type User {
+						name: String
+						age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John",
+					"age": 21
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Andy",
+					"age": 30
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+						User(filter: {name: {_eq: "Andy"}}) {
+							_diff(from: "bafybeigbebvn5h3iy4sycrhbkrnsutctdds6z3v2pdmdazqscn7t537stq") {
+								field
+							}
+						}
+					}`,
+				ExpectedError: "version is not a composite commit of the document",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
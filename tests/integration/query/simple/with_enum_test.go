@@ -0,0 +1,162 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+var taskCollectionGQLSchema = `
+	type Tasks {
+		Title: String
+		Status: Status
+	}
+
+	enum Status {
+		OPEN
+		IN_PROGRESS
+		DONE
+	}
+`
+
+func TestQuerySimple_WithEnumEqFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with enum equality filter",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: taskCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"Title": "Write", "Status": "OPEN"}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"Title": "Read", "Status": "DONE"}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Tasks(filter: {Status: {_eq: OPEN}}) {
+						Title
+						Status
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Title":  "Write",
+						"Status": "OPEN",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithEnumInFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with enum in filter",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: taskCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"Title": "Write", "Status": "OPEN"}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"Title": "Read", "Status": "DONE"}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"Title": "Plan", "Status": "IN_PROGRESS"}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Tasks(filter: {Status: {_in: [IN_PROGRESS, DONE]}}, order: {Title: ASC}) {
+						Title
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Title": "Plan",
+					},
+					{
+						"Title": "Read",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithEnumOrder_OrdersByDeclaration(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query ordered by enum, in declaration order of the values",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: taskCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"Title": "Read", "Status": "DONE"}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"Title": "Write", "Status": "OPEN"}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"Title": "Plan", "Status": "IN_PROGRESS"}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Tasks(order: {Status: ASC}) {
+						Title
+						Status
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Title":  "Write",
+						"Status": "OPEN",
+					},
+					{
+						"Title":  "Plan",
+						"Status": "IN_PROGRESS",
+					},
+					{
+						"Title":  "Read",
+						"Status": "DONE",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Tasks(order: {Status: DESC}) {
+						Title
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Title": "Read",
+					},
+					{
+						"Title": "Plan",
+					},
+					{
+						"Title": "Write",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
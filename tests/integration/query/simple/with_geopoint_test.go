@@ -0,0 +1,248 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+var jobCollectionGQLSchema = `
+	type Jobs {
+		Name: String
+		Location: GeoPoint
+	}
+`
+
+func createJobs() []any {
+	return []any{
+		testUtils.SchemaUpdate{
+			Schema: jobCollectionGQLSchema,
+		},
+		testUtils.CreateDoc{
+			Doc: `{"Name": "Louvre", "Location": {"latitude": 48.8606, "longitude": 2.3376}}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{"Name": "Eiffel", "Location": {"latitude": 48.8584, "longitude": 2.2945}}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{"Name": "Versailles", "Location": {"latitude": 48.8049, "longitude": 2.1204}}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{"Name": "London", "Location": {"latitude": 51.5072, "longitude": -0.1276}}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{"Name": "Suva", "Location": {"latitude": -18.1416, "longitude": 178.4419}}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{"Name": "Apia", "Location": {"latitude": -13.8333, "longitude": -171.7667}}`,
+		},
+		testUtils.CreateDoc{
+			Doc: `{"Name": "Nowhere"}`,
+		},
+	}
+}
+
+func TestQuerySimple_WithGeoPoint(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with GeoPoint field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: jobCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"Name": "Louvre", "Location": {"latitude": 48.8606, "longitude": 2.3376}}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Jobs {
+						Name
+						Location
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name":     "Louvre",
+						"Location": client.GeoPoint{Latitude: 48.8606, Longitude: 2.3376},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithGeoPointWithinRadiusOrderedByDistance(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with GeoPoint within radius filter, ordered by distance",
+		Actions: append(
+			createJobs(),
+			testUtils.Request{
+				Request: `query {
+					Jobs(
+						filter: {Location: {_withinRadius: {
+							center: {latitude: 48.8566, longitude: 2.3522},
+							radius: 5000
+						}}},
+						order: {Location: {_distanceFrom: {latitude: 48.8566, longitude: 2.3522}}}
+					) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Louvre",
+					},
+					{
+						"Name": "Eiffel",
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithGeoPointNear(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with GeoPoint near filter, ordered by descending distance",
+		Actions: append(
+			createJobs(),
+			testUtils.Request{
+				Request: `query {
+					Jobs(
+						filter: {Location: {_near: {
+							point: {latitude: 48.8566, longitude: 2.3522},
+							minDistance: 2000,
+							maxDistance: 20000
+						}}},
+						order: {Location: {
+							_distanceFrom: {latitude: 48.8566, longitude: 2.3522},
+							_direction: DESC
+						}}
+					) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Versailles",
+					},
+					{
+						"Name": "Eiffel",
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithGeoPointWithinBoxAcrossAntimeridian(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with GeoPoint within box filter, the box crossing the antimeridian",
+		Actions: append(
+			createJobs(),
+			testUtils.Request{
+				Request: `query {
+					Jobs(
+						filter: {Location: {_withinBox: {
+							southWest: {latitude: -20, longitude: 170},
+							northEast: {latitude: -10, longitude: -170}
+						}}},
+						order: {Name: ASC}
+					) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Apia",
+					},
+					{
+						"Name": "Suva",
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithGeoPointEqualAndNotWithinRadius(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with GeoPoint equality and negated within radius filters",
+		Actions: append(
+			createJobs(),
+			testUtils.Request{
+				Request: `query {
+					Jobs(filter: {Location: {_eq: {latitude: 51.5072, longitude: -0.1276}}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "London",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Jobs(
+						filter: {_not: {Location: {_withinRadius: {
+							center: {latitude: 48.8566, longitude: 2.3522},
+							radius: 400000
+						}}}},
+						order: {Name: ASC}
+					) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Apia",
+					},
+					{
+						"Name": "Nowhere",
+					},
+					{
+						"Name": "Suva",
+					},
+				},
+			},
+		),
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithInvalidGeoPoint_ReturnsError(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create with GeoPoint out of range",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: jobCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc:           `{"Name": "Nowhere", "Location": {"latitude": 91, "longitude": 0}}`,
+				ExpectedError: "invalid geo point value",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,145 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+var eventCollectionGQLSchema = `
+	type Events {
+		Name: String
+		Meta: JSON
+	}
+`
+
+func TestQuerySimple_WithJSON(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with JSON field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: eventCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Login",
+					"Meta": {"user": {"id": 1, "roles": ["admin", "dev"]}, "ip": "10.0.0.1"}
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Events {
+						Name
+						Meta
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Login",
+						"Meta": map[string]any{
+							"ip": "10.0.0.1",
+							"user": map[string]any{
+								"id":    int64(1),
+								"roles": []any{"admin", "dev"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestQuerySimple_WithJSONPathFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with JSON path filters",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: eventCollectionGQLSchema,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"Name": "Login", "Meta": {"user": {"id": 1}, "tags": ["a", "b"]}}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"Name": "Logout", "Meta": {"user": {"id": 2}}}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"Name": "Ping", "Meta": 3}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Events(filter: {Meta: {_path: ["user", "id"], _eq: 1}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Login",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Events(filter: {Meta: {_path: ["user", "id"], _gt: 1}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Logout",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Events(filter: {Meta: {_path: ["tags", "1"], _eq: "b"}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Login",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Events(filter: {Meta: {_path: ["user"], _exists: false}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Ping",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Events(filter: {Meta: {_eq: 3}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Ping",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, test)
+}
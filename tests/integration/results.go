@@ -12,10 +12,13 @@ package tests
 
 import (
 	"encoding/json"
+	"math/big"
 	"testing"
 
 	"github.com/sourcenetwork/immutable"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcenetwork/defradb/client"
 )
 
 // AnyOf may be used as `Results` field where the value may
@@ -105,6 +108,24 @@ func areResultsEqual(expected any, actual any) bool {
 			return false
 		}
 		return assert.ObjectsAreEqualValues(expected, actualVal)
+	case client.Decimal:
+		actualVal, err := client.NewDecimalFromValue(actual)
+		if err != nil {
+			return false
+		}
+		return expectedVal.Cmp(actualVal) == 0
+	case *big.Int:
+		actualVal, err := client.NewBigIntFromValue(actual)
+		if err != nil {
+			return false
+		}
+		return expectedVal.Cmp(actualVal) == 0
+	case client.GeoPoint:
+		actualVal, err := client.NewGeoPointFromValue(actual)
+		if err != nil {
+			return false
+		}
+		return expectedVal == actualVal
 	case immutable.Option[float64]:
 		return areResultOptionsEqual(expectedVal, actual)
 	case immutable.Option[uint64]:
@@ -62,6 +62,8 @@ var DefaultFields = concat(
 		versionField,
 		groupField,
 		deletedField,
+		diffField,
+		ownerField,
 	},
 	aggregateFields,
 )
@@ -82,6 +84,14 @@ var deletedField = Field{
 	},
 }
 
+var ownerField = Field{
+	"name": "_owner",
+	"type": map[string]any{
+		"kind": "SCALAR",
+		"name": "String",
+	},
+}
+
 var versionField = Field{
 	"name": "_version",
 	"type": map[string]any{
@@ -90,6 +100,14 @@ var versionField = Field{
 	},
 }
 
+var diffField = Field{
+	"name": "_diff",
+	"type": map[string]any{
+		"kind": "LIST",
+		"name": nil,
+	},
+}
+
 var groupField = Field{
 	"name": "_group",
 	"type": map[string]any{
@@ -155,6 +173,29 @@ var showDeletedArg = Field{
 	},
 }
 
+var asOfArg = Field{
+	"name": "asOf",
+	"type": map[string]any{
+		"name": "AsOf",
+		"inputFields": []any{
+			map[string]any{
+				"name": "height",
+				"type": map[string]any{
+					"name":   "Int",
+					"ofType": nil,
+				},
+			},
+			map[string]any{
+				"name": "time",
+				"type": map[string]any{
+					"name":   "DateTime",
+					"ofType": nil,
+				},
+			},
+		},
+	},
+}
+
 var groupByArg = Field{
 	"name": "groupBy",
 	"type": map[string]any{
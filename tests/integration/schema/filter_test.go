@@ -135,6 +135,7 @@ var defaultUserArgsWithoutFilter = trimFields(
 		dockeyArg,
 		dockeysArg,
 		showDeletedArg,
+		asOfArg,
 		groupByArg,
 		limitArg,
 		offsetArg,
@@ -287,6 +288,7 @@ var defaultBookArgsWithoutFilter = trimFields(
 		dockeyArg,
 		dockeysArg,
 		showDeletedArg,
+		asOfArg,
 		groupByArg,
 		limitArg,
 		offsetArg,
@@ -234,7 +234,7 @@ func TestSchemaSimpleCreatesSchemaGivenTypeWithStringField(t *testing.T) {
 	testUtils.ExecuteTestCase(t, test)
 }
 
-func TestSchemaSimpleErrorsGivenNonNullField(t *testing.T) {
+func TestSchemaSimpleCreatesSchemaGivenNonNullField(t *testing.T) {
 	test := testUtils.TestCase{
 		Actions: []any{
 			testUtils.SchemaUpdate{
@@ -243,7 +243,14 @@ func TestSchemaSimpleErrorsGivenNonNullField(t *testing.T) {
 						email: String!
 					}
 				`,
-				ExpectedError: "NonNull fields are not currently supported",
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						email
+					}
+				}`,
+				Results: []map[string]any{},
 			},
 		},
 	}
@@ -0,0 +1,153 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package kind
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaUpdatesAddEnumValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add enum value",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Tasks {
+						status: Status
+					}
+
+					enum Status {
+						OPEN
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Tasks/Enums/0/Values/-", "value": "DONE" }
+					]
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"status": "DONE"}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Tasks(filter: {status: {_eq: DONE}}) {
+						status
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"status": "DONE",
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesAddEnumField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add enum and enum field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Tasks {
+						title: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Tasks/Enums", "value": [{"Name": "Status", "Values": ["OPEN", "DONE"]}] },
+						{ "op": "add", "path": "/Tasks/Fields/-", "value": {"Name": "status", "Kind": "Enum", "Schema": "Status"} }
+					]
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{"title": "Read", "status": "OPEN"}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Tasks {
+						title
+						status
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"title":  "Read",
+						"status": "OPEN",
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesAddEnumField_WithoutEnum_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add enum field without declaring the enum",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Tasks {
+						title: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Tasks/Fields/-", "value": {"Name": "status", "Kind": "Enum", "Schema": "Status"} }
+					]
+				`,
+				ExpectedError: "enum not found. Field: status, Enum: Status",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesRemoveEnumValue_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, remove enum value",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Tasks {
+						status: Status
+					}
+
+					enum Status {
+						OPEN
+						DONE
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "remove", "path": "/Tasks/Enums/0/Values/0" }
+					]
+				`,
+				ExpectedError: "enum values cannot be removed or reordered. Enum: Status",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -0,0 +1,272 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package field
+
+import (
+	"testing"
+
+	"github.com/lens-vm/lens/host-go/config/model"
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+	"github.com/sourcenetwork/defradb/tests/lenses"
+)
+
+func TestSchemaUpdatesAddFieldRequiredWithoutDefault_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add required field without a default value",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "email", "Kind": 11, "IsRequired": true} }
+					]
+				`,
+				ExpectedError: "required field added without a default value or a migration. Field: email",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesAddFieldRequiredWithDefault(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add required field with a default value",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "age", "Kind": 4, "IsRequired": true, "DefaultValue": 18} }
+					]
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+						"age":  int64(18),
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesAddFieldRequiredWithDefault_WithDocumentCreatedBeforePatch(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add required field with a default value to existing documents",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "age", "Kind": 4, "IsRequired": true, "DefaultValue": 18} }
+					]
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Shahzad",
+					"age": 30
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "Shahzad",
+						"age":  int64(30),
+					},
+					{
+						"name": "John",
+						"age":  int64(18),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {age: {_eq: 18}}) {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesAddFieldWithInvalidDefault_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add field with a default value of the wrong kind",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "age", "Kind": 4, "DefaultValue": "old"} }
+					]
+				`,
+				ExpectedError: "invalid default value for field",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesAddFieldRequiredWithoutDefault_SetDefaultVersionWithoutMigration_Errors(t *testing.T) {
+	schemaVersion2ID := "bafkreihl652lwep2c6v52cgvaks3n7tm4276q7kj66ojg3pffw5fcezu5i"
+
+	test := testUtils.TestCase{
+		Description: "Test schema update, add required field and set it as default without a migration",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "email", "Kind": 11, "IsRequired": true} }
+					]
+				`,
+				SetAsDefaultVersion: immutable.Some(false),
+			},
+			testUtils.SetDefaultSchemaVersion{
+				SchemaVersionID: schemaVersion2ID,
+				ExpectedError:   "required field added without a default value or a migration. Field: email",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesAddFieldRequiredWithoutDefault_SetDefaultVersionWithMigration(t *testing.T) {
+	schemaVersion1ID := "bafkreih27vuxrj4j2tmxnibfm77wswa36xji74hwhq7deipj5rvh3qyabq"
+	schemaVersion2ID := "bafkreihl652lwep2c6v52cgvaks3n7tm4276q7kj66ojg3pffw5fcezu5i"
+
+	test := testUtils.TestCase{
+		Description: "Test schema update, add required field and set it as default with a migration",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/-", "value": {"Name": "email", "Kind": 11, "IsRequired": true} }
+					]
+				`,
+				SetAsDefaultVersion: immutable.Some(false),
+			},
+			testUtils.ConfigureMigration{
+				LensConfig: client.LensConfig{
+					SourceSchemaVersionID:      schemaVersion1ID,
+					DestinationSchemaVersionID: schemaVersion2ID,
+					Lens: model.Lens{
+						Lenses: []model.LensModule{
+							{
+								Path: lenses.SetDefaultModulePath,
+								Arguments: map[string]any{
+									"dst":   "email",
+									"value": "unknown",
+								},
+							},
+						},
+					},
+				},
+			},
+			testUtils.SetDefaultSchemaVersion{
+				SchemaVersionID: schemaVersion2ID,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+						email
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name":  "John",
+						"email": "unknown",
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
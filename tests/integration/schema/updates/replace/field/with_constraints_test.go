@@ -0,0 +1,121 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package replace
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaUpdatesReplaceFieldConstraints_WithViolatingDoc_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, tighten field constraints violated by an existing document",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String @constraint(maxLength: 10)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Jo"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Fields/1/Constraints", "value": {"minLength": 3, "maxLength": 10} }
+					]
+				`,
+				ExpectedError: "existing document violates field constraint",
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Al"
+				}`,
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldConstraints(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, tighten field constraints",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "John"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/1/Constraints", "value": {"minLength": 3} }
+					]
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"name": "Jo"
+				}`,
+				ExpectedError: "value violates field constraint. Field: name, Constraint: minLength",
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"name": "John",
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
+
+func TestSchemaUpdatesReplaceFieldConstraints_WithInvalidRule_Errors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, field constraint not applicable to the field kind",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						age: Int
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Fields/1/Constraints", "value": {"pattern": "^a"} }
+					]
+				`,
+				ExpectedError: "invalid field constraint. Constraint: pattern",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, test)
+}
@@ -13,6 +13,7 @@ package tests
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -87,6 +88,9 @@ type state struct {
 
 	// isBench indicates wether the test is currently being benchmarked.
 	isBench bool
+
+	// The times recorded by any RecordTime actions, in the order they were recorded.
+	times []time.Time
 }
 
 // newState returns a new fresh state for the given testCase.
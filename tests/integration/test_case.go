@@ -59,6 +59,14 @@ type ConfigureNode func() config.Config
 // Restart is an action that will close and then start all nodes.
 type Restart struct{}
 
+// RecordTime is an action that will record the current time, allowing later requests
+// to read documents as they were at that time.
+//
+// Recorded times are substituted into requests in place of `{{time:<index>}}`, where
+// index is the order in which the time was recorded. The action waits for the clock
+// to move on so that any later changes are made strictly after the recorded time.
+type RecordTime struct{}
+
 // SchemaUpdate is an action that will update the database schema.
 //
 // WARNING: getCollectionNames will not work with schemas ending in `type`, e.g. `user_type`
@@ -22,6 +22,7 @@ import (
 
 	"github.com/bxcodec/faker/support/slice"
 	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/sourcenetwork/immutable"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,6 +30,7 @@ import (
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/datastore"
 	badgerds "github.com/sourcenetwork/defradb/datastore/badger/v4"
+	"github.com/sourcenetwork/defradb/db"
 	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/logging"
 	"github.com/sourcenetwork/defradb/net"
@@ -149,6 +151,9 @@ func ExecuteTestCase(
 	if inMemoryStore {
 		databases = append(databases, defraIMType)
 	}
+	if pebbleFile {
+		databases = append(databases, pebbleFileType)
+	}
 
 	// Assert that these are not empty to protect against accidental mis-configurations,
 	// otherwise an empty set would silently pass all the tests.
@@ -323,6 +328,9 @@ func performAction(
 	case CreatePredefinedDocs:
 		generatePredefinedDocs(s, action)
 
+	case RecordTime:
+		recordTime(s)
+
 	case SetupComplete:
 		// no-op, just continue.
 
@@ -614,6 +622,12 @@ func setStartingNodes(
 		switch action.(type) {
 		case ConfigureNode:
 			hasExplicitNode = true
+
+			// The keys of all the nodes are generated upfront so that each node can be
+			// configured to trust the blocks signed by the others.
+			privateKey, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+			require.NoError(s.t, err)
+			s.nodePrivateKeys = append(s.nodePrivateKeys, privateKey)
 		}
 	}
 
@@ -641,9 +655,14 @@ func restartNodes(
 
 	// We need to restart the nodes in reverse order, to avoid dial backoff issues.
 	for i := len(s.nodes) - 1; i >= 0; i-- {
+		var dbopts []db.Option
+		if len(s.nodeConfigs) != 0 {
+			dbopts = append(dbopts, db.WithSigningKey(s.nodePrivateKeys[i]))
+		}
+
 		originalPath := databaseDir
 		databaseDir = s.dbPaths[i]
-		db, _, err := setupDatabase(s)
+		db, _, err := setupDatabase(s, dbopts...)
 		require.Nil(s.t, err)
 		databaseDir = originalPath
 
@@ -668,6 +687,7 @@ func restartNodes(
 			db,
 			net.WithConfig(&cfg),
 			net.WithPrivateKey(key),
+			net.WithTrustedSigners(getNodePeerIDs(s)...),
 		)
 		require.NoError(s.t, err)
 
@@ -758,10 +778,9 @@ func configureNode(
 	}
 
 	cfg := action()
-	db, path, err := setupDatabase(s) //disable change dector, or allow it?
-	require.NoError(s.t, err)
+	privateKey := s.nodePrivateKeys[len(s.nodes)]
 
-	privateKey, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	db, path, err := setupDatabase(s, db.WithSigningKey(privateKey)) //disable change dector, or allow it?
 	require.NoError(s.t, err)
 
 	var n *net.Node
@@ -771,6 +790,7 @@ func configureNode(
 		db,
 		net.WithConfig(&cfg),
 		net.WithPrivateKey(privateKey),
+		net.WithTrustedSigners(getNodePeerIDs(s)...),
 	)
 	require.NoError(s.t, err)
 
@@ -782,7 +802,6 @@ func configureNode(
 
 	s.nodeAddresses = append(s.nodeAddresses, n.PeerInfo())
 	s.nodeConfigs = append(s.nodeConfigs, cfg)
-	s.nodePrivateKeys = append(s.nodePrivateKeys, privateKey)
 
 	c, err := setupClient(s, n)
 	require.NoError(s.t, err)
@@ -791,6 +810,17 @@ func configureNode(
 	s.dbPaths = append(s.dbPaths, path)
 }
 
+// getNodePeerIDs returns the peer IDs of all the nodes configured by the test case.
+func getNodePeerIDs(s *state) []peer.ID {
+	ids := make([]peer.ID, len(s.nodePrivateKeys))
+	for i, key := range s.nodePrivateKeys {
+		id, err := peer.IDFromPrivateKey(key)
+		require.NoError(s.t, err)
+		ids[i] = id
+	}
+	return ids
+}
+
 func refreshDocuments(
 	s *state,
 	startActionIndex int,
@@ -1498,7 +1528,7 @@ func executeRequest(
 	var expectedErrorRaised bool
 	for nodeID, node := range getNodes(action.NodeID, s.nodes) {
 		db := getStore(s, node, action.TransactionID, action.ExpectedError)
-		result := db.ExecRequest(s.ctx, action.Request)
+		result := db.ExecRequest(s.ctx, replaceRecordedTimes(s, action.Request))
 
 		anyOfByFieldKey := map[docFieldKey][]any{}
 		expectedErrorRaised = assertRequestResults(
@@ -1515,6 +1545,21 @@ func executeRequest(
 	assertExpectedErrorRaised(s.t, s.testCase.Description, action.ExpectedError, expectedErrorRaised)
 }
 
+// recordTime records the current time and then waits for the clock to move on.
+func recordTime(s *state) {
+	s.times = append(s.times, time.Now())
+	time.Sleep(time.Millisecond)
+}
+
+// replaceRecordedTimes substitutes the times recorded by RecordTime actions into
+// the given request.
+func replaceRecordedTimes(s *state, request string) string {
+	for i, t := range s.times {
+		request = strings.ReplaceAll(request, fmt.Sprintf("{{time:%d}}", i), t.Format(time.RFC3339Nano))
+	}
+	return request
+}
+
 // executeSubscriptionRequest executes the given subscription request, returning
 // a channel that will receive a single event once the subscription has been completed.
 //